@@ -11,6 +11,7 @@ import (
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	db "github.com/kubeflow/katib/pkg/db/v1beta1"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"k8s.io/klog"
 
 	"google.golang.org/grpc"
@@ -31,24 +32,60 @@ type server struct {
 // Katib store every log of metrics.
 // You can see accuracy curve or other metric logs on UI.
 func (s *server) ReportObservationLog(ctx context.Context, in *api_pb.ReportObservationLogRequest) (*api_pb.ReportObservationLogReply, error) {
-	err := dbIf.RegisterObservationLog(in.TrialName, in.ObservationLog)
+	err := dbIf.RegisterObservationLog(common.TrialKey{Name: in.TrialName, Namespace: in.Namespace, UID: in.TrialUid}, in.ObservationLog)
 	return &api_pb.ReportObservationLogReply{}, err
 }
 
 // Get all log of Observations for a Trial.
 func (s *server) GetObservationLog(ctx context.Context, in *api_pb.GetObservationLogRequest) (*api_pb.GetObservationLogReply, error) {
-	ol, err := dbIf.GetObservationLog(in.TrialName, in.MetricName, in.StartTime, in.EndTime)
+	ol, err := dbIf.GetObservationLog(common.TrialKey{Name: in.TrialName, Namespace: in.Namespace, UID: in.TrialUid}, in.MetricName, in.StartTime, in.EndTime)
 	return &api_pb.GetObservationLogReply{
 		ObservationLog: ol,
 	}, err
 }
 
 // Delete all log of Observations for a Trial.
+// Reported container logs of the Trial are deleted as well.
 func (s *server) DeleteObservationLog(ctx context.Context, in *api_pb.DeleteObservationLogRequest) (*api_pb.DeleteObservationLogReply, error) {
-	err := dbIf.DeleteObservationLog(in.TrialName)
+	trial := common.TrialKey{Name: in.TrialName, Namespace: in.Namespace, UID: in.TrialUid}
+	if err := dbIf.DeleteObservationLog(trial); err != nil {
+		return &api_pb.DeleteObservationLogReply{}, err
+	}
+	if err := dbIf.DeleteTrialLogs(trial); err != nil {
+		return &api_pb.DeleteObservationLogReply{}, err
+	}
+	err := dbIf.DeleteArtifacts(trial)
 	return &api_pb.DeleteObservationLogReply{}, err
 }
 
+// Report the last part of the primary container logs for a failed Trial.
+func (s *server) ReportTrialLogs(ctx context.Context, in *api_pb.ReportTrialLogsRequest) (*api_pb.ReportTrialLogsReply, error) {
+	err := dbIf.RegisterTrialLogs(common.TrialKey{Name: in.TrialName, Namespace: in.Namespace, UID: in.TrialUid}, in.Logs)
+	return &api_pb.ReportTrialLogsReply{}, err
+}
+
+// Get the reported container logs for a Trial.
+func (s *server) GetTrialLogs(ctx context.Context, in *api_pb.GetTrialLogsRequest) (*api_pb.GetTrialLogsReply, error) {
+	logs, err := dbIf.GetTrialLogs(common.TrialKey{Name: in.TrialName, Namespace: in.Namespace, UID: in.TrialUid})
+	return &api_pb.GetTrialLogsReply{
+		Logs: logs,
+	}, err
+}
+
+// Report the checkpoints, models and other outputs written by a Trial.
+func (s *server) ReportArtifacts(ctx context.Context, in *api_pb.ReportArtifactsRequest) (*api_pb.ReportArtifactsReply, error) {
+	err := dbIf.RegisterArtifacts(common.TrialKey{Name: in.TrialName, Namespace: in.Namespace, UID: in.TrialUid}, in.Artifacts)
+	return &api_pb.ReportArtifactsReply{}, err
+}
+
+// Get all reported artifacts for a Trial.
+func (s *server) GetArtifacts(ctx context.Context, in *api_pb.GetArtifactsRequest) (*api_pb.GetArtifactsReply, error) {
+	artifacts, err := dbIf.GetArtifacts(common.TrialKey{Name: in.TrialName, Namespace: in.Namespace, UID: in.TrialUid})
+	return &api_pb.GetArtifactsReply{
+		Artifacts: artifacts,
+	}, err
+}
+
 func (s *server) Check(ctx context.Context, in *health_pb.HealthCheckRequest) (*health_pb.HealthCheckResponse, error) {
 	resp := health_pb.HealthCheckResponse{
 		Status: health_pb.HealthCheckResponse_SERVING,
@@ -88,9 +125,14 @@ func main() {
 		klog.Fatalf("Failed to listen: %v", err)
 	}
 
+	tlsOpts, err := grpctls.ConfigFromEnv().ServerOptions()
+	if err != nil {
+		klog.Fatalf("Failed to load TLS config: %v", err)
+	}
+
 	size := 1<<31 - 1
 	klog.Infof("Start Katib manager: %s", port)
-	s := grpc.NewServer(grpc.MaxRecvMsgSize(size), grpc.MaxSendMsgSize(size))
+	s := grpc.NewServer(append([]grpc.ServerOption{grpc.MaxRecvMsgSize(size), grpc.MaxSendMsgSize(size)}, tlsOpts...)...)
 	api_pb.RegisterDBManagerServer(s, &server{})
 	health_pb.RegisterHealthServer(s, &server{})
 	reflection.Register(s)
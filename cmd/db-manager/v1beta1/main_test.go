@@ -8,6 +8,7 @@ import (
 
 	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	mockdb "github.com/kubeflow/katib/pkg/mock/v1beta1/db"
 )
 
@@ -20,6 +21,8 @@ func TestReportObservationLog(t *testing.T) {
 
 	req := &api_pb.ReportObservationLogRequest{
 		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
+		TrialUid:  "test-uid",
 		ObservationLog: &api_pb.ObservationLog{
 			MetricLogs: []*api_pb.MetricLog{
 				{
@@ -53,7 +56,7 @@ func TestReportObservationLog(t *testing.T) {
 			},
 		},
 	}
-	mockDB.EXPECT().RegisterObservationLog(req.TrialName, req.ObservationLog).Return(nil)
+	mockDB.EXPECT().RegisterObservationLog(common.TrialKey{Name: req.TrialName, Namespace: req.Namespace, UID: req.TrialUid}, req.ObservationLog).Return(nil)
 	_, err := s.ReportObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("ReportObservationLog Error %v", err)
@@ -106,7 +109,7 @@ func TestGetObservationLog(t *testing.T) {
 		},
 	}
 
-	mockDB.EXPECT().GetObservationLog(req.TrialName, req.MetricName, req.StartTime, req.EndTime).Return(obs, nil)
+	mockDB.EXPECT().GetObservationLog(common.TrialKey{Name: req.TrialName, Namespace: req.Namespace, UID: req.TrialUid}, req.MetricName, req.StartTime, req.EndTime).Return(obs, nil)
 	ret, err := s.GetObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("GetObservationLog Error %v", err)
@@ -126,13 +129,111 @@ func TestDeleteObservationLog(t *testing.T) {
 	req := &api_pb.DeleteObservationLogRequest{
 		TrialName: "test1-trial1",
 	}
-	mockDB.EXPECT().DeleteObservationLog(req.TrialName).Return(nil)
+	mockDB.EXPECT().DeleteObservationLog(common.TrialKey{Name: req.TrialName}).Return(nil)
+	mockDB.EXPECT().DeleteTrialLogs(common.TrialKey{Name: req.TrialName}).Return(nil)
+	mockDB.EXPECT().DeleteArtifacts(common.TrialKey{Name: req.TrialName}).Return(nil)
 	_, err := s.DeleteObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("DeleteExperiment Error %v", err)
 	}
 }
 
+func TestReportTrialLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	req := &api_pb.ReportTrialLogsRequest{
+		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
+		TrialUid:  "test-uid",
+		Logs:      "Traceback (most recent call last):",
+	}
+	mockDB.EXPECT().RegisterTrialLogs(common.TrialKey{Name: req.TrialName, Namespace: req.Namespace, UID: req.TrialUid}, req.Logs).Return(nil)
+	_, err := s.ReportTrialLogs(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ReportTrialLogs Error %v", err)
+	}
+}
+
+func TestGetTrialLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	req := &api_pb.GetTrialLogsRequest{
+		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
+		TrialUid:  "test-uid",
+	}
+	logs := "Traceback (most recent call last):"
+	mockDB.EXPECT().GetTrialLogs(common.TrialKey{Name: req.TrialName, Namespace: req.Namespace, UID: req.TrialUid}).Return(logs, nil)
+	ret, err := s.GetTrialLogs(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetTrialLogs Error %v", err)
+	}
+	if ret.Logs != logs {
+		t.Fatalf("GetTrialLogs Test fail expect logs %q got %q", logs, ret.Logs)
+	}
+}
+
+func TestReportArtifacts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	req := &api_pb.ReportArtifactsRequest{
+		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
+		TrialUid:  "test-uid",
+		Artifacts: []*api_pb.Artifact{
+			{
+				Name: "model",
+				Uri:  "s3://bucket/model",
+			},
+		},
+	}
+	mockDB.EXPECT().RegisterArtifacts(common.TrialKey{Name: req.TrialName, Namespace: req.Namespace, UID: req.TrialUid}, req.Artifacts).Return(nil)
+	_, err := s.ReportArtifacts(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ReportArtifacts Error %v", err)
+	}
+}
+
+func TestGetArtifacts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	req := &api_pb.GetArtifactsRequest{
+		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
+		TrialUid:  "test-uid",
+	}
+	artifacts := []*api_pb.Artifact{
+		{
+			Name: "model",
+			Uri:  "s3://bucket/model",
+		},
+	}
+	mockDB.EXPECT().GetArtifacts(common.TrialKey{Name: req.TrialName, Namespace: req.Namespace, UID: req.TrialUid}).Return(artifacts, nil)
+	ret, err := s.GetArtifacts(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetArtifacts Error %v", err)
+	}
+	if len(ret.Artifacts) != 1 || ret.Artifacts[0].Uri != "s3://bucket/model" {
+		t.Fatalf("GetArtifacts Test fail expect artifacts %v got %v", artifacts, ret.Artifacts)
+	}
+}
+
 func TestCheck(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
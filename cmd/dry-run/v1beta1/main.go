@@ -0,0 +1,105 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+ Dry-run renders the Trial template of the Experiment for sample parameter
+ assignments (min, max and random value of every parameter) and validates
+ rendered jobs using Kubernetes server-side dry-run.
+ Usage: dry-run -f experiment.yaml [-o yaml|json]
+*/
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ghodss/yaml"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/manifest"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/dryrun"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
+)
+
+var (
+	experimentFile = flag.String("f", "", "Path to the Experiment YAML file")
+	namespace      = flag.String("n", "", "Namespace to dry-run the Trial template, overrides metadata.namespace of the Experiment")
+	output         = flag.String("o", "yaml", "Output format of the dry-run results, yaml or json")
+)
+
+func main() {
+	flag.Parse()
+	if *experimentFile == "" {
+		klog.Fatal("Experiment file must be specified with -f flag")
+	}
+
+	content, err := ioutil.ReadFile(*experimentFile)
+	if err != nil {
+		klog.Fatalf("Failed to read %v: %v", *experimentFile, err)
+	}
+	experiment := &experimentsv1beta1.Experiment{}
+	if err := yaml.Unmarshal(content, experiment); err != nil {
+		klog.Fatalf("Failed to unmarshal Experiment: %v", err)
+	}
+	if *namespace != "" {
+		experiment.SetNamespace(*namespace)
+	}
+	if experiment.GetNamespace() == "" {
+		experiment.SetNamespace("default")
+	}
+
+	cfg, err := config.GetConfig()
+	if err != nil {
+		klog.Fatalf("Failed to get Kubernetes config: %v", err)
+	}
+	kclient, err := katibclient.NewClient(client.Options{})
+	if err != nil {
+		klog.Fatalf("Failed to create Katib client: %v", err)
+	}
+	dryRunner, err := dryrun.New(cfg, manifest.New(kclient.GetClient()))
+	if err != nil {
+		klog.Fatalf("Failed to create dry-runner: %v", err)
+	}
+
+	results, err := dryRunner.DryRunTrialTemplate(experiment)
+	if err != nil {
+		klog.Fatalf("Dry-run failed: %v", err)
+	}
+
+	var out []byte
+	switch *output {
+	case "json":
+		out, err = json.MarshalIndent(results, "", "  ")
+	case "yaml":
+		out, err = yaml.Marshal(results)
+	default:
+		klog.Fatalf("Unknown output format: %v", *output)
+	}
+	if err != nil {
+		klog.Fatalf("Failed to marshal dry-run results: %v", err)
+	}
+	fmt.Println(string(out))
+
+	if dryrun.Failed(results) {
+		os.Exit(1)
+	}
+}
@@ -46,6 +46,7 @@ func main() {
 	var serviceName string
 	var enableGRPCProbeInSuggestion bool
 	var trialResources trialutil.GvkListFlag
+	var trialLogsLimitBytes int
 
 	flag.StringVar(&experimentSuggestionName, "experiment-suggestion-name",
 		"default", "The implementation of suggestion interface in experiment controller (default)")
@@ -56,6 +57,7 @@ func main() {
 	flag.StringVar(&serviceName, "webhook-service-name", "katib-controller", "The service name which will be used in webhook")
 	flag.BoolVar(&enableGRPCProbeInSuggestion, "enable-grpc-probe-in-suggestion", true, "enable grpc probe in suggestions")
 	flag.Var(&trialResources, "trial-resources", "The list of resources that can be used as trial template, in the form: Kind.version.group (e.g. TFJob.v1.kubeflow.org)")
+	flag.IntVar(&trialLogsLimitBytes, "trial-logs-limit-bytes", 16384, "The size of the last part of the failed Trial primary container logs which is stored in DB, 0 disables it")
 
 	flag.Parse()
 
@@ -65,6 +67,7 @@ func main() {
 	viper.Set(consts.ConfigInjectSecurityContext, injectSecurityContext)
 	viper.Set(consts.ConfigEnableGRPCProbeInSuggestion, enableGRPCProbeInSuggestion)
 	viper.Set(consts.ConfigTrialResources, trialResources)
+	viper.Set(consts.ConfigTrialLogsLimitBytes, trialLogsLimitBytes)
 
 	log.Info("Config:",
 		consts.ConfigExperimentSuggestionName,
@@ -81,6 +84,8 @@ func main() {
 		viper.GetBool(consts.ConfigEnableGRPCProbeInSuggestion),
 		"trial-resources",
 		viper.Get(consts.ConfigTrialResources),
+		consts.ConfigTrialLogsLimitBytes,
+		viper.GetInt(consts.ConfigTrialLogsLimitBytes),
 	)
 
 	// Get a config to talk to the apiserver
@@ -0,0 +1,35 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+ Kubectl-katib is the kubectl plugin to manage Katib Experiments and Trials.
+ Install the binary in PATH as kubectl-katib to run it as kubectl katib.
+ Usage: kubectl katib (create|get|optimal|logs|extend|delete) [flags]
+*/
+package main
+
+import (
+	"os"
+
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+
+	cli "github.com/kubeflow/katib/pkg/cli/v1beta1"
+)
+
+func main() {
+	if err := cli.NewCommand(cli.NewOptions()).Execute(); err != nil {
+		os.Exit(1)
+	}
+}
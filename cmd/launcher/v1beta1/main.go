@@ -0,0 +1,82 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Launcher runs the training process in the Trial primary container.
+
+The init container copies the launcher to the shared volume:
+
+	katib-launcher -copy-to /katib/bin/katib-launcher
+
+The primary container runs the original command with the launcher:
+
+	/katib/bin/katib-launcher -metrics-file /var/log/katib/metrics.log -completed-dir /var/log/katib -- python train.py
+
+If the original container command is not set, -image flag is used to resolve ENTRYPOINT and CMD of the image.
+*/
+package main
+
+import (
+	"flag"
+	"os"
+	"strings"
+
+	"k8s.io/klog"
+
+	launcher "github.com/kubeflow/katib/pkg/launcher/v1beta1"
+)
+
+var (
+	copyTo          = flag.String("copy-to", "", "Copy the launcher binary to the path and exit")
+	image           = flag.String("image", "", "Training container image to resolve ENTRYPOINT and CMD")
+	dockerConfigDir = flag.String("docker-config", "", "Comma-separated directories with config.json to pull the image config, credentials are tried in order")
+	metricsFile     = flag.String("metrics-file", "", "File to copy stdout and stderr of the training process")
+	completedDir    = flag.String("completed-dir", "", "Directory for the completed marker of the training process")
+)
+
+func main() {
+	flag.Parse()
+
+	if *copyTo != "" {
+		if err := launcher.CopyBinary(*copyTo); err != nil {
+			klog.Fatalf("Failed to copy launcher: %v", err)
+		}
+		return
+	}
+
+	if *dockerConfigDir != "" {
+		// Image config is pulled anonymously if credentials are not available
+		if dir, err := launcher.MergeDockerConfigs(strings.Split(*dockerConfigDir, ",")); err != nil {
+			klog.Errorf("Failed to load registry credentials: %v", err)
+		} else {
+			os.Setenv("DOCKER_CONFIG", dir)
+		}
+	}
+	opts := launcher.Options{
+		Command:                flag.Args(),
+		Image:                  *image,
+		MetricsFile:            *metricsFile,
+		CompletedMarkedDirPath: *completedDir,
+	}
+	command, err := launcher.ResolveCommand(opts, launcher.GetRemoteImageConfig)
+	if err != nil {
+		klog.Fatalf("Failed to resolve training command: %v", err)
+	}
+	exitCode, err := launcher.Run(command, opts)
+	if err != nil {
+		klog.Fatalf("Failed to run training process: %v", err)
+	}
+	os.Exit(exitCode)
+}
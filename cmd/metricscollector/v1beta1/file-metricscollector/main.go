@@ -40,28 +40,39 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/hpcloud/tail"
 	"google.golang.org/grpc"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
 	"k8s.io/klog"
 
 	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
 var (
 	managerServiceAddr = flag.String("s", "", "Katib Manager service")
 	trialName          = flag.String("t", "", "Trial Name")
+	trialNamespace     = flag.String("n", "", "Trial Namespace")
+	trialUID           = flag.String("u", "", "Trial UID")
 	metricsFilePath    = flag.String("path", "", "Metrics File Path")
 	metricNames        = flag.String("m", "", "Metric names")
 	metricFilters      = flag.String("f", "", "Metric filters")
 	pollInterval       = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
 	timeout            = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
 	waitAll            = flag.Bool("w", common.DefaultWaitAll, "Whether wait for all other main process of container exiting")
+	stdOutMode         = flag.String("stdout-mode", string(common.StdOutModeLauncher), "How training container output is collected: Launcher, PodLogs or KubeletLogs")
+	containerName      = flag.String("container", "", "Training container name to collect logs in PodLogs and KubeletLogs StdOut mode")
+	kubeletLogDir      = flag.String("kubelet-log-dir", common.KubeletPodLogDir, "Directory of the Trial pod logs mounted from the node")
+	artifactsFilePath  = flag.String("artifacts-path", "", "Artifacts File Path")
 )
 
 func printMetricsFile(mFile string) {
@@ -82,42 +93,107 @@ func printMetricsFile(mFile string) {
 	}
 }
 
+// collectContainerLogs parses metrics from the training container logs while the training is running.
+// Training container command is not changed in this mode.
+func collectContainerLogs(pods typedcorev1.PodInterface, podName string, parser *filemc.LogParser, done <-chan struct{}) error {
+	switch common.StdOutMode(*stdOutMode) {
+	case common.StdOutModePodLogs:
+		return filemc.StreamPodLogs(pods, podName, *containerName, parser, *pollInterval)
+	case common.StdOutModeKubeletLogs:
+		return filemc.TailKubeletLogs(*kubeletLogDir, *containerName, parser, *pollInterval, done)
+	}
+	return fmt.Errorf("Unknown StdOut mode: %v", *stdOutMode)
+}
+
+// getPodsClient returns the client of the Trial pod namespace.
+func getPodsClient() (typedcorev1.PodInterface, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return clientset.CoreV1().Pods(os.Getenv(common.PodNamespaceEnvName)), nil
+}
+
 func main() {
 	flag.Parse()
 	klog.Infof("Trial Name: %s", *trialName)
 
-	go printMetricsFile(*metricsFilePath)
+	var metricList []string
+	if len(*metricNames) != 0 {
+		metricList = strings.Split(*metricNames, ";")
+	}
+	var filterList []string
+	if len(*metricFilters) != 0 {
+		filterList = strings.Split(*metricFilters, ";")
+	}
+
 	wopts := common.WaitPidsOpts{
-		PollInterval:           *pollInterval,
-		Timeout:                *timeout,
-		WaitAll:                *waitAll,
-		CompletedMarkedDirPath: filepath.Dir(*metricsFilePath),
+		PollInterval: *pollInterval,
+		Timeout:      *timeout,
+		WaitAll:      *waitAll,
 	}
-	if err := common.WaitMainProcesses(wopts); err != nil {
-		klog.Fatalf("Failed to wait for worker container: %v", err)
+	var olog *api.ObservationLog
+	if common.StdOutMode(*stdOutMode) == common.StdOutModeLauncher {
+		go printMetricsFile(*metricsFilePath)
+		wopts.CompletedMarkedDirPath = filepath.Dir(*metricsFilePath)
+		if err := common.WaitMainProcesses(wopts); err != nil {
+			klog.Fatalf("Failed to wait for worker container: %v", err)
+		}
+		var err error
+		olog, err = filemc.CollectObservationLog(*metricsFilePath, metricList, filterList)
+		if err != nil {
+			klog.Fatalf("Failed to collect logs: %v", err)
+		}
+	} else {
+		pods, err := getPodsClient()
+		if err != nil {
+			klog.Fatalf("Failed to create Kubernetes client: %v", err)
+		}
+		podName := os.Getenv(common.PodNameEnvName)
+		parser := filemc.NewLogParser(metricList, filterList)
+		done := make(chan struct{})
+		collectErr := make(chan error, 1)
+		go func() {
+			collectErr <- collectContainerLogs(pods, podName, parser, done)
+		}()
+		// Completion is taken from the training container status, since the pod can have other sidecars
+		terminated, err := filemc.WaitContainerTerminated(pods, podName, *containerName, *pollInterval, *timeout)
+		if err != nil {
+			klog.Fatalf("Failed to wait for worker container: %v", err)
+		}
+		close(done)
+		if err := <-collectErr; err != nil {
+			klog.Fatalf("Failed to collect logs: %v", err)
+		}
+		if terminated.ExitCode != 0 {
+			klog.Fatalf("Training container %v exited with code %v, metrics are not reported", *containerName, terminated.ExitCode)
+		}
+		olog = parser.ObservationLog()
 	}
 
-	conn, err := grpc.Dial(*managerServiceAddr, grpc.WithInsecure())
+	tlsOpt, err := grpctls.ConfigFromEnv().DialOption()
+	if err != nil {
+		klog.Fatalf("Failed to load TLS config: %v", err)
+	}
+	conn, err := grpc.Dial(*managerServiceAddr, tlsOpt)
 	if err != nil {
 		klog.Fatalf("could not connect: %v", err)
 	}
 	defer conn.Close()
 	c := api.NewDBManagerClient(conn)
 	ctx := context.Background()
-	var metricList []string
-	if len(*metricNames) != 0 {
-		metricList = strings.Split(*metricNames, ";")
-	}
-	var filterList []string
-	if len(*metricFilters) != 0 {
-		filterList = strings.Split(*metricFilters, ";")
-	}
-	olog, err := filemc.CollectObservationLog(*metricsFilePath, metricList, filterList)
-	if err != nil {
-		klog.Fatalf("Failed to collect logs: %v", err)
+	// Artifacts are reported before metrics, since Trial controller gets them when metrics are available
+	if *artifactsFilePath != "" {
+		reportArtifacts(ctx, c)
 	}
 	reportreq := &api.ReportObservationLogRequest{
 		TrialName:      *trialName,
+		Namespace:      *trialNamespace,
+		TrialUid:       *trialUID,
 		ObservationLog: olog,
 	}
 	_, err = c.ReportObservationLog(ctx, reportreq)
@@ -126,3 +202,27 @@ func main() {
 	}
 	klog.Infof("Metrics reported. :\n%v", olog)
 }
+
+// reportArtifacts reports artifacts from the artifacts file to the DB manager.
+// Artifacts are optional, so errors are only logged and metrics are still reported.
+func reportArtifacts(ctx context.Context, c api.DBManagerClient) {
+	artifacts, err := filemc.CollectArtifacts(*artifactsFilePath)
+	if err != nil {
+		klog.Errorf("Failed to collect artifacts, skipping them: %v", err)
+		return
+	}
+	if len(artifacts) == 0 {
+		return
+	}
+	_, err = c.ReportArtifacts(ctx, &api.ReportArtifactsRequest{
+		TrialName: *trialName,
+		Namespace: *trialNamespace,
+		TrialUid:  *trialUID,
+		Artifacts: artifacts,
+	})
+	if err != nil {
+		klog.Errorf("Failed to Report artifacts, skipping them: %v", err)
+		return
+	}
+	klog.Infof("Artifacts reported. :\n%v", artifacts)
+}
@@ -1,8 +1,8 @@
-import grpc
 import argparse
 import api_pb2
 from pns import WaitMainProcesses
 import const
+import grpc_tls
 from tfevent_loader import MetricsCollector
 from logging import getLogger, StreamHandler, INFO
 
@@ -17,6 +17,8 @@ def parse_options():
 
     parser.add_argument("-s", "--manager_server_addr", type=str, default="")
     parser.add_argument("-t", "--trial_name", type=str, default="")
+    parser.add_argument("-n", "--trial_namespace", type=str, default="")
+    parser.add_argument("-u", "--trial_uid", type=str, default="")
     parser.add_argument("-path", "--metrics_file_dir", type=str, default=const.DEFAULT_METRICS_FILE_DIR)
     parser.add_argument("-m", "--metric_names", type=str, default="")
     parser.add_argument("-f", "--metric_filters", type=str, default="")
@@ -50,7 +52,7 @@ if __name__ == '__main__':
     mc = MetricsCollector(opt.metric_names.split(';'))
     observation_log = mc.parse_file(opt.metrics_file_dir)
 
-    channel = grpc.beta.implementations.insecure_channel(
+    channel = grpc_tls.create_channel(
         manager_server[0], int(manager_server[1]))
 
     with api_pb2.beta_create_DBManager_stub(channel) as client:
@@ -58,5 +60,7 @@ if __name__ == '__main__':
                     str(len(observation_log.metric_logs)) + " metrics will be reported.")
         client.ReportObservationLog(api_pb2.ReportObservationLogRequest(
             trial_name=opt.trial_name,
+            namespace=opt.trial_namespace,
+            trial_uid=opt.trial_uid,
             observation_log=observation_log
         ), timeout=timeout_in_seconds)
@@ -0,0 +1,176 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+ Migrate converts v1alpha3 Experiments, Trials and Suggestions to v1beta1.
+ Objects are read from the YAML file or from the cluster. Converted objects are printed,
+ or created in the cluster with --apply. Observation logs of the Trials are copied from
+ v1alpha3 to v1beta1 Katib DB Manager with --copy-observation-logs.
+ The migration report is printed to stderr, the exit code is 1 if any object isn't migrated.
+ Usage: migrate (-f objects.yaml | --from-cluster [-n namespace]) [--apply] [--copy-observation-logs] [-o yaml|json]
+*/
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"google.golang.org/grpc"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	apis "github.com/kubeflow/katib/pkg/apis/controller"
+	api_pb_v1alpha3 "github.com/kubeflow/katib/pkg/apis/manager/v1alpha3"
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	commonv1alpha3 "github.com/kubeflow/katib/pkg/common/v1alpha3"
+	commonv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/migration"
+)
+
+var (
+	objectsFile         = flag.String("f", "", "Path to the YAML file with v1alpha3 objects, - to read from stdin")
+	fromCluster         = flag.Bool("from-cluster", false, "Read v1alpha3 objects from the cluster")
+	namespace           = flag.String("n", "", "Namespace to read v1alpha3 objects from the cluster, all namespaces if empty")
+	apply               = flag.Bool("apply", false, "Create the converted objects in the cluster instead of printing them")
+	copyObservationLogs = flag.Bool("copy-observation-logs", false, "Copy observation logs of the Trials from v1alpha3 to v1beta1 Katib DB Manager")
+	v1alpha3DBManager   = flag.String("v1alpha3-db-manager-address", commonv1alpha3.GetDBManagerAddr(), "Address of v1alpha3 Katib DB Manager")
+	v1beta1DBManager    = flag.String("v1beta1-db-manager-address", commonv1beta1.GetDBManagerAddr(), "Address of v1beta1 Katib DB Manager")
+	output              = flag.String("o", "yaml", "Output format of the converted objects, yaml or json")
+)
+
+func main() {
+	flag.Parse()
+	if (*objectsFile == "") == !*fromCluster {
+		klog.Fatal("Either -f or --from-cluster flag must be specified")
+	}
+
+	var c client.Client
+	if *fromCluster || *apply {
+		c = newClient()
+	}
+
+	report := migration.NewReport()
+	var objects *migration.Objects
+	var err error
+	if *fromCluster {
+		objects, err = migration.ListObjects(c, *namespace)
+	} else {
+		objects, err = readObjects(*objectsFile, report)
+	}
+	if err != nil {
+		klog.Fatal(err)
+	}
+
+	m := migration.Convert(objects, report)
+	if *apply {
+		m.Apply(c)
+	}
+	if *copyObservationLogs {
+		src, dst := newDBManagerClients()
+		m.CopyObservationLogs(src, dst)
+	}
+	if !*apply {
+		if err := printObjects(os.Stdout, m.Objects()); err != nil {
+			klog.Fatal(err)
+		}
+	}
+
+	if err := report.Print(os.Stderr); err != nil {
+		klog.Fatalf("Failed to print migration report: %v", err)
+	}
+	if report.Failed() {
+		os.Exit(1)
+	}
+}
+
+// newClient creates the client with both v1alpha3 and v1beta1 Katib APIs.
+func newClient() client.Client {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		klog.Fatalf("Failed to get Kubernetes config: %v", err)
+	}
+	if err := apis.AddToScheme(clientgoscheme.Scheme); err != nil {
+		klog.Fatalf("Failed to add Katib APIs to scheme: %v", err)
+	}
+	c, err := client.New(cfg, client.Options{Scheme: clientgoscheme.Scheme})
+	if err != nil {
+		klog.Fatalf("Failed to create Kubernetes client: %v", err)
+	}
+	return c
+}
+
+func readObjects(path string, report *migration.Report) (*migration.Objects, error) {
+	if path == "-" {
+		return migration.DecodeObjects(os.Stdin, report)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read %v: %v", path, err)
+	}
+	defer f.Close()
+	return migration.DecodeObjects(f, report)
+}
+
+// newDBManagerClients connects to v1alpha3 Katib DB Manager without TLS and to
+// v1beta1 Katib DB Manager with TLS from the environment variables.
+func newDBManagerClients() (api_pb_v1alpha3.ManagerClient, api_pb_v1beta1.DBManagerClient) {
+	srcConn, err := grpc.Dial(*v1alpha3DBManager, grpc.WithInsecure())
+	if err != nil {
+		klog.Fatalf("Failed to connect to v1alpha3 Katib DB Manager %v: %v", *v1alpha3DBManager, err)
+	}
+	tlsOpt, err := grpctls.ConfigFromEnv().DialOption()
+	if err != nil {
+		klog.Fatalf("Failed to load TLS configuration: %v", err)
+	}
+	dstConn, err := grpc.Dial(*v1beta1DBManager, tlsOpt)
+	if err != nil {
+		klog.Fatalf("Failed to connect to v1beta1 Katib DB Manager %v: %v", *v1beta1DBManager, err)
+	}
+	return api_pb_v1alpha3.NewManagerClient(srcConn), api_pb_v1beta1.NewDBManagerClient(dstConn)
+}
+
+func printObjects(w io.Writer, objects []interface{}) error {
+	switch *output {
+	case "json":
+		out, err := json.MarshalIndent(map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "List",
+			"items":      objects,
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("Failed to marshal objects: %v", err)
+		}
+		fmt.Fprintln(w, string(out))
+	case "yaml":
+		for _, obj := range objects {
+			out, err := yaml.Marshal(obj)
+			if err != nil {
+				return fmt.Errorf("Failed to marshal objects: %v", err)
+			}
+			fmt.Fprintf(w, "---\n%s", out)
+		}
+	default:
+		return fmt.Errorf("Unknown output format: %v", *output)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+ Promote-best-trial renders the Trial template of the Experiment with the
+ parameter assignments of the best Trial and creates it as a standalone job.
+ Flags override spec.promoteBestTrial of the Experiment.
+ Usage: promote-best-trial -e experiment [-n namespace] [--name job] [--set param=value] [--patch json] [--dry-run] [-o yaml|json]
+*/
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/manifest"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/promotion"
+)
+
+// assignmentsFlag collects repeated --set param=value flags.
+type assignmentsFlag []commonv1beta1.ParameterAssignment
+
+func (a *assignmentsFlag) String() string {
+	s := make([]string, 0, len(*a))
+	for _, assignment := range *a {
+		s = append(s, assignment.Name+"="+assignment.Value)
+	}
+	return strings.Join(s, ",")
+}
+
+func (a *assignmentsFlag) Set(value string) error {
+	kv := strings.SplitN(value, "=", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return fmt.Errorf("expected param=value, got %v", value)
+	}
+	*a = append(*a, commonv1beta1.ParameterAssignment{Name: kv[0], Value: kv[1]})
+	return nil
+}
+
+var (
+	experimentName = flag.String("e", "", "Name of the Experiment")
+	namespace      = flag.String("n", "default", "Namespace of the Experiment")
+	jobName        = flag.String("name", "", "Name of the job, defaults to the Experiment name with -best suffix")
+	runSpecPatch   = flag.String("patch", "", "JSON merge patch applied to the rendered job")
+	dryRun         = flag.Bool("dry-run", false, "Print the rendered job without creating it")
+	output         = flag.String("o", "yaml", "Output format of the rendered job with --dry-run, yaml or json")
+	overrides      assignmentsFlag
+)
+
+func main() {
+	flag.Var(&overrides, "set", "Override the best Trial parameter assignment, param=value, can be repeated")
+	flag.Parse()
+	if *experimentName == "" {
+		klog.Fatal("Experiment name must be specified with -e flag")
+	}
+
+	kclient, err := katibclient.NewClient(client.Options{})
+	if err != nil {
+		klog.Fatalf("Failed to create Katib client: %v", err)
+	}
+	experiment, err := kclient.GetExperiment(*experimentName, *namespace)
+	if err != nil {
+		klog.Fatalf("Failed to get Experiment %v: %v", *experimentName, err)
+	}
+
+	spec := &experimentsv1beta1.PromotionSpec{}
+	if experiment.Spec.PromoteBestTrial != nil {
+		spec = experiment.Spec.PromoteBestTrial.DeepCopy()
+	}
+	if *jobName != "" {
+		spec.Name = *jobName
+	}
+	spec.ParameterOverrides = append(spec.ParameterOverrides, overrides...)
+	if *runSpecPatch != "" {
+		spec.RunSpecPatch = *runSpecPatch
+	}
+
+	promoter := promotion.New(kclient.GetClient(), manifest.New(kclient.GetClient()))
+	if *dryRun {
+		job, err := promoter.RenderBestTrialJob(experiment, spec)
+		if err != nil {
+			klog.Fatalf("Failed to render the best trial job: %v", err)
+		}
+		var out []byte
+		switch *output {
+		case "json":
+			out, err = json.MarshalIndent(job.Object, "", "  ")
+		case "yaml":
+			out, err = yaml.Marshal(job.Object)
+		default:
+			klog.Fatalf("Unknown output format: %v", *output)
+		}
+		if err != nil {
+			klog.Fatalf("Failed to marshal job: %v", err)
+		}
+		fmt.Println(string(out))
+		return
+	}
+
+	job, err := promoter.PromoteBestTrial(experiment, spec)
+	if err != nil {
+		klog.Fatalf("Failed to promote the best trial: %v", err)
+	}
+	fmt.Printf("%v %v/%v created from trial %v\n", job.GetKind(), job.GetNamespace(), job.GetName(),
+		experiment.Status.CurrentOptimalTrial.BestTrialName)
+}
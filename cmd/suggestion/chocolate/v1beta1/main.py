@@ -3,6 +3,7 @@ import time
 from pkg.apis.manager.v1beta1.python import api_pb2_grpc
 from pkg.apis.manager.health.python import health_pb2_grpc
 from pkg.suggestion.v1beta1.chocolate.service import ChocolateService
+from pkg.suggestion.v1beta1.internal import grpc_tls
 from concurrent import futures
 
 _ONE_DAY_IN_SECONDS = 60 * 60 * 24
@@ -14,7 +15,7 @@ def serve():
     service = ChocolateService()
     api_pb2_grpc.add_SuggestionServicer_to_server(service, server)
     health_pb2_grpc.add_HealthServicer_to_server(service, server)
-    server.add_insecure_port(DEFAULT_PORT)
+    grpc_tls.add_port(server, DEFAULT_PORT)
     print("Listening...")
     server.start()
     try:
@@ -4,6 +4,7 @@ import time
 from pkg.apis.manager.v1beta1.python import api_pb2_grpc
 from pkg.apis.manager.health.python import health_pb2_grpc
 from pkg.suggestion.v1beta1.nas.darts.service import DartsService
+from pkg.suggestion.v1beta1.internal import grpc_tls
 
 
 _ONE_DAY_IN_SECONDS = 60 * 60 * 24
@@ -16,7 +17,7 @@ def serve():
     service = DartsService()
     api_pb2_grpc.add_SuggestionServicer_to_server(service, server)
     health_pb2_grpc.add_HealthServicer_to_server(service, server)
-    server.add_insecure_port(DEFAULT_PORT)
+    grpc_tls.add_port(server, DEFAULT_PORT)
     print("Listening...")
     server.start()
     try:
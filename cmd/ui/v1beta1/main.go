@@ -14,6 +14,7 @@ import (
 
 var (
 	port, host, buildDir, dbManagerAddr *string
+	userHeader, userPrefix              *string
 )
 
 func init() {
@@ -21,11 +22,19 @@ func init() {
 	host = flag.String("host", "0.0.0.0", "The host to listen to for incoming HTTP connections")
 	buildDir = flag.String("build-dir", "/app/build", "The dir of frontend")
 	dbManagerAddr = flag.String("db-manager-address", common_v1beta1.GetDBManagerAddr(), "The address of Katib DB manager")
+	userHeader = flag.String("user-header", "", "The request header with the user identity, e.g. kubeflow-userid. If empty, requests are not authorized")
+	userPrefix = flag.String("user-prefix", "", "The prefix which is trimmed from the user header value, e.g. accounts.google.com:")
 }
 
 func main() {
 	flag.Parse()
-	kuh := ui.NewKatibUIHandler(*dbManagerAddr)
+	if *userHeader == "" {
+		log.Printf("WARNING: --user-header is not set, requests are not authorized and run with the permissions of the UI service account")
+	}
+	kuh := ui.NewKatibUIHandler(*dbManagerAddr, ui.AuthConfig{
+		UserHeader: *userHeader,
+		UserPrefix: *userPrefix,
+	})
 
 	log.Printf("Serving the frontend dir %s", *buildDir)
 	frontend := http.FileServer(http.Dir(*buildDir))
@@ -36,6 +45,7 @@ func main() {
 	http.HandleFunc("/katib/submit_yaml/", kuh.SubmitYamlJob)
 	http.HandleFunc("/katib/submit_hp_job/", kuh.SubmitParamsJob)
 	http.HandleFunc("/katib/submit_nas_job/", kuh.SubmitParamsJob)
+	http.HandleFunc("/katib/dry_run_trial_template/", kuh.DryRunTrialTemplate)
 
 	http.HandleFunc("/katib/delete_experiment/", kuh.DeleteExperiment)
 
@@ -44,6 +54,7 @@ func main() {
 
 	http.HandleFunc("/katib/fetch_hp_job_info/", kuh.FetchHPJobInfo)
 	http.HandleFunc("/katib/fetch_hp_job_trial_info/", kuh.FetchHPJobTrialInfo)
+	http.HandleFunc("/katib/fetch_hp_job_trial_logs/", kuh.FetchHPJobTrialLogs)
 	http.HandleFunc("/katib/fetch_nas_job_info/", kuh.FetchNASJobInfo)
 
 	http.HandleFunc("/katib/fetch_trial_templates/", kuh.FetchTrialTemplates)
@@ -52,6 +63,8 @@ func main() {
 	http.HandleFunc("/katib/delete_template/", kuh.DeleteTemplate)
 	http.HandleFunc("/katib/fetch_namespaces", kuh.FetchNamespaces)
 
+	http.HandleFunc(ui.APIPrefix, kuh.ServeAPI)
+
 	log.Printf("Serving at %s:%s", *host, *port)
 	if err := http.ListenAndServe(fmt.Sprintf("%s:%s", *host, *port), nil); err != nil {
 		panic(err)
@@ -85,6 +85,8 @@ if __name__ == '__main__':
     parser.add_argument('--add_stn',  action="store_true", default=False,
                         help='Add Spatial Transformer Network Layer (lenet only)')
     parser.add_argument('--image_shape', default='1, 28, 28', help='shape of training images')
+    parser.add_argument('--seed', type=int, default=None,
+                        help='random seed, e.g. the seed of the repeated trial')
 
     fit.add_fit_args(parser)
     parser.set_defaults(
@@ -99,6 +101,9 @@ if __name__ == '__main__':
         lr_step_epochs='10'
     )
     args = parser.parse_args()
+    if args.seed is not None:
+        mx.random.seed(args.seed)
+        np.random.seed(args.seed)
 
     # load mlp network
     sym = mlp.get_symbol(**vars(args))
@@ -75,11 +75,34 @@ const (
 	ExtractByMin    MetricStrategyType = "min"
 	ExtractByMax    MetricStrategyType = "max"
 	ExtractByLatest MetricStrategyType = "latest"
+	// ExtractByAvg is the mean of all metric values.
+	ExtractByAvg MetricStrategyType = "avg"
+	// ExtractByLastNAvg is the mean of the last N metric values.
+	ExtractByLastNAvg MetricStrategyType = "lastNAvg"
+	// ExtractByMedian is the median of all metric values.
+	ExtractByMedian MetricStrategyType = "median"
+	// ExtractByPercentile is the percentile of all metric values.
+	ExtractByPercentile MetricStrategyType = "percentile"
 )
 
+// IsComputedFromLogs returns true if the strategy value is computed from all metric values
+// of the observation log and stored in Metric.Value.
+func (s MetricStrategyType) IsComputedFromLogs() bool {
+	switch s {
+	case ExtractByAvg, ExtractByLastNAvg, ExtractByMedian, ExtractByPercentile:
+		return true
+	}
+	return false
+}
+
+// +k8s:deepcopy-gen=true
 type MetricStrategy struct {
 	Name  string             `json:"name,omitempty"`
 	Value MetricStrategyType `json:"value,omitempty"`
+	// Number of the last metric values for lastNAvg strategy. Defaults to 10.
+	LastN int32 `json:"lastN,omitempty"`
+	// Percentile from 0 to 100 for percentile strategy.
+	Percentile *float64 `json:"percentile,omitempty"`
 }
 
 type Metric struct {
@@ -87,6 +110,8 @@ type Metric struct {
 	Min    string `json:"min,omitempty"`
 	Max    string `json:"max,omitempty"`
 	Latest string `json:"latest,omitempty"`
+	// Value computed from the observation log by avg, lastNAvg, median or percentile strategy.
+	Value string `json:"value,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -95,6 +120,16 @@ type Observation struct {
 	Metrics []Metric `json:"metrics"`
 }
 
+// +k8s:deepcopy-gen=true
+// Artifact is a checkpoint, model or other output written by a Trial.
+type Artifact struct {
+	Name string `json:"name"`
+	// Location of the artifact, e.g. s3://bucket/model or /mnt/models/trial-1.
+	URI string `json:"uri"`
+	// Key-value pairs describing the artifact, e.g. framework or format.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
 // +k8s:deepcopy-gen=true
 type MetricsCollectorSpec struct {
 	Source    *SourceSpec    `json:"source,omitempty"`
@@ -65,6 +65,29 @@ func (in *AlgorithmSpec) DeepCopy() *AlgorithmSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Artifact) DeepCopyInto(out *Artifact) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Artifact.
+func (in *Artifact) DeepCopy() *Artifact {
+	if in == nil {
+		return nil
+	}
+	out := new(Artifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CollectorSpec) DeepCopyInto(out *CollectorSpec) {
 	*out = *in
@@ -179,6 +202,11 @@ func (in *Metric) DeepCopy() *Metric {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricStrategy) DeepCopyInto(out *MetricStrategy) {
 	*out = *in
+	if in.Percentile != nil {
+		in, out := &in.Percentile, &out.Percentile
+		*out = new(float64)
+		**out = **in
+	}
 	return
 }
 
@@ -234,7 +262,9 @@ func (in *ObjectiveSpec) DeepCopyInto(out *ObjectiveSpec) {
 	if in.MetricStrategies != nil {
 		in, out := &in.MetricStrategies, &out.MetricStrategies
 		*out = make([]MetricStrategy, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -0,0 +1,76 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AnnotationV1alpha3Data is the annotation of the converted v1beta1 resource with the spec and status
+	// of the source v1alpha3 resource. It is set only if the conversion back doesn't restore the source.
+	AnnotationV1alpha3Data = "conversion.katib.kubeflow.org/v1alpha3-data"
+	// AnnotationV1beta1Data is the annotation of the converted v1alpha3 resource with the spec and status
+	// of the source v1beta1 resource. It is set only if the conversion back doesn't restore the source.
+	AnnotationV1beta1Data = "conversion.katib.kubeflow.org/v1beta1-data"
+)
+
+// convertObjectMeta copies the object meta without the conversion data annotation of the target version.
+func convertObjectMeta(in metav1.ObjectMeta, dataAnnotation string) metav1.ObjectMeta {
+	out := *in.DeepCopy()
+	if _, ok := out.Annotations[dataAnnotation]; ok {
+		delete(out.Annotations, dataAnnotation)
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	}
+	return out
+}
+
+// getConversionData decodes the conversion data annotation. It returns false if the annotation is not set.
+func getConversionData(meta metav1.ObjectMeta, dataAnnotation string, data interface{}) (bool, error) {
+	value, ok := meta.Annotations[dataAnnotation]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(value), data); err != nil {
+		return false, fmt.Errorf("Unable to decode %v annotation: %v", dataAnnotation, err)
+	}
+	return true, nil
+}
+
+func setConversionData(meta *metav1.ObjectMeta, dataAnnotation string, data interface{}) error {
+	value, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("Unable to encode %v annotation: %v", dataAnnotation, err)
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	meta.Annotations[dataAnnotation] = string(value)
+	return nil
+}
+
+// jsonEqual compares the serialized values, so nil and empty fields with omitempty tag are equal.
+func jsonEqual(a, b interface{}) bool {
+	aBytes, errA := json.Marshal(a)
+	bBytes, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(aBytes, bBytes)
+}
@@ -0,0 +1,267 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion converts Katib resources between v1alpha3 and v1beta1 API versions.
+// v1beta1 is the storage version. Fields which can't be represented in the target version
+// are kept in the conversion annotation, so objects are not changed by the round-trip conversion.
+package conversion
+
+import (
+	"math"
+	"strconv"
+
+	commonv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/common/v1alpha3"
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+)
+
+func convertAlgorithmSpecToV1beta1(in *commonv1alpha3.AlgorithmSpec) *commonv1beta1.AlgorithmSpec {
+	if in == nil {
+		return nil
+	}
+	out := &commonv1beta1.AlgorithmSpec{
+		AlgorithmName: in.AlgorithmName,
+	}
+	for _, s := range in.AlgorithmSettings {
+		out.AlgorithmSettings = append(out.AlgorithmSettings, commonv1beta1.AlgorithmSetting{Name: s.Name, Value: s.Value})
+	}
+	if in.EarlyStopping != nil {
+		out.EarlyStopping = &commonv1beta1.EarlyStoppingSpec{
+			EarlyStoppingAlgorithmName: in.EarlyStopping.EarlyStoppingAlgorithmName,
+		}
+		if in.EarlyStopping.EarlyStoppingSettings != nil {
+			out.EarlyStopping.EarlyStoppingSettings = make([]commonv1beta1.EarlyStoppingSetting, 0, len(in.EarlyStopping.EarlyStoppingSettings))
+		}
+		for _, s := range in.EarlyStopping.EarlyStoppingSettings {
+			out.EarlyStopping.EarlyStoppingSettings = append(out.EarlyStopping.EarlyStoppingSettings,
+				commonv1beta1.EarlyStoppingSetting{Name: s.Name, Value: s.Value})
+		}
+	}
+	return out
+}
+
+func convertAlgorithmSpecToV1alpha3(in *commonv1beta1.AlgorithmSpec) *commonv1alpha3.AlgorithmSpec {
+	if in == nil {
+		return nil
+	}
+	out := &commonv1alpha3.AlgorithmSpec{
+		AlgorithmName: in.AlgorithmName,
+	}
+	for _, s := range in.AlgorithmSettings {
+		out.AlgorithmSettings = append(out.AlgorithmSettings, commonv1alpha3.AlgorithmSetting{Name: s.Name, Value: s.Value})
+	}
+	if in.EarlyStopping != nil {
+		out.EarlyStopping = &commonv1alpha3.EarlyStoppingSpec{
+			EarlyStoppingAlgorithmName: in.EarlyStopping.EarlyStoppingAlgorithmName,
+		}
+		if in.EarlyStopping.EarlyStoppingSettings != nil {
+			out.EarlyStopping.EarlyStoppingSettings = make([]commonv1alpha3.EarlyStoppingSetting, 0, len(in.EarlyStopping.EarlyStoppingSettings))
+		}
+		for _, s := range in.EarlyStopping.EarlyStoppingSettings {
+			out.EarlyStopping.EarlyStoppingSettings = append(out.EarlyStopping.EarlyStoppingSettings,
+				commonv1alpha3.EarlyStoppingSetting{Name: s.Name, Value: s.Value})
+		}
+	}
+	return out
+}
+
+func convertObjectiveSpecToV1beta1(in *commonv1alpha3.ObjectiveSpec) *commonv1beta1.ObjectiveSpec {
+	if in == nil {
+		return nil
+	}
+	out := &commonv1beta1.ObjectiveSpec{
+		Type:                  commonv1beta1.ObjectiveType(in.Type),
+		ObjectiveMetricName:   in.ObjectiveMetricName,
+		AdditionalMetricNames: append([]string(nil), in.AdditionalMetricNames...),
+	}
+	if in.Goal != nil {
+		goal := *in.Goal
+		out.Goal = &goal
+	}
+	return out
+}
+
+// convertObjectiveSpecToV1alpha3 drops metric strategies, v1alpha3 uses the best objective metric value.
+func convertObjectiveSpecToV1alpha3(in *commonv1beta1.ObjectiveSpec) *commonv1alpha3.ObjectiveSpec {
+	if in == nil {
+		return nil
+	}
+	out := &commonv1alpha3.ObjectiveSpec{
+		Type:                  commonv1alpha3.ObjectiveType(in.Type),
+		ObjectiveMetricName:   in.ObjectiveMetricName,
+		AdditionalMetricNames: append([]string(nil), in.AdditionalMetricNames...),
+	}
+	if in.Goal != nil {
+		goal := *in.Goal
+		out.Goal = &goal
+	}
+	return out
+}
+
+func convertParameterAssignmentsToV1beta1(in []commonv1alpha3.ParameterAssignment) []commonv1beta1.ParameterAssignment {
+	if in == nil {
+		return nil
+	}
+	out := make([]commonv1beta1.ParameterAssignment, 0, len(in))
+	for _, a := range in {
+		out = append(out, commonv1beta1.ParameterAssignment{Name: a.Name, Value: a.Value})
+	}
+	return out
+}
+
+func convertParameterAssignmentsToV1alpha3(in []commonv1beta1.ParameterAssignment) []commonv1alpha3.ParameterAssignment {
+	if in == nil {
+		return nil
+	}
+	out := make([]commonv1alpha3.ParameterAssignment, 0, len(in))
+	for _, a := range in {
+		out = append(out, commonv1alpha3.ParameterAssignment{Name: a.Name, Value: a.Value})
+	}
+	return out
+}
+
+// metricStrategy returns the strategy of the metric from the objective or the default one:
+// min or max of the objective metric by the objective type and latest of the additional metrics.
+func metricStrategy(objective *commonv1beta1.ObjectiveSpec, metricName string) commonv1beta1.MetricStrategyType {
+	if objective == nil {
+		return commonv1beta1.ExtractByLatest
+	}
+	for _, strategy := range objective.MetricStrategies {
+		if strategy.Name == metricName {
+			return strategy.Value
+		}
+	}
+	if metricName == objective.ObjectiveMetricName {
+		switch objective.Type {
+		case commonv1beta1.ObjectiveTypeMinimize:
+			return commonv1beta1.ExtractByMin
+		case commonv1beta1.ObjectiveTypeMaximize:
+			return commonv1beta1.ExtractByMax
+		}
+	}
+	return commonv1beta1.ExtractByLatest
+}
+
+// convertObservationToV1beta1 sets the v1alpha3 metric value to the field of the v1beta1 metric
+// which is used by the metric strategy.
+func convertObservationToV1beta1(in *commonv1alpha3.Observation, objective *commonv1beta1.ObjectiveSpec) *commonv1beta1.Observation {
+	if in == nil {
+		return nil
+	}
+	out := &commonv1beta1.Observation{}
+	if in.Metrics != nil {
+		out.Metrics = make([]commonv1beta1.Metric, 0, len(in.Metrics))
+	}
+	for _, m := range in.Metrics {
+		metric := commonv1beta1.Metric{Name: m.Name}
+		value := strconv.FormatFloat(m.Value, 'f', -1, 64)
+		switch metricStrategy(objective, m.Name) {
+		case commonv1beta1.ExtractByMin:
+			metric.Min = value
+		case commonv1beta1.ExtractByMax:
+			metric.Max = value
+		case commonv1beta1.ExtractByLatest:
+			metric.Latest = value
+		default:
+			metric.Value = value
+		}
+		out.Metrics = append(out.Metrics, metric)
+	}
+	return out
+}
+
+// convertObservationToV1alpha3 uses the v1beta1 metric value by the metric strategy.
+// Metrics which are not reported are converted to zero values.
+func convertObservationToV1alpha3(in *commonv1beta1.Observation, objective *commonv1beta1.ObjectiveSpec) *commonv1alpha3.Observation {
+	if in == nil {
+		return nil
+	}
+	out := &commonv1alpha3.Observation{}
+	if in.Metrics != nil {
+		out.Metrics = make([]commonv1alpha3.Metric, 0, len(in.Metrics))
+	}
+	for _, m := range in.Metrics {
+		var value string
+		switch metricStrategy(objective, m.Name) {
+		case commonv1beta1.ExtractByMin:
+			value = m.Min
+		case commonv1beta1.ExtractByMax:
+			value = m.Max
+		case commonv1beta1.ExtractByLatest:
+			value = m.Latest
+		default:
+			value = m.Value
+		}
+		metric := commonv1alpha3.Metric{Name: m.Name}
+		if v, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(v) && !math.IsInf(v, 0) {
+			metric.Value = v
+		}
+		out.Metrics = append(out.Metrics, metric)
+	}
+	return out
+}
+
+func convertMetricsCollectorSpecToV1beta1(in commonv1alpha3.MetricsCollectorSpec) commonv1beta1.MetricsCollectorSpec {
+	out := commonv1beta1.MetricsCollectorSpec{}
+	if in.Source != nil {
+		out.Source = &commonv1beta1.SourceSpec{
+			HttpGet: in.Source.HttpGet.DeepCopy(),
+		}
+		if in.Source.FileSystemPath != nil {
+			out.Source.FileSystemPath = &commonv1beta1.FileSystemPath{
+				Path: in.Source.FileSystemPath.Path,
+				Kind: commonv1beta1.FileSystemKind(in.Source.FileSystemPath.Kind),
+			}
+		}
+		if in.Source.Filter != nil {
+			out.Source.Filter = &commonv1beta1.FilterSpec{
+				MetricsFormat: append([]string(nil), in.Source.Filter.MetricsFormat...),
+			}
+		}
+	}
+	if in.Collector != nil {
+		out.Collector = &commonv1beta1.CollectorSpec{
+			Kind:            commonv1beta1.CollectorKind(in.Collector.Kind),
+			CustomCollector: in.Collector.CustomCollector.DeepCopy(),
+		}
+	}
+	return out
+}
+
+func convertMetricsCollectorSpecToV1alpha3(in commonv1beta1.MetricsCollectorSpec) commonv1alpha3.MetricsCollectorSpec {
+	out := commonv1alpha3.MetricsCollectorSpec{}
+	if in.Source != nil {
+		out.Source = &commonv1alpha3.SourceSpec{
+			HttpGet: in.Source.HttpGet.DeepCopy(),
+		}
+		if in.Source.FileSystemPath != nil {
+			out.Source.FileSystemPath = &commonv1alpha3.FileSystemPath{
+				Path: in.Source.FileSystemPath.Path,
+				Kind: commonv1alpha3.FileSystemKind(in.Source.FileSystemPath.Kind),
+			}
+		}
+		if in.Source.Filter != nil {
+			out.Source.Filter = &commonv1alpha3.FilterSpec{
+				MetricsFormat: append([]string(nil), in.Source.Filter.MetricsFormat...),
+			}
+		}
+	}
+	if in.Collector != nil {
+		out.Collector = &commonv1alpha3.CollectorSpec{
+			Kind:            commonv1alpha3.CollectorKind(in.Collector.Kind),
+			CustomCollector: in.Collector.CustomCollector.DeepCopy(),
+		}
+	}
+	return out
+}
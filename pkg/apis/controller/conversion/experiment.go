@@ -0,0 +1,389 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"fmt"
+
+	experimentsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1alpha3"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+type experimentV1alpha3Data struct {
+	Spec   experimentsv1alpha3.ExperimentSpec   `json:"spec,omitempty"`
+	Status experimentsv1alpha3.ExperimentStatus `json:"status,omitempty"`
+}
+
+type experimentV1beta1Data struct {
+	Spec   experimentsv1beta1.ExperimentSpec   `json:"spec,omitempty"`
+	Status experimentsv1beta1.ExperimentStatus `json:"status,omitempty"`
+}
+
+// ConvertExperimentToV1beta1 converts the v1alpha3 Experiment to v1beta1.
+// The Go Trial template is converted to the Trial spec with trial parameters for all experiment parameters.
+// It returns error if the Trial template can't be converted.
+func ConvertExperimentToV1beta1(in *experimentsv1alpha3.Experiment) (*experimentsv1beta1.Experiment, error) {
+	out, err := convertExperimentToV1beta1(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore v1beta1 fields which were lost by the conversion to v1alpha3
+	data := &experimentV1beta1Data{}
+	if ok, err := getConversionData(in.ObjectMeta, AnnotationV1beta1Data, data); err != nil {
+		return nil, err
+	} else if ok {
+		restoreExperimentV1beta1Fields(in, out, data)
+	}
+
+	// Keep v1alpha3 fields which are lost by the conversion back
+	if back := convertExperimentToV1alpha3(out); !jsonEqual(back.Spec, in.Spec) || !jsonEqual(back.Status, in.Status) {
+		if err := setConversionData(&out.ObjectMeta, AnnotationV1alpha3Data, &experimentV1alpha3Data{Spec: in.Spec, Status: in.Status}); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// ConvertExperimentToV1alpha3 converts the v1beta1 Experiment to v1alpha3.
+// The Trial spec is converted to the Go Trial template, fields which don't exist in v1alpha3 are dropped.
+func ConvertExperimentToV1alpha3(in *experimentsv1beta1.Experiment) (*experimentsv1alpha3.Experiment, error) {
+	out := convertExperimentToV1alpha3(in)
+
+	// Restore v1alpha3 fields which were lost by the conversion to v1beta1
+	data := &experimentV1alpha3Data{}
+	if ok, err := getConversionData(in.ObjectMeta, AnnotationV1alpha3Data, data); err != nil {
+		return nil, err
+	} else if ok {
+		restoreExperimentV1alpha3Fields(in, out, data)
+	}
+
+	// Keep v1beta1 fields which are lost by the conversion back
+	if back, err := convertExperimentToV1beta1(out); err != nil || !jsonEqual(back.Spec, in.Spec) || !jsonEqual(back.Status, in.Status) {
+		if err := setConversionData(&out.ObjectMeta, AnnotationV1beta1Data, &experimentV1beta1Data{Spec: in.Spec, Status: in.Status}); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// restoreExperimentV1beta1Fields restores the fields from the source v1beta1 Experiment
+// if their v1alpha3 values are not changed after the conversion.
+func restoreExperimentV1beta1Fields(in *experimentsv1alpha3.Experiment, out *experimentsv1beta1.Experiment, data *experimentV1beta1Data) {
+	source := convertExperimentToV1alpha3(&experimentsv1beta1.Experiment{Spec: data.Spec, Status: data.Status})
+	if jsonEqual(source.Spec.Parameters, in.Spec.Parameters) {
+		out.Spec.Parameters = data.Spec.Parameters
+	}
+	if jsonEqual(source.Spec.Objective, in.Spec.Objective) {
+		out.Spec.Objective = data.Spec.Objective
+	}
+	if jsonEqual(source.Spec.TrialTemplate, in.Spec.TrialTemplate) {
+		out.Spec.TrialTemplate = data.Spec.TrialTemplate
+	}
+	if jsonEqual(source.Spec.NasConfig, in.Spec.NasConfig) {
+		out.Spec.NasConfig = data.Spec.NasConfig
+	}
+	if jsonEqual(source.Status.CurrentOptimalTrial, in.Status.CurrentOptimalTrial) {
+		out.Status.CurrentOptimalTrial = data.Status.CurrentOptimalTrial
+	}
+	out.Spec.Notifications = data.Spec.Notifications
+	out.Spec.PromoteBestTrial = data.Spec.PromoteBestTrial
+	out.Spec.Repetitions = data.Spec.Repetitions
+	out.Status.PromotedJob = data.Status.PromotedJob
+}
+
+// restoreExperimentV1alpha3Fields restores the fields from the source v1alpha3 Experiment
+// if their v1beta1 values are not changed after the conversion.
+func restoreExperimentV1alpha3Fields(in *experimentsv1beta1.Experiment, out *experimentsv1alpha3.Experiment, data *experimentV1alpha3Data) {
+	source, err := convertExperimentToV1beta1(&experimentsv1alpha3.Experiment{Spec: data.Spec, Status: data.Status})
+	if err != nil {
+		return
+	}
+	if jsonEqual(source.Spec.TrialTemplate, in.Spec.TrialTemplate) {
+		out.Spec.TrialTemplate = data.Spec.TrialTemplate
+	}
+	if jsonEqual(source.Status.CurrentOptimalTrial, in.Status.CurrentOptimalTrial) {
+		out.Status.CurrentOptimalTrial = data.Status.CurrentOptimalTrial
+	}
+}
+
+func convertExperimentToV1beta1(in *experimentsv1alpha3.Experiment) (*experimentsv1beta1.Experiment, error) {
+	out := &experimentsv1beta1.Experiment{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: convertObjectMeta(in.ObjectMeta, AnnotationV1beta1Data),
+	}
+	out.APIVersion = experimentsv1beta1.SchemeGroupVersion.String()
+
+	spec := in.Spec
+	out.Spec = experimentsv1beta1.ExperimentSpec{
+		Parameters:          convertParametersToV1beta1(spec.Parameters),
+		Objective:           convertObjectiveSpecToV1beta1(spec.Objective),
+		Algorithm:           convertAlgorithmSpecToV1beta1(spec.Algorithm),
+		ParallelTrialCount:  copyInt32(spec.ParallelTrialCount),
+		MaxTrialCount:       copyInt32(spec.MaxTrialCount),
+		MaxFailedTrialCount: copyInt32(spec.MaxFailedTrialCount),
+		ResumePolicy:        experimentsv1beta1.ResumePolicyType(spec.ResumePolicy),
+	}
+	if spec.MetricsCollectorSpec != nil {
+		mc := convertMetricsCollectorSpecToV1beta1(*spec.MetricsCollectorSpec)
+		out.Spec.MetricsCollectorSpec = &mc
+	}
+	if spec.NasConfig != nil {
+		out.Spec.NasConfig = &experimentsv1beta1.NasConfig{
+			GraphConfig: experimentsv1beta1.GraphConfig{
+				NumLayers:   copyInt32(spec.NasConfig.GraphConfig.NumLayers),
+				InputSizes:  append([]int32(nil), spec.NasConfig.GraphConfig.InputSizes...),
+				OutputSizes: append([]int32(nil), spec.NasConfig.GraphConfig.OutputSizes...),
+			},
+		}
+		for _, op := range spec.NasConfig.Operations {
+			out.Spec.NasConfig.Operations = append(out.Spec.NasConfig.Operations, experimentsv1beta1.Operation{
+				OperationType: op.OperationType,
+				Parameters:    convertParametersToV1beta1(op.Parameters),
+			})
+		}
+	}
+	if spec.TrialTemplate != nil {
+		trialTemplate, err := convertTrialTemplateToV1beta1(spec.TrialTemplate, spec.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to convert spec.trialTemplate of Experiment %v/%v: %v", in.Namespace, in.Name, err)
+		}
+		out.Spec.TrialTemplate = trialTemplate
+	}
+
+	status := in.Status
+	out.Status = experimentsv1beta1.ExperimentStatus{
+		StartTime:          status.StartTime.DeepCopy(),
+		CompletionTime:     status.CompletionTime.DeepCopy(),
+		LastReconcileTime:  status.LastReconcileTime.DeepCopy(),
+		RunningTrialList:   status.RunningTrialList,
+		PendingTrialList:   status.PendingTrialList,
+		FailedTrialList:    status.FailedTrialList,
+		SucceededTrialList: status.SucceededTrialList,
+		KilledTrialList:    status.KilledTrialList,
+		Trials:             status.Trials,
+		TrialsSucceeded:    status.TrialsSucceeded,
+		TrialsFailed:       status.TrialsFailed,
+		TrialsKilled:       status.TrialsKilled,
+		TrialsPending:      status.TrialsPending,
+		TrialsRunning:      status.TrialsRunning,
+		CurrentOptimalTrial: experimentsv1beta1.OptimalTrial{
+			BestTrialName:        status.CurrentOptimalTrial.BestTrialName,
+			ParameterAssignments: convertParameterAssignmentsToV1beta1(status.CurrentOptimalTrial.ParameterAssignments),
+			Observation:          *convertObservationToV1beta1(&status.CurrentOptimalTrial.Observation, out.Spec.Objective),
+		},
+	}
+	for _, c := range status.Conditions {
+		out.Status.Conditions = append(out.Status.Conditions, experimentsv1beta1.ExperimentCondition{
+			Type:               experimentsv1beta1.ExperimentConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastUpdateTime:     c.LastUpdateTime,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return out, nil
+}
+
+func convertExperimentToV1alpha3(in *experimentsv1beta1.Experiment) *experimentsv1alpha3.Experiment {
+	out := &experimentsv1alpha3.Experiment{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: convertObjectMeta(in.ObjectMeta, AnnotationV1alpha3Data),
+	}
+	out.APIVersion = experimentsv1alpha3.SchemeGroupVersion.String()
+
+	spec := in.Spec
+	out.Spec = experimentsv1alpha3.ExperimentSpec{
+		Parameters:          convertParametersToV1alpha3(spec.Parameters),
+		Objective:           convertObjectiveSpecToV1alpha3(spec.Objective),
+		Algorithm:           convertAlgorithmSpecToV1alpha3(spec.Algorithm),
+		ParallelTrialCount:  copyInt32(spec.ParallelTrialCount),
+		MaxTrialCount:       copyInt32(spec.MaxTrialCount),
+		MaxFailedTrialCount: copyInt32(spec.MaxFailedTrialCount),
+		ResumePolicy:        experimentsv1alpha3.ResumePolicyType(spec.ResumePolicy),
+		TrialTemplate:       convertTrialTemplateToV1alpha3(spec.TrialTemplate),
+	}
+	if spec.MetricsCollectorSpec != nil {
+		mc := convertMetricsCollectorSpecToV1alpha3(*spec.MetricsCollectorSpec)
+		out.Spec.MetricsCollectorSpec = &mc
+	}
+	if spec.NasConfig != nil {
+		out.Spec.NasConfig = &experimentsv1alpha3.NasConfig{
+			GraphConfig: experimentsv1alpha3.GraphConfig{
+				NumLayers:   copyInt32(spec.NasConfig.GraphConfig.NumLayers),
+				InputSizes:  append([]int32(nil), spec.NasConfig.GraphConfig.InputSizes...),
+				OutputSizes: append([]int32(nil), spec.NasConfig.GraphConfig.OutputSizes...),
+			},
+		}
+		for _, op := range spec.NasConfig.Operations {
+			out.Spec.NasConfig.Operations = append(out.Spec.NasConfig.Operations, experimentsv1alpha3.Operation{
+				OperationType: op.OperationType,
+				Parameters:    convertParametersToV1alpha3(op.Parameters),
+			})
+		}
+	}
+
+	status := in.Status
+	out.Status = experimentsv1alpha3.ExperimentStatus{
+		StartTime:          status.StartTime.DeepCopy(),
+		CompletionTime:     status.CompletionTime.DeepCopy(),
+		LastReconcileTime:  status.LastReconcileTime.DeepCopy(),
+		RunningTrialList:   status.RunningTrialList,
+		PendingTrialList:   status.PendingTrialList,
+		FailedTrialList:    status.FailedTrialList,
+		SucceededTrialList: status.SucceededTrialList,
+		KilledTrialList:    status.KilledTrialList,
+		Trials:             status.Trials,
+		TrialsSucceeded:    status.TrialsSucceeded,
+		TrialsFailed:       status.TrialsFailed,
+		TrialsKilled:       status.TrialsKilled,
+		TrialsPending:      status.TrialsPending,
+		TrialsRunning:      status.TrialsRunning,
+		CurrentOptimalTrial: experimentsv1alpha3.OptimalTrial{
+			BestTrialName:        status.CurrentOptimalTrial.BestTrialName,
+			ParameterAssignments: convertParameterAssignmentsToV1alpha3(status.CurrentOptimalTrial.ParameterAssignments),
+			Observation:          *convertObservationToV1alpha3(&status.CurrentOptimalTrial.Observation, spec.Objective),
+		},
+	}
+	for _, c := range status.Conditions {
+		out.Status.Conditions = append(out.Status.Conditions, experimentsv1alpha3.ExperimentCondition{
+			Type:               experimentsv1alpha3.ExperimentConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastUpdateTime:     c.LastUpdateTime,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return out
+}
+
+func convertParametersToV1beta1(in []experimentsv1alpha3.ParameterSpec) []experimentsv1beta1.ParameterSpec {
+	var out []experimentsv1beta1.ParameterSpec
+	for _, p := range in {
+		out = append(out, experimentsv1beta1.ParameterSpec{
+			Name:          p.Name,
+			ParameterType: experimentsv1beta1.ParameterType(p.ParameterType),
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+				Max:  p.FeasibleSpace.Max,
+				Min:  p.FeasibleSpace.Min,
+				List: append([]string(nil), p.FeasibleSpace.List...),
+				Step: p.FeasibleSpace.Step,
+			},
+		})
+	}
+	return out
+}
+
+// convertParametersToV1alpha3 drops the distribution, v1alpha3 algorithms sample values uniformly.
+func convertParametersToV1alpha3(in []experimentsv1beta1.ParameterSpec) []experimentsv1alpha3.ParameterSpec {
+	var out []experimentsv1alpha3.ParameterSpec
+	for _, p := range in {
+		out = append(out, experimentsv1alpha3.ParameterSpec{
+			Name:          p.Name,
+			ParameterType: experimentsv1alpha3.ParameterType(p.ParameterType),
+			FeasibleSpace: experimentsv1alpha3.FeasibleSpace{
+				Max:  p.FeasibleSpace.Max,
+				Min:  p.FeasibleSpace.Min,
+				List: append([]string(nil), p.FeasibleSpace.List...),
+				Step: p.FeasibleSpace.Step,
+			},
+		})
+	}
+	return out
+}
+
+// convertTrialTemplateToV1beta1 converts the Go template to the Trial template with trial parameters.
+// The template from the ConfigMap is not converted, the ConfigMap must be updated to the v1beta1 format.
+func convertTrialTemplateToV1beta1(in *experimentsv1alpha3.TrialTemplate, parameters []experimentsv1alpha3.ParameterSpec) (*experimentsv1beta1.TrialTemplate, error) {
+	out := &experimentsv1beta1.TrialTemplate{
+		Retain: in.Retain,
+	}
+	if in.GoTemplate == nil {
+		return out, nil
+	}
+
+	parameterNames := make([]string, 0, len(parameters))
+	for _, p := range parameters {
+		parameterNames = append(parameterNames, p.Name)
+	}
+	trialParameters := newTrialParameters(parameterNames)
+	for _, name := range parameterNames {
+		out.TrialParameters = append(out.TrialParameters, experimentsv1beta1.TrialParameterSpec{
+			Name:      trialParameters[name],
+			Reference: name,
+		})
+	}
+
+	if in.GoTemplate.RawTemplate != "" {
+		rawTemplate, err := convertGoTemplate(in.GoTemplate.RawTemplate, parameterNames, trialParameters)
+		if err != nil {
+			return nil, err
+		}
+		trialSpec, err := convertStringToUnstructured(rawTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse converted Trial template: %v", err)
+		}
+		out.TrialSpec = trialSpec
+	} else if in.GoTemplate.TemplateSpec != nil {
+		out.ConfigMap = &experimentsv1beta1.ConfigMapSource{
+			ConfigMapName:      in.GoTemplate.TemplateSpec.ConfigMapName,
+			ConfigMapNamespace: in.GoTemplate.TemplateSpec.ConfigMapNamespace,
+			TemplatePath:       in.GoTemplate.TemplateSpec.TemplatePath,
+		}
+	}
+	return out, nil
+}
+
+// convertTrialTemplateToV1alpha3 converts the Trial spec to the Go template, the template from
+// the ConfigMap is not converted. Primary pod and Trial conditions settings are dropped, they are
+// supported only for the Trial kinds which are known by v1alpha3 controller.
+func convertTrialTemplateToV1alpha3(in *experimentsv1beta1.TrialTemplate) *experimentsv1alpha3.TrialTemplate {
+	if in == nil {
+		return nil
+	}
+	out := &experimentsv1alpha3.TrialTemplate{
+		Retain: in.Retain,
+	}
+	trialParameters := make(map[string]string, len(in.TrialParameters))
+	for _, p := range in.TrialParameters {
+		trialParameters[p.Name] = p.Reference
+	}
+	if in.TrialSpec != nil {
+		rawTemplate, err := convertTrialSpecToGoTemplate(in.TrialSpec, trialParameters)
+		if err == nil {
+			out.GoTemplate = &experimentsv1alpha3.GoTemplate{RawTemplate: rawTemplate}
+		}
+	} else if in.ConfigMap != nil {
+		out.GoTemplate = &experimentsv1alpha3.GoTemplate{
+			TemplateSpec: &experimentsv1alpha3.TemplateSpec{
+				ConfigMapName:      in.ConfigMap.ConfigMapName,
+				ConfigMapNamespace: in.ConfigMap.ConfigMapNamespace,
+				TemplatePath:       in.ConfigMap.TemplatePath,
+			},
+		}
+	}
+	return out
+}
+
+func copyInt32(in *int32) *int32 {
+	if in == nil {
+		return nil
+	}
+	out := *in
+	return &out
+}
@@ -0,0 +1,355 @@
+package conversion
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	commonv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/common/v1alpha3"
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1alpha3"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+const v1alpha3RawTemplate = `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: {{.Trial}}
+  namespace: {{.NameSpace}}
+spec:
+  template:
+    spec:
+      containers:
+      - name: {{.Trial}}
+        image: docker.io/kubeflowkatib/mxnet-mnist
+        command:
+        - "python3"
+        - "/opt/mxnet-mnist/mnist.py"
+        - "--batch-size=64"
+        {{- with .HyperParameters}}
+        {{- range .}}
+        - "{{.Name}}={{.Value}}"
+        {{- end}}
+        {{- end}}
+      restartPolicy: Never`
+
+func newFakeV1alpha3Experiment() *experimentsv1alpha3.Experiment {
+	goal := 0.99
+	maxTrialCount := int32(12)
+	parallelTrialCount := int32(3)
+	now := metav1.Now()
+	return &experimentsv1alpha3.Experiment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: experimentsv1alpha3.SchemeGroupVersion.String(),
+			Kind:       "Experiment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "random-example",
+			Namespace:   "kubeflow",
+			Annotations: map[string]string{"owner": "katib"},
+		},
+		Spec: experimentsv1alpha3.ExperimentSpec{
+			Parameters: []experimentsv1alpha3.ParameterSpec{
+				{
+					Name:          "--lr",
+					ParameterType: experimentsv1alpha3.ParameterTypeDouble,
+					FeasibleSpace: experimentsv1alpha3.FeasibleSpace{Min: "0.01", Max: "0.03"},
+				},
+				{
+					Name:          "--optimizer",
+					ParameterType: experimentsv1alpha3.ParameterTypeCategorical,
+					FeasibleSpace: experimentsv1alpha3.FeasibleSpace{List: []string{"sgd", "adam"}},
+				},
+			},
+			Objective: &commonv1alpha3.ObjectiveSpec{
+				Type:                  commonv1alpha3.ObjectiveTypeMaximize,
+				Goal:                  &goal,
+				ObjectiveMetricName:   "Validation-accuracy",
+				AdditionalMetricNames: []string{"Train-accuracy"},
+			},
+			Algorithm: &commonv1alpha3.AlgorithmSpec{
+				AlgorithmName:     "random",
+				AlgorithmSettings: []commonv1alpha3.AlgorithmSetting{{Name: "random_state", Value: "10"}},
+			},
+			TrialTemplate: &experimentsv1alpha3.TrialTemplate{
+				GoTemplate: &experimentsv1alpha3.GoTemplate{
+					RawTemplate: v1alpha3RawTemplate,
+				},
+			},
+			ParallelTrialCount: &parallelTrialCount,
+			MaxTrialCount:      &maxTrialCount,
+			MetricsCollectorSpec: &commonv1alpha3.MetricsCollectorSpec{
+				Collector: &commonv1alpha3.CollectorSpec{Kind: commonv1alpha3.StdOutCollector},
+			},
+			ResumePolicy: experimentsv1alpha3.LongRunning,
+		},
+		Status: experimentsv1alpha3.ExperimentStatus{
+			StartTime: &now,
+			Conditions: []experimentsv1alpha3.ExperimentCondition{
+				{
+					Type:   experimentsv1alpha3.ExperimentRunning,
+					Status: v1.ConditionTrue,
+					Reason: "ExperimentRunning",
+				},
+			},
+			CurrentOptimalTrial: experimentsv1alpha3.OptimalTrial{
+				BestTrialName: "random-example-abc",
+				ParameterAssignments: []commonv1alpha3.ParameterAssignment{
+					{Name: "--lr", Value: "0.02"},
+					{Name: "--optimizer", Value: "adam"},
+				},
+				Observation: commonv1alpha3.Observation{
+					Metrics: []commonv1alpha3.Metric{
+						{Name: "Validation-accuracy", Value: 0.95},
+						{Name: "Train-accuracy", Value: 0.97},
+					},
+				},
+			},
+			RunningTrialList:   []string{"random-example-def"},
+			SucceededTrialList: []string{"random-example-abc"},
+			Trials:             2,
+			TrialsSucceeded:    1,
+			TrialsRunning:      1,
+		},
+	}
+}
+
+func newFakeV1beta1Experiment() *experimentsv1beta1.Experiment {
+	goal := 0.99
+	maxTrialCount := int32(12)
+	trialSpec, err := convertStringToUnstructured(`apiVersion: batch/v1
+kind: Job
+spec:
+  template:
+    spec:
+      containers:
+      - name: training-container
+        image: docker.io/kubeflowkatib/mxnet-mnist
+        command:
+        - "python3"
+        - "/opt/mxnet-mnist/mnist.py"
+        - "--lr=${trialParameters.learningRate}"
+        - "--trial=${trialParameters.trialName}"
+        - "--job={{ .job }}"
+      restartPolicy: Never`)
+	if err != nil {
+		panic(err)
+	}
+	return &experimentsv1beta1.Experiment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: experimentsv1beta1.SchemeGroupVersion.String(),
+			Kind:       "Experiment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tpe-example",
+			Namespace: "kubeflow",
+		},
+		Spec: experimentsv1beta1.ExperimentSpec{
+			Parameters: []experimentsv1beta1.ParameterSpec{
+				{
+					Name:          "lr",
+					ParameterType: experimentsv1beta1.ParameterTypeDouble,
+					FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+						Min:          "0.001",
+						Max:          "0.1",
+						Distribution: experimentsv1beta1.DistributionLogUniform,
+					},
+				},
+			},
+			Objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMinimize,
+				Goal:                &goal,
+				ObjectiveMetricName: "loss",
+				MetricStrategies: []commonv1beta1.MetricStrategy{
+					{Name: "loss", Value: commonv1beta1.ExtractByLatest},
+				},
+			},
+			Algorithm: &commonv1beta1.AlgorithmSpec{AlgorithmName: "tpe"},
+			TrialTemplate: &experimentsv1beta1.TrialTemplate{
+				TrialSource: experimentsv1beta1.TrialSource{TrialSpec: trialSpec},
+				TrialParameters: []experimentsv1beta1.TrialParameterSpec{
+					{Name: "learningRate", Description: "Learning rate", Reference: "lr"},
+					{Name: "trialName", Reference: "${trialSpec.Name}"},
+				},
+				PrimaryContainerName: "training-container",
+				SuccessCondition:     `status.conditions.#(type=="Complete")#|#(status=="True")#`,
+			},
+			MaxTrialCount: &maxTrialCount,
+			ResumePolicy:  experimentsv1beta1.FromVolume,
+			Notifications: []experimentsv1beta1.NotificationSpec{{URL: "http://example.com/hook"}},
+			Repetitions:   &experimentsv1beta1.RepetitionSpec{Count: 3},
+		},
+		Status: experimentsv1beta1.ExperimentStatus{
+			CurrentOptimalTrial: experimentsv1beta1.OptimalTrial{
+				BestTrialName:        "tpe-example-abc",
+				ParameterAssignments: []commonv1beta1.ParameterAssignment{{Name: "lr", Value: "0.01"}},
+				Observation: commonv1beta1.Observation{
+					Metrics: []commonv1beta1.Metric{
+						{Name: "loss", Min: "0.1", Max: "0.9", Latest: "0.2"},
+					},
+				},
+				Artifacts: []commonv1beta1.Artifact{{Name: "model", URI: "s3://bucket/model"}},
+			},
+			PromotedJob: &v1.ObjectReference{Kind: "Job", Name: "tpe-example-best"},
+		},
+	}
+}
+
+func TestConvertExperimentToV1beta1(t *testing.T) {
+	in := newFakeV1alpha3Experiment()
+	out, err := ConvertExperimentToV1beta1(in.DeepCopy())
+	if err != nil {
+		t.Fatalf("ConvertExperimentToV1beta1 failed: %v", err)
+	}
+
+	if out.APIVersion != "kubeflow.org/v1beta1" {
+		t.Errorf("Expected apiVersion kubeflow.org/v1beta1, got %v", out.APIVersion)
+	}
+	expectedParameters := []experimentsv1beta1.TrialParameterSpec{
+		{Name: "lr", Reference: "--lr"},
+		{Name: "optimizer", Reference: "--optimizer"},
+	}
+	if !reflect.DeepEqual(out.Spec.TrialTemplate.TrialParameters, expectedParameters) {
+		t.Errorf("Expected trial parameters %v, got %v", expectedParameters, out.Spec.TrialTemplate.TrialParameters)
+	}
+	if name := out.Spec.TrialTemplate.TrialSpec.GetName(); name != "${trialParameters.trial.name}" {
+		t.Errorf("Expected Trial name substitution, got %v", name)
+	}
+	containers, _, _ := unstructured.NestedSlice(out.Spec.TrialTemplate.TrialSpec.Object, "spec", "template", "spec", "containers")
+	command := containers[0].(map[string]interface{})["command"]
+	expectedCommand := []interface{}{"python3", "/opt/mxnet-mnist/mnist.py", "--batch-size=64",
+		"--lr=${trialParameters.lr}", "--optimizer=${trialParameters.optimizer}"}
+	if !reflect.DeepEqual(command, expectedCommand) {
+		t.Errorf("Expected command %v, got %v", expectedCommand, command)
+	}
+	expectedMetrics := []commonv1beta1.Metric{
+		{Name: "Validation-accuracy", Max: "0.95"},
+		{Name: "Train-accuracy", Latest: "0.97"},
+	}
+	if !reflect.DeepEqual(out.Status.CurrentOptimalTrial.Observation.Metrics, expectedMetrics) {
+		t.Errorf("Expected metrics %v, got %v", expectedMetrics, out.Status.CurrentOptimalTrial.Observation.Metrics)
+	}
+	if _, ok := out.Annotations[AnnotationV1alpha3Data]; !ok {
+		t.Errorf("Expected %v annotation with the source Go template", AnnotationV1alpha3Data)
+	}
+
+	back, err := ConvertExperimentToV1alpha3(out)
+	if err != nil {
+		t.Fatalf("ConvertExperimentToV1alpha3 failed: %v", err)
+	}
+	if !reflect.DeepEqual(back, in) {
+		t.Errorf("Round-trip conversion changed the Experiment\nexpected: %+v\ngot:      %+v", in, back)
+	}
+}
+
+func TestConvertExperimentToV1alpha3(t *testing.T) {
+	in := newFakeV1beta1Experiment()
+	out, err := ConvertExperimentToV1alpha3(in.DeepCopy())
+	if err != nil {
+		t.Fatalf("ConvertExperimentToV1alpha3 failed: %v", err)
+	}
+
+	rawTemplate := out.Spec.TrialTemplate.GoTemplate.RawTemplate
+	for _, expected := range []string{
+		`--lr={{range .HyperParameters}}{{if eq .Name "lr"}}{{.Value}}{{end}}{{end}}`,
+		`--trial={{.Trial}}`,
+		`--job={{"{{"}} .job }}`,
+	} {
+		if !strings.Contains(rawTemplate, expected) {
+			t.Errorf("Expected %v in Go template, got %v", expected, rawTemplate)
+		}
+	}
+	if value := out.Status.CurrentOptimalTrial.Observation.Metrics[0].Value; value != 0.2 {
+		t.Errorf("Expected latest value of the objective metric 0.2, got %v", value)
+	}
+	if _, ok := out.Annotations[AnnotationV1beta1Data]; !ok {
+		t.Errorf("Expected %v annotation with the source v1beta1 fields", AnnotationV1beta1Data)
+	}
+
+	back, err := ConvertExperimentToV1beta1(out)
+	if err != nil {
+		t.Fatalf("ConvertExperimentToV1beta1 failed: %v", err)
+	}
+	if !reflect.DeepEqual(back, in) {
+		t.Errorf("Round-trip conversion changed the Experiment\nexpected: %+v\ngot:      %+v", in, back)
+	}
+}
+
+func TestConvertExperimentChangedInOtherVersion(t *testing.T) {
+	in := newFakeV1beta1Experiment()
+	out, err := ConvertExperimentToV1alpha3(in)
+	if err != nil {
+		t.Fatalf("ConvertExperimentToV1alpha3 failed: %v", err)
+	}
+
+	// Changes of v1alpha3 Experiment must be kept, v1beta1 fields which can't be changed in v1alpha3 are restored
+	maxTrialCount := int32(20)
+	out.Spec.MaxTrialCount = &maxTrialCount
+	out.Spec.Parameters[0].FeasibleSpace.Max = "0.2"
+
+	back, err := ConvertExperimentToV1beta1(out)
+	if err != nil {
+		t.Fatalf("ConvertExperimentToV1beta1 failed: %v", err)
+	}
+	if *back.Spec.MaxTrialCount != maxTrialCount {
+		t.Errorf("Expected maxTrialCount %v, got %v", maxTrialCount, *back.Spec.MaxTrialCount)
+	}
+	if back.Spec.Parameters[0].FeasibleSpace.Max != "0.2" || back.Spec.Parameters[0].FeasibleSpace.Distribution != "" {
+		t.Errorf("Expected changed parameter without distribution, got %v", back.Spec.Parameters[0])
+	}
+	if !reflect.DeepEqual(back.Spec.TrialTemplate, in.Spec.TrialTemplate) {
+		t.Errorf("Expected restored Trial template %v, got %v", in.Spec.TrialTemplate, back.Spec.TrialTemplate)
+	}
+	if !reflect.DeepEqual(back.Spec.Notifications, in.Spec.Notifications) {
+		t.Errorf("Expected restored notifications %v, got %v", in.Spec.Notifications, back.Spec.Notifications)
+	}
+	if _, ok := back.Annotations[AnnotationV1beta1Data]; ok {
+		t.Errorf("%v annotation must be removed", AnnotationV1beta1Data)
+	}
+}
+
+func TestConvertExperimentConfigMapTemplate(t *testing.T) {
+	in := newFakeV1alpha3Experiment()
+	in.Spec.TrialTemplate.GoTemplate = &experimentsv1alpha3.GoTemplate{
+		TemplateSpec: &experimentsv1alpha3.TemplateSpec{
+			ConfigMapName:      "trial-template",
+			ConfigMapNamespace: "kubeflow",
+			TemplatePath:       "defaultTrialTemplate.yaml",
+		},
+	}
+	out, err := ConvertExperimentToV1beta1(in.DeepCopy())
+	if err != nil {
+		t.Fatalf("ConvertExperimentToV1beta1 failed: %v", err)
+	}
+	expected := &experimentsv1beta1.ConfigMapSource{
+		ConfigMapName:      "trial-template",
+		ConfigMapNamespace: "kubeflow",
+		TemplatePath:       "defaultTrialTemplate.yaml",
+	}
+	if !reflect.DeepEqual(out.Spec.TrialTemplate.ConfigMap, expected) {
+		t.Errorf("Expected ConfigMap source %v, got %v", expected, out.Spec.TrialTemplate.ConfigMap)
+	}
+	if len(out.Spec.TrialTemplate.TrialParameters) != 2 {
+		t.Errorf("Expected trial parameters for all experiment parameters, got %v", out.Spec.TrialTemplate.TrialParameters)
+	}
+
+	back, err := ConvertExperimentToV1alpha3(out)
+	if err != nil {
+		t.Fatalf("ConvertExperimentToV1alpha3 failed: %v", err)
+	}
+	if !reflect.DeepEqual(back, in) {
+		t.Errorf("Round-trip conversion changed the Experiment\nexpected: %+v\ngot:      %+v", in, back)
+	}
+}
+
+func TestConvertExperimentUnsupportedTemplate(t *testing.T) {
+	in := newFakeV1alpha3Experiment()
+	in.Spec.TrialTemplate.GoTemplate.RawTemplate = `name: {{index .HyperParameters 0}}`
+	if _, err := ConvertExperimentToV1beta1(in); err == nil {
+		t.Errorf("Expected error for unsupported Go template action")
+	}
+}
@@ -0,0 +1,135 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	commonv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/common/v1alpha3"
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	suggestionsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1alpha3"
+	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+)
+
+type suggestionV1beta1Data struct {
+	Spec   suggestionsv1beta1.SuggestionSpec   `json:"spec,omitempty"`
+	Status suggestionsv1beta1.SuggestionStatus `json:"status,omitempty"`
+}
+
+// ConvertSuggestionToV1beta1 converts the v1alpha3 Suggestion to v1beta1.
+func ConvertSuggestionToV1beta1(in *suggestionsv1alpha3.Suggestion) (*suggestionsv1beta1.Suggestion, error) {
+	out := convertSuggestionToV1beta1(in)
+
+	// Restore v1beta1 fields which were lost by the conversion to v1alpha3
+	data := &suggestionV1beta1Data{}
+	if ok, err := getConversionData(in.ObjectMeta, AnnotationV1beta1Data, data); err != nil {
+		return nil, err
+	} else if ok {
+		out.Spec.ResumePolicy = data.Spec.ResumePolicy
+		out.Status.Endpoint = data.Status.Endpoint
+	}
+	return out, nil
+}
+
+// ConvertSuggestionToV1alpha3 converts the v1beta1 Suggestion to v1alpha3.
+func ConvertSuggestionToV1alpha3(in *suggestionsv1beta1.Suggestion) (*suggestionsv1alpha3.Suggestion, error) {
+	out := convertSuggestionToV1alpha3(in)
+
+	// Keep v1beta1 fields which are lost by the conversion back
+	if back := convertSuggestionToV1beta1(out); !jsonEqual(back.Spec, in.Spec) || !jsonEqual(back.Status, in.Status) {
+		if err := setConversionData(&out.ObjectMeta, AnnotationV1beta1Data, &suggestionV1beta1Data{Spec: in.Spec, Status: in.Status}); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func convertSuggestionToV1beta1(in *suggestionsv1alpha3.Suggestion) *suggestionsv1beta1.Suggestion {
+	out := &suggestionsv1beta1.Suggestion{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: convertObjectMeta(in.ObjectMeta, AnnotationV1beta1Data),
+	}
+	out.APIVersion = suggestionsv1beta1.SchemeGroupVersion.String()
+
+	out.Spec = suggestionsv1beta1.SuggestionSpec{
+		AlgorithmName: in.Spec.AlgorithmName,
+		Requests:      in.Spec.Requests,
+	}
+	out.Status = suggestionsv1beta1.SuggestionStatus{
+		SuggestionCount:   in.Status.SuggestionCount,
+		StartTime:         in.Status.StartTime.DeepCopy(),
+		CompletionTime:    in.Status.CompletionTime.DeepCopy(),
+		LastReconcileTime: in.Status.LastReconcileTime.DeepCopy(),
+	}
+	for _, s := range in.Status.AlgorithmSettings {
+		out.Status.AlgorithmSettings = append(out.Status.AlgorithmSettings, commonv1beta1.AlgorithmSetting{Name: s.Name, Value: s.Value})
+	}
+	for _, s := range in.Status.Suggestions {
+		out.Status.Suggestions = append(out.Status.Suggestions, suggestionsv1beta1.TrialAssignment{
+			Name:                 s.Name,
+			ParameterAssignments: convertParameterAssignmentsToV1beta1(s.ParameterAssignments),
+		})
+	}
+	for _, c := range in.Status.Conditions {
+		out.Status.Conditions = append(out.Status.Conditions, suggestionsv1beta1.SuggestionCondition{
+			Type:               suggestionsv1beta1.SuggestionConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastUpdateTime:     c.LastUpdateTime,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return out
+}
+
+// convertSuggestionToV1alpha3 drops the resume policy and the shared algorithm service endpoint.
+func convertSuggestionToV1alpha3(in *suggestionsv1beta1.Suggestion) *suggestionsv1alpha3.Suggestion {
+	out := &suggestionsv1alpha3.Suggestion{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: convertObjectMeta(in.ObjectMeta, AnnotationV1alpha3Data),
+	}
+	out.APIVersion = suggestionsv1alpha3.SchemeGroupVersion.String()
+
+	out.Spec = suggestionsv1alpha3.SuggestionSpec{
+		AlgorithmName: in.Spec.AlgorithmName,
+		Requests:      in.Spec.Requests,
+	}
+	out.Status = suggestionsv1alpha3.SuggestionStatus{
+		SuggestionCount:   in.Status.SuggestionCount,
+		StartTime:         in.Status.StartTime.DeepCopy(),
+		CompletionTime:    in.Status.CompletionTime.DeepCopy(),
+		LastReconcileTime: in.Status.LastReconcileTime.DeepCopy(),
+	}
+	for _, s := range in.Status.AlgorithmSettings {
+		out.Status.AlgorithmSettings = append(out.Status.AlgorithmSettings, commonv1alpha3.AlgorithmSetting{Name: s.Name, Value: s.Value})
+	}
+	for _, s := range in.Status.Suggestions {
+		out.Status.Suggestions = append(out.Status.Suggestions, suggestionsv1alpha3.TrialAssignment{
+			Name:                 s.Name,
+			ParameterAssignments: convertParameterAssignmentsToV1alpha3(s.ParameterAssignments),
+		})
+	}
+	for _, c := range in.Status.Conditions {
+		out.Status.Conditions = append(out.Status.Conditions, suggestionsv1alpha3.SuggestionCondition{
+			Type:               suggestionsv1alpha3.SuggestionConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastUpdateTime:     c.LastUpdateTime,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package conversion
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+)
+
+func TestConvertSuggestion(t *testing.T) {
+	in := &suggestionsv1beta1.Suggestion{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: suggestionsv1beta1.SchemeGroupVersion.String(),
+			Kind:       "Suggestion",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tpe-example",
+			Namespace: "kubeflow",
+		},
+		Spec: suggestionsv1beta1.SuggestionSpec{
+			AlgorithmName: "tpe",
+			Requests:      3,
+			ResumePolicy:  experimentsv1beta1.FromVolume,
+		},
+		Status: suggestionsv1beta1.SuggestionStatus{
+			AlgorithmSettings: []commonv1beta1.AlgorithmSetting{{Name: "n_startup_trials", Value: "5"}},
+			SuggestionCount:   1,
+			Suggestions: []suggestionsv1beta1.TrialAssignment{
+				{
+					Name:                 "tpe-example-abc",
+					ParameterAssignments: []commonv1beta1.ParameterAssignment{{Name: "lr", Value: "0.01"}},
+				},
+			},
+			Conditions: []suggestionsv1beta1.SuggestionCondition{
+				{Type: suggestionsv1beta1.SuggestionRunning, Status: v1.ConditionTrue},
+			},
+			Endpoint: "katib-tpe.kubeflow:6789",
+		},
+	}
+
+	out, err := ConvertSuggestionToV1alpha3(in.DeepCopy())
+	if err != nil {
+		t.Fatalf("ConvertSuggestionToV1alpha3 failed: %v", err)
+	}
+	if out.Spec.AlgorithmName != "tpe" || len(out.Status.Suggestions) != 1 {
+		t.Errorf("Unexpected v1alpha3 Suggestion %+v", out)
+	}
+	back, err := ConvertSuggestionToV1beta1(out.DeepCopy())
+	if err != nil {
+		t.Fatalf("ConvertSuggestionToV1beta1 failed: %v", err)
+	}
+	if !reflect.DeepEqual(back, in) {
+		t.Errorf("Round-trip conversion changed the Suggestion\nexpected: %+v\ngot:      %+v", in, back)
+	}
+
+	// Suggestion without v1beta1 fields is converted without the annotation
+	in.Spec.ResumePolicy = ""
+	in.Status.Endpoint = ""
+	out, err = ConvertSuggestionToV1alpha3(in.DeepCopy())
+	if err != nil {
+		t.Fatalf("ConvertSuggestionToV1alpha3 failed: %v", err)
+	}
+	if out.Annotations != nil {
+		t.Errorf("Expected no annotations, got %v", out.Annotations)
+	}
+}
@@ -0,0 +1,293 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+const (
+	bufferSize = 1024
+
+	// Fields of the v1alpha3 Trial template parameters.
+	goTemplateFieldTrial           = "Trial"
+	goTemplateFieldExperiment      = "Experiment"
+	goTemplateFieldNameSpace       = "NameSpace"
+	goTemplateFieldHyperParameters = "HyperParameters"
+	goTemplateFieldName            = "Name"
+	goTemplateFieldValue           = "Value"
+)
+
+// Substitutions of the v1beta1 Trial template which are converted to the v1alpha3 Trial template fields.
+var trialTemplateBuiltinFields = map[string]string{
+	consts.TrialTemplateExprKeyOfTrialName:           goTemplateFieldTrial,
+	consts.TrialTemplateExprKeyOfTrialNamespace:      goTemplateFieldNameSpace,
+	consts.TrialTemplateExprKeyOfExperimentName:      goTemplateFieldExperiment,
+	consts.TrialTemplateExprKeyOfExperimentNamespace: goTemplateFieldNameSpace,
+}
+
+// newTrialParameters returns the trial parameters which reference the experiment parameters.
+// Names of the trial parameters are the parameter names without leading dashes, e.g. lr for --lr.
+func newTrialParameters(parameterNames []string) map[string]string {
+	trialParameters := make(map[string]string, len(parameterNames))
+	used := make(map[string]bool, len(parameterNames))
+	for i, parameterName := range parameterNames {
+		name := strings.Map(func(r rune) rune {
+			if r == '{' || r == '}' {
+				return '_'
+			}
+			return r
+		}, strings.TrimLeft(parameterName, "-"))
+		if name == "" {
+			name = fmt.Sprintf("param%d", i)
+		}
+		for base, n := name, 1; used[name] || trialTemplateBuiltinFields[name] != ""; n++ {
+			name = fmt.Sprintf("%s%d", base, n)
+		}
+		used[name] = true
+		trialParameters[parameterName] = name
+	}
+	return trialParameters
+}
+
+// Scope of the dot in the v1alpha3 Trial template.
+type goTemplateDot int
+
+const (
+	dotTemplateParams goTemplateDot = iota
+	dotHyperParameters
+	dotHyperParameter
+)
+
+// goTemplateConverter renders the v1alpha3 Trial template into the v1beta1 Trial template.
+// Loops over .HyperParameters are unrolled for all experiment parameters and parameter values
+// are replaced with the trial parameters substitutions.
+type goTemplateConverter struct {
+	buf             bytes.Buffer
+	parameterNames  []string
+	trialParameters map[string]string
+}
+
+// convertGoTemplate converts the v1alpha3 Go template to the v1beta1 Trial template string.
+func convertGoTemplate(rawTemplate string, parameterNames []string, trialParameters map[string]string) (string, error) {
+	tpl, err := template.New("Trial").Parse(rawTemplate)
+	if err != nil {
+		return "", fmt.Errorf("Unable to parse Trial template: %v", err)
+	}
+	c := &goTemplateConverter{
+		parameterNames:  parameterNames,
+		trialParameters: trialParameters,
+	}
+	if tpl.Tree != nil {
+		if err := c.walk(tpl.Tree.Root, dotTemplateParams, ""); err != nil {
+			return "", err
+		}
+	}
+	return c.buf.String(), nil
+}
+
+func (c *goTemplateConverter) walk(node parse.Node, dot goTemplateDot, parameterName string) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := c.walk(child, dot, parameterName); err != nil {
+				return err
+			}
+		}
+	case *parse.TextNode:
+		c.buf.Write(n.Text)
+	case *parse.ActionNode:
+		value, err := c.action(n.Pipe, dot, parameterName)
+		if err != nil {
+			return err
+		}
+		c.buf.WriteString(value)
+	case *parse.WithNode:
+		if dot != dotTemplateParams || !isField(n.Pipe, goTemplateFieldHyperParameters) {
+			return fmt.Errorf("Unsupported Trial template action: {{with %v}}", n.Pipe)
+		}
+		if len(c.parameterNames) == 0 {
+			return c.walk(n.ElseList, dot, parameterName)
+		}
+		return c.walk(n.List, dotHyperParameters, parameterName)
+	case *parse.RangeNode:
+		if !(dot == dotTemplateParams && isField(n.Pipe, goTemplateFieldHyperParameters)) &&
+			!(dot == dotHyperParameters && isDot(n.Pipe)) {
+			return fmt.Errorf("Unsupported Trial template action: {{range %v}}", n.Pipe)
+		}
+		if len(c.parameterNames) == 0 {
+			return c.walk(n.ElseList, dot, parameterName)
+		}
+		for _, name := range c.parameterNames {
+			if err := c.walk(n.List, dotHyperParameter, name); err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		// Only conditions on the parameter name are supported, e.g. {{if eq .Name "--lr"}}
+		name, ok := nameCondition(n.Pipe)
+		if dot != dotHyperParameter || !ok {
+			return fmt.Errorf("Unsupported Trial template action: {{if %v}}", n.Pipe)
+		}
+		if name == parameterName {
+			return c.walk(n.List, dot, parameterName)
+		}
+		return c.walk(n.ElseList, dot, parameterName)
+	default:
+		return fmt.Errorf("Unsupported Trial template node: %v", node)
+	}
+	return nil
+}
+
+func (c *goTemplateConverter) action(pipe *parse.PipeNode, dot goTemplateDot, parameterName string) (string, error) {
+	if len(pipe.Decl) == 0 && len(pipe.Cmds) == 1 && len(pipe.Cmds[0].Args) == 1 {
+		switch arg := pipe.Cmds[0].Args[0].(type) {
+		case *parse.StringNode:
+			return arg.Text, nil
+		case *parse.FieldNode:
+			if len(arg.Ident) == 1 {
+				switch {
+				case dot == dotTemplateParams && arg.Ident[0] == goTemplateFieldTrial:
+					return fmt.Sprintf(consts.TrialTemplateParamReplaceFormat, consts.TrialTemplateExprKeyOfTrialName), nil
+				case dot == dotTemplateParams && arg.Ident[0] == goTemplateFieldNameSpace:
+					return fmt.Sprintf(consts.TrialTemplateParamReplaceFormat, consts.TrialTemplateExprKeyOfTrialNamespace), nil
+				case dot == dotTemplateParams && arg.Ident[0] == goTemplateFieldExperiment:
+					return fmt.Sprintf(consts.TrialTemplateParamReplaceFormat, consts.TrialTemplateExprKeyOfExperimentName), nil
+				case dot == dotHyperParameter && arg.Ident[0] == goTemplateFieldName:
+					return parameterName, nil
+				case dot == dotHyperParameter && arg.Ident[0] == goTemplateFieldValue:
+					return fmt.Sprintf(consts.TrialTemplateParamReplaceFormat, c.trialParameters[parameterName]), nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("Unsupported Trial template action: {{%v}}", pipe)
+}
+
+func isField(pipe *parse.PipeNode, field string) bool {
+	if len(pipe.Decl) != 0 || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	arg, ok := pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	return ok && len(arg.Ident) == 1 && arg.Ident[0] == field
+}
+
+func isDot(pipe *parse.PipeNode) bool {
+	if len(pipe.Decl) != 0 || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	_, ok := pipe.Cmds[0].Args[0].(*parse.DotNode)
+	return ok
+}
+
+// nameCondition returns the parameter name from the eq .Name "<name>" condition.
+func nameCondition(pipe *parse.PipeNode) (string, bool) {
+	if len(pipe.Decl) != 0 || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 3 {
+		return "", false
+	}
+	args := pipe.Cmds[0].Args
+	if fn, ok := args[0].(*parse.IdentifierNode); !ok || fn.Ident != "eq" {
+		return "", false
+	}
+	for i, arg := range args[1:] {
+		field, ok := arg.(*parse.FieldNode)
+		if !ok || len(field.Ident) != 1 || field.Ident[0] != goTemplateFieldName {
+			continue
+		}
+		if name, ok := args[2-i].(*parse.StringNode); ok {
+			return name.Text, true
+		}
+	}
+	return "", false
+}
+
+// convertTrialSpecToGoTemplate converts the v1beta1 Trial template to the v1alpha3 Go template.
+// Substitutions of the trial parameters are replaced with the values of the referenced parameter
+// assignments. Expressions and references to the Trial metadata other than name and namespace
+// can't be represented in v1alpha3 and are kept as is.
+func convertTrialSpecToGoTemplate(trialSpec *unstructured.Unstructured, trialParameters map[string]string) (string, error) {
+	rawTemplate, err := convertUnstructuredToYAML(trialSpec)
+	if err != nil {
+		return "", err
+	}
+	return convertTrialTemplateToGoTemplate(rawTemplate, trialParameters), nil
+}
+
+// convertTrialTemplateToGoTemplate replaces the substitutions in the v1beta1 Trial template string.
+// trialParameters maps the trial parameter names to the references.
+func convertTrialTemplateToGoTemplate(trialTemplate string, trialParameters map[string]string) string {
+	// Escape actions delimiters which are not Go template actions in v1beta1
+	trialTemplate = strings.Replace(trialTemplate, "{{", `{{"{{"}}`, -1)
+
+	metaRegex := regexp.MustCompile(consts.TrialTemplateMetaReplaceFormatRegex)
+	paramRegex := regexp.MustCompile(consts.TrialTemplateParamParseFormatRegex)
+	return paramRegex.ReplaceAllStringFunc(trialTemplate, func(placeHolder string) string {
+		content := paramRegex.FindStringSubmatch(placeHolder)[1]
+		reference, ok := trialParameters[content]
+		if !ok {
+			if field, ok := trialTemplateBuiltinFields[content]; ok {
+				return fmt.Sprintf("{{.%v}}", field)
+			}
+			return placeHolder
+		}
+		if sub := metaRegex.FindStringSubmatch(reference); len(sub) > 0 {
+			switch sub[1] {
+			case consts.TrialTemplateMetaKeyOfName:
+				return fmt.Sprintf("{{.%v}}", goTemplateFieldTrial)
+			case consts.TrialTemplateMetaKeyOfNamespace:
+				return fmt.Sprintf("{{.%v}}", goTemplateFieldNameSpace)
+			}
+			return placeHolder
+		}
+		return fmt.Sprintf("{{range .%v}}{{if eq .%v %v}}{{.%v}}{{end}}{{end}}",
+			goTemplateFieldHyperParameters, goTemplateFieldName, strconv.Quote(reference), goTemplateFieldValue)
+	})
+}
+
+func convertStringToUnstructured(in string) (*unstructured.Unstructured, error) {
+	out := &unstructured.Unstructured{}
+	if err := k8syaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(in), bufferSize).Decode(out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func convertUnstructuredToYAML(in *unstructured.Unstructured) (string, error) {
+	inJSON, err := in.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	out, err := yaml.JSONToYAML(inJSON)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
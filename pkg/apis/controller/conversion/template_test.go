@@ -0,0 +1,108 @@
+package conversion
+
+import (
+	"testing"
+)
+
+func TestConvertGoTemplate(t *testing.T) {
+	parameterNames := []string{"--lr", "--num-layers"}
+	trialParameters := newTrialParameters(parameterNames)
+
+	tcs := []struct {
+		rawTemplate     string
+		parameterNames  []string
+		expected        string
+		err             bool
+		testDescription string
+	}{
+		{
+			rawTemplate:     `name: {{.Trial}}, namespace: {{.NameSpace}}, experiment: {{.Experiment}}`,
+			parameterNames:  parameterNames,
+			expected:        `name: ${trialParameters.trial.name}, namespace: ${trialParameters.trial.namespace}, experiment: ${trialParameters.experiment.name}`,
+			testDescription: "Trial metadata fields",
+		},
+		{
+			rawTemplate:     `args: [{{- range .HyperParameters}}"{{.Name}}={{.Value}}",{{- end}}]`,
+			parameterNames:  parameterNames,
+			expected:        `args: ["--lr=${trialParameters.lr}","--num-layers=${trialParameters.num-layers}",]`,
+			testDescription: "Range over hyperparameters",
+		},
+		{
+			rawTemplate:     `lr: {{range .HyperParameters}}{{if eq .Name "--lr"}}{{.Value}}{{end}}{{end}}`,
+			parameterNames:  parameterNames,
+			expected:        `lr: ${trialParameters.lr}`,
+			testDescription: "Value of the hyperparameter",
+		},
+		{
+			rawTemplate:     `args: [{{- with .HyperParameters}}{{- range .}}"{{.Name}}={{.Value}}"{{- end}}{{else}}"--default"{{end}}]`,
+			parameterNames:  nil,
+			expected:        `args: ["--default"]`,
+			testDescription: "Experiment without parameters",
+		},
+		{
+			rawTemplate:     `command: echo {{"{{"}} .job }}`,
+			parameterNames:  parameterNames,
+			expected:        `command: echo {{ .job }}`,
+			testDescription: "Escaped delimiters",
+		},
+		{
+			rawTemplate:     `lr: {{(index .HyperParameters 0).Value}}`,
+			parameterNames:  parameterNames,
+			err:             true,
+			testDescription: "Unsupported action",
+		},
+		{
+			rawTemplate:     `{{if .Trial}}name: {{.Trial}}{{end}}`,
+			parameterNames:  parameterNames,
+			err:             true,
+			testDescription: "Unsupported condition",
+		},
+		{
+			rawTemplate:     `name: {{.Trial`,
+			parameterNames:  parameterNames,
+			err:             true,
+			testDescription: "Invalid Go template",
+		},
+	}
+
+	for _, tc := range tcs {
+		actual, err := convertGoTemplate(tc.rawTemplate, tc.parameterNames, trialParameters)
+		if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected error, got nil", tc.testDescription)
+		} else if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if !tc.err && actual != tc.expected {
+			t.Errorf("Case: %v failed. Expected %v, got %v", tc.testDescription, tc.expected, actual)
+		}
+	}
+}
+
+func TestNewTrialParameters(t *testing.T) {
+	trialParameters := newTrialParameters([]string{"--lr", "lr", "--", "trial.name"})
+	expected := map[string]string{
+		"--lr":       "lr",
+		"lr":         "lr1",
+		"--":         "param2",
+		"trial.name": "trial.name1",
+	}
+	for name, trialParameter := range expected {
+		if trialParameters[name] != trialParameter {
+			t.Errorf("Expected trial parameter %v for %v, got %v", trialParameter, name, trialParameters[name])
+		}
+	}
+}
+
+func TestConvertTrialTemplateToGoTemplate(t *testing.T) {
+	trialParameters := map[string]string{
+		"learningRate": "--lr",
+		"trialName":    "${trialSpec.Name}",
+		"jobKind":      "${trialSpec.Kind}",
+	}
+	in := `args: ["--lr=${trialParameters.learningRate}", "--kind=${trialParameters.jobKind}", "--batch=${trialParameters.learningRate * 10}"]
+name: ${trialParameters.trialName}-${trialParameters.experiment.name}`
+	expected := `args: ["--lr={{range .HyperParameters}}{{if eq .Name "--lr"}}{{.Value}}{{end}}{{end}}", "--kind=${trialParameters.jobKind}", "--batch=${trialParameters.learningRate * 10}"]
+name: {{.Trial}}-{{.Experiment}}`
+	if actual := convertTrialTemplateToGoTemplate(in, trialParameters); actual != expected {
+		t.Errorf("Expected %v, got %v", expected, actual)
+	}
+}
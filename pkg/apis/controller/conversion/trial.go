@@ -0,0 +1,200 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"fmt"
+
+	trialsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1alpha3"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+type trialV1alpha3Data struct {
+	Spec   trialsv1alpha3.TrialSpec   `json:"spec,omitempty"`
+	Status trialsv1alpha3.TrialStatus `json:"status,omitempty"`
+}
+
+type trialV1beta1Data struct {
+	Spec   trialsv1beta1.TrialSpec   `json:"spec,omitempty"`
+	Status trialsv1beta1.TrialStatus `json:"status,omitempty"`
+}
+
+// ConvertTrialToV1beta1 converts the v1alpha3 Trial to v1beta1.
+// It returns error if the run spec can't be parsed.
+func ConvertTrialToV1beta1(in *trialsv1alpha3.Trial) (*trialsv1beta1.Trial, error) {
+	out, err := convertTrialToV1beta1(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore v1beta1 fields which were lost by the conversion to v1alpha3
+	data := &trialV1beta1Data{}
+	if ok, err := getConversionData(in.ObjectMeta, AnnotationV1beta1Data, data); err != nil {
+		return nil, err
+	} else if ok {
+		restoreTrialV1beta1Fields(in, out, data)
+	}
+
+	// Keep v1alpha3 fields which are lost by the conversion back
+	if back, err := convertTrialToV1alpha3(out); err != nil || !jsonEqual(back.Spec, in.Spec) || !jsonEqual(back.Status, in.Status) {
+		if err := setConversionData(&out.ObjectMeta, AnnotationV1alpha3Data, &trialV1alpha3Data{Spec: in.Spec, Status: in.Status}); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// ConvertTrialToV1alpha3 converts the v1beta1 Trial to v1alpha3.
+func ConvertTrialToV1alpha3(in *trialsv1beta1.Trial) (*trialsv1alpha3.Trial, error) {
+	out, err := convertTrialToV1alpha3(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore v1alpha3 fields which were lost by the conversion to v1beta1
+	data := &trialV1alpha3Data{}
+	if ok, err := getConversionData(in.ObjectMeta, AnnotationV1alpha3Data, data); err != nil {
+		return nil, err
+	} else if ok {
+		restoreTrialV1alpha3Fields(in, out, data)
+	}
+
+	// Keep v1beta1 fields which are lost by the conversion back
+	if back, err := convertTrialToV1beta1(out); err != nil || !jsonEqual(back.Spec, in.Spec) || !jsonEqual(back.Status, in.Status) {
+		if err := setConversionData(&out.ObjectMeta, AnnotationV1beta1Data, &trialV1beta1Data{Spec: in.Spec, Status: in.Status}); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// restoreTrialV1beta1Fields restores the fields from the source v1beta1 Trial
+// if their v1alpha3 values are not changed after the conversion.
+func restoreTrialV1beta1Fields(in *trialsv1alpha3.Trial, out *trialsv1beta1.Trial, data *trialV1beta1Data) {
+	source, err := convertTrialToV1alpha3(&trialsv1beta1.Trial{Spec: data.Spec, Status: data.Status})
+	if err != nil {
+		return
+	}
+	if jsonEqual(source.Spec.Objective, in.Spec.Objective) {
+		out.Spec.Objective = data.Spec.Objective
+	}
+	if jsonEqual(source.Spec.RunSpec, in.Spec.RunSpec) {
+		out.Spec.RunSpec = data.Spec.RunSpec
+	}
+	if jsonEqual(source.Status.Observation, in.Status.Observation) {
+		out.Status.Observation = data.Status.Observation
+	}
+	out.Spec.PrimaryPodLabels = data.Spec.PrimaryPodLabels
+	out.Spec.PrimaryContainerName = data.Spec.PrimaryContainerName
+	out.Spec.SuccessCondition = data.Spec.SuccessCondition
+	out.Spec.FailureCondition = data.Spec.FailureCondition
+	out.Status.Artifacts = data.Status.Artifacts
+}
+
+// restoreTrialV1alpha3Fields restores the fields from the source v1alpha3 Trial
+// if their v1beta1 values are not changed after the conversion.
+func restoreTrialV1alpha3Fields(in *trialsv1beta1.Trial, out *trialsv1alpha3.Trial, data *trialV1alpha3Data) {
+	source, err := convertTrialToV1beta1(&trialsv1alpha3.Trial{Spec: data.Spec, Status: data.Status})
+	if err != nil {
+		return
+	}
+	if jsonEqual(source.Spec.RunSpec, in.Spec.RunSpec) {
+		out.Spec.RunSpec = data.Spec.RunSpec
+	}
+	if jsonEqual(source.Status.Observation, in.Status.Observation) {
+		out.Status.Observation = data.Status.Observation
+	}
+}
+
+func convertTrialToV1beta1(in *trialsv1alpha3.Trial) (*trialsv1beta1.Trial, error) {
+	out := &trialsv1beta1.Trial{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: convertObjectMeta(in.ObjectMeta, AnnotationV1beta1Data),
+	}
+	out.APIVersion = trialsv1beta1.SchemeGroupVersion.String()
+
+	out.Spec = trialsv1beta1.TrialSpec{
+		Objective:            convertObjectiveSpecToV1beta1(in.Spec.Objective),
+		ParameterAssignments: convertParameterAssignmentsToV1beta1(in.Spec.ParameterAssignments),
+		RetainRun:            in.Spec.RetainRun,
+		MetricsCollector:     convertMetricsCollectorSpecToV1beta1(in.Spec.MetricsCollector),
+	}
+	if in.Spec.RunSpec != "" {
+		runSpec, err := convertStringToUnstructured(in.Spec.RunSpec)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse spec.runSpec of Trial %v/%v: %v", in.Namespace, in.Name, err)
+		}
+		out.Spec.RunSpec = runSpec
+	}
+
+	out.Status = trialsv1beta1.TrialStatus{
+		StartTime:         in.Status.StartTime.DeepCopy(),
+		CompletionTime:    in.Status.CompletionTime.DeepCopy(),
+		LastReconcileTime: in.Status.LastReconcileTime.DeepCopy(),
+		Observation:       convertObservationToV1beta1(in.Status.Observation, out.Spec.Objective),
+	}
+	for _, c := range in.Status.Conditions {
+		out.Status.Conditions = append(out.Status.Conditions, trialsv1beta1.TrialCondition{
+			Type:               trialsv1beta1.TrialConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastUpdateTime:     c.LastUpdateTime,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return out, nil
+}
+
+func convertTrialToV1alpha3(in *trialsv1beta1.Trial) (*trialsv1alpha3.Trial, error) {
+	out := &trialsv1alpha3.Trial{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: convertObjectMeta(in.ObjectMeta, AnnotationV1alpha3Data),
+	}
+	out.APIVersion = trialsv1alpha3.SchemeGroupVersion.String()
+
+	out.Spec = trialsv1alpha3.TrialSpec{
+		Objective:            convertObjectiveSpecToV1alpha3(in.Spec.Objective),
+		ParameterAssignments: convertParameterAssignmentsToV1alpha3(in.Spec.ParameterAssignments),
+		RetainRun:            in.Spec.RetainRun,
+		MetricsCollector:     convertMetricsCollectorSpecToV1alpha3(in.Spec.MetricsCollector),
+	}
+	if in.Spec.RunSpec != nil {
+		runSpec, err := convertUnstructuredToYAML(in.Spec.RunSpec)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to convert spec.runSpec of Trial %v/%v: %v", in.Namespace, in.Name, err)
+		}
+		out.Spec.RunSpec = runSpec
+	}
+
+	out.Status = trialsv1alpha3.TrialStatus{
+		StartTime:         in.Status.StartTime.DeepCopy(),
+		CompletionTime:    in.Status.CompletionTime.DeepCopy(),
+		LastReconcileTime: in.Status.LastReconcileTime.DeepCopy(),
+		Observation:       convertObservationToV1alpha3(in.Status.Observation, in.Spec.Objective),
+	}
+	for _, c := range in.Status.Conditions {
+		out.Status.Conditions = append(out.Status.Conditions, trialsv1alpha3.TrialCondition{
+			Type:               trialsv1alpha3.TrialConditionType(c.Type),
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastUpdateTime:     c.LastUpdateTime,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,149 @@
+package conversion
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/common/v1alpha3"
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1alpha3"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func newFakeV1alpha3Trial() *trialsv1alpha3.Trial {
+	return &trialsv1alpha3.Trial{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: trialsv1alpha3.SchemeGroupVersion.String(),
+			Kind:       "Trial",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "random-example-abc",
+			Namespace: "kubeflow",
+			Labels:    map[string]string{"experiment": "random-example"},
+		},
+		Spec: trialsv1alpha3.TrialSpec{
+			Objective: &commonv1alpha3.ObjectiveSpec{
+				Type:                commonv1alpha3.ObjectiveTypeMinimize,
+				ObjectiveMetricName: "loss",
+			},
+			ParameterAssignments: []commonv1alpha3.ParameterAssignment{{Name: "--lr", Value: "0.02"}},
+			RunSpec: `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: random-example-abc
+  namespace: kubeflow
+spec:
+  template:
+    spec:
+      containers:
+      - name: random-example-abc
+        image: docker.io/kubeflowkatib/mxnet-mnist
+        command:
+        - "python3"
+        - "--lr=0.02"`,
+			MetricsCollector: commonv1alpha3.MetricsCollectorSpec{
+				Collector: &commonv1alpha3.CollectorSpec{Kind: commonv1alpha3.StdOutCollector},
+			},
+		},
+		Status: trialsv1alpha3.TrialStatus{
+			Conditions: []trialsv1alpha3.TrialCondition{
+				{Type: trialsv1alpha3.TrialSucceeded, Status: v1.ConditionTrue, Reason: "TrialSucceeded"},
+			},
+			Observation: &commonv1alpha3.Observation{
+				Metrics: []commonv1alpha3.Metric{{Name: "loss", Value: 0.125}},
+			},
+		},
+	}
+}
+
+func newFakeV1beta1Trial() *trialsv1beta1.Trial {
+	runSpec, err := convertStringToUnstructured(`{"apiVersion": "batch/v1", "kind": "Job", "metadata": {"name": "tpe-example-abc"}}`)
+	if err != nil {
+		panic(err)
+	}
+	return &trialsv1beta1.Trial{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: trialsv1beta1.SchemeGroupVersion.String(),
+			Kind:       "Trial",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tpe-example-abc",
+			Namespace: "kubeflow",
+		},
+		Spec: trialsv1beta1.TrialSpec{
+			Objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "accuracy",
+				MetricStrategies: []commonv1beta1.MetricStrategy{
+					{Name: "accuracy", Value: commonv1beta1.ExtractByAvg},
+				},
+			},
+			ParameterAssignments: []commonv1beta1.ParameterAssignment{{Name: "lr", Value: "0.01"}},
+			RunSpec:              runSpec,
+			PrimaryPodLabels:     map[string]string{"job-role": "master"},
+			PrimaryContainerName: "training-container",
+		},
+		Status: trialsv1beta1.TrialStatus{
+			Observation: &commonv1beta1.Observation{
+				Metrics: []commonv1beta1.Metric{
+					{Name: "accuracy", Min: "0.5", Max: "0.9", Latest: "0.8", Value: "0.75"},
+				},
+			},
+			Artifacts: []commonv1beta1.Artifact{{Name: "model", URI: "s3://bucket/model"}},
+		},
+	}
+}
+
+func TestConvertTrialToV1beta1(t *testing.T) {
+	in := newFakeV1alpha3Trial()
+	out, err := ConvertTrialToV1beta1(in.DeepCopy())
+	if err != nil {
+		t.Fatalf("ConvertTrialToV1beta1 failed: %v", err)
+	}
+	if out.Spec.RunSpec.GetName() != "random-example-abc" || out.Spec.RunSpec.GetKind() != "Job" {
+		t.Errorf("Expected Job run spec, got %v", out.Spec.RunSpec)
+	}
+	expectedMetrics := []commonv1beta1.Metric{{Name: "loss", Min: "0.125"}}
+	if !reflect.DeepEqual(out.Status.Observation.Metrics, expectedMetrics) {
+		t.Errorf("Expected metrics %v, got %v", expectedMetrics, out.Status.Observation.Metrics)
+	}
+
+	back, err := ConvertTrialToV1alpha3(out)
+	if err != nil {
+		t.Fatalf("ConvertTrialToV1alpha3 failed: %v", err)
+	}
+	if !reflect.DeepEqual(back, in) {
+		t.Errorf("Round-trip conversion changed the Trial\nexpected: %+v\ngot:      %+v", in, back)
+	}
+
+	in.Spec.RunSpec = "kind: [Job"
+	if _, err := ConvertTrialToV1beta1(in); err == nil {
+		t.Errorf("Expected error for invalid run spec")
+	}
+}
+
+func TestConvertTrialToV1alpha3(t *testing.T) {
+	in := newFakeV1beta1Trial()
+	out, err := ConvertTrialToV1alpha3(in.DeepCopy())
+	if err != nil {
+		t.Fatalf("ConvertTrialToV1alpha3 failed: %v", err)
+	}
+	expectedRunSpec := "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: tpe-example-abc\n"
+	if out.Spec.RunSpec != expectedRunSpec {
+		t.Errorf("Expected run spec %v, got %v", expectedRunSpec, out.Spec.RunSpec)
+	}
+	if value := out.Status.Observation.Metrics[0].Value; value != 0.75 {
+		t.Errorf("Expected avg value of the objective metric 0.75, got %v", value)
+	}
+
+	back, err := ConvertTrialToV1beta1(out)
+	if err != nil {
+		t.Fatalf("ConvertTrialToV1beta1 failed: %v", err)
+	}
+	if !reflect.DeepEqual(back, in) {
+		t.Errorf("Round-trip conversion changed the Trial\nexpected: %+v\ngot:      %+v", in, back)
+	}
+}
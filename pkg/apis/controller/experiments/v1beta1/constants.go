@@ -27,4 +27,19 @@ const (
 
 	// Default value of Spec.DefaultResumePolicy
 	DefaultResumePolicy = LongRunning
+
+	// Default value of Spec.Notifications[].MaxRetries
+	DefaultNotificationMaxRetries = 3
+
+	// Default value of Spec.Objective.MetricStrategies[].LastN for lastNAvg strategy
+	DefaultMetricStrategyLastN = 10
+
+	// Default value of Spec.Repetitions.Count
+	DefaultRepetitionCount = 1
+
+	// Default value of Spec.Repetitions.SeedParameterName
+	DefaultRepetitionSeedParameterName = "seed"
+
+	// Default value of Spec.Repetitions.Aggregation
+	DefaultRepetitionAggregation = AggregationMean
 )
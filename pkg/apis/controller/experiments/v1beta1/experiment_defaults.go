@@ -33,6 +33,8 @@ func (e *Experiment) SetDefault() {
 	e.setDefaultObjective()
 	e.setDefaultTrialTemplate()
 	e.setDefaultMetricsCollector()
+	e.setDefaultNotifications()
+	e.setDefaultRepetitions()
 }
 
 func (e *Experiment) setDefaultParallelTrialCount() {
@@ -92,6 +94,12 @@ func (e *Experiment) setDefaultObjective() {
 			obj.MetricStrategies = append(obj.MetricStrategies, strategy)
 		}
 	}
+
+	for i := range obj.MetricStrategies {
+		if obj.MetricStrategies[i].Value == common.ExtractByLastNAvg && obj.MetricStrategies[i].LastN == 0 {
+			obj.MetricStrategies[i].LastN = DefaultMetricStrategyLastN
+		}
+	}
 }
 
 func (e *Experiment) setDefaultTrialTemplate() {
@@ -181,3 +189,32 @@ func (e *Experiment) setDefaultMetricsCollector() {
 		}
 	}
 }
+
+func (e *Experiment) setDefaultNotifications() {
+	for i := range e.Spec.Notifications {
+		notification := &e.Spec.Notifications[i]
+		if len(notification.Events) == 0 {
+			notification.Events = []NotificationEventType{NotificationEventSucceeded, NotificationEventFailed}
+		}
+		if notification.MaxRetries == nil {
+			notification.MaxRetries = new(int32)
+			*notification.MaxRetries = DefaultNotificationMaxRetries
+		}
+	}
+}
+
+func (e *Experiment) setDefaultRepetitions() {
+	repetitions := e.Spec.Repetitions
+	if repetitions == nil {
+		return
+	}
+	if repetitions.Count == 0 {
+		repetitions.Count = DefaultRepetitionCount
+	}
+	if repetitions.SeedParameterName == "" {
+		repetitions.SeedParameterName = DefaultRepetitionSeedParameterName
+	}
+	if repetitions.Aggregation == "" {
+		repetitions.Aggregation = DefaultRepetitionAggregation
+	}
+}
@@ -53,6 +53,16 @@ type ExperimentSpec struct {
 	// Describes resuming policy which usually take effect after experiment terminated.
 	ResumePolicy ResumePolicyType `json:"resumePolicy,omitempty"`
 
+	// List of webhooks which are notified about the experiment events.
+	Notifications []NotificationSpec `json:"notifications,omitempty"`
+
+	// Describes the job which is created from the best trial when the experiment is succeeded.
+	PromoteBestTrial *PromotionSpec `json:"promoteBestTrial,omitempty"`
+
+	// Describes how many times each suggested parameter assignment is run
+	// and how observations of the repeated trials are aggregated.
+	Repetitions *RepetitionSpec `json:"repetitions,omitempty"`
+
 	// TODO - Other fields, exact format is TBD. Will add these back during implementation.
 	// - Early stopping
 }
@@ -111,6 +121,9 @@ type ExperimentStatus struct {
 
 	// How many trials are currently running.
 	TrialsRunning int32 `json:"trialsRunning,omitempty"`
+
+	// Reference to the job which is created from the best trial.
+	PromotedJob *v1.ObjectReference `json:"promotedJob,omitempty"`
 }
 
 // OptimalTrial is the metrics and assignments of the best trial.
@@ -122,6 +135,13 @@ type OptimalTrial struct {
 
 	// Observation for this trial
 	Observation common.Observation `json:"observation,omitempty"`
+
+	// Names of the repeated trials with the same parameter assignments.
+	// Observation is aggregated from these trials if repetitions are set.
+	RepetitionTrialNames []string `json:"repetitionTrialNames,omitempty"`
+
+	// Checkpoints, models and other outputs reported by the best trial.
+	Artifacts []common.Artifact `json:"artifacts,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -174,6 +194,82 @@ const (
 	FromVolume ResumePolicyType = "FromVolume"
 )
 
+// NotificationSpec describes the webhook which is called on the experiment events.
+type NotificationSpec struct {
+	// URL of the HTTP endpoint. The payload is sent with the POST request.
+	URL string `json:"url"`
+
+	// List of events which trigger the notification.
+	// Defaults to Succeeded and Failed.
+	Events []NotificationEventType `json:"events,omitempty"`
+
+	// Go template of the JSON payload. The template is executed with
+	// .Event, .Experiment and .BestTrial fields.
+	// Default payload contains the experiment, the event, the condition and the best trial.
+	PayloadTemplate string `json:"payloadTemplate,omitempty"`
+
+	// Secret key in the experiment namespace with the value of the Authorization header.
+	AuthSecretRef *v1.SecretKeySelector `json:"authSecretRef,omitempty"`
+
+	// Max number of retries with exponential backoff if the request fails.
+	// Defaults to 3
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
+// NotificationEventType describes the experiment event which triggers the notification.
+type NotificationEventType string
+
+const (
+	// NotificationEventSucceeded is sent when the experiment is succeeded.
+	NotificationEventSucceeded NotificationEventType = "Succeeded"
+	// NotificationEventFailed is sent when the experiment is failed.
+	NotificationEventFailed NotificationEventType = "Failed"
+	// NotificationEventNewBestTrial is sent when the experiment finds a new best trial.
+	NotificationEventNewBestTrial NotificationEventType = "NewBestTrial"
+)
+
+// PromotionSpec describes the standalone job which is rendered from the trial template
+// with the parameter assignments of the best trial. The job isn't owned by the experiment,
+// so it isn't deleted with the experiment.
+type PromotionSpec struct {
+	// Name of the job. Defaults to <experiment name>-best.
+	Name string `json:"name,omitempty"`
+
+	// Parameter assignments which override the assignments of the best trial.
+	ParameterOverrides []common.ParameterAssignment `json:"parameterOverrides,omitempty"`
+
+	// JSON merge patch which is applied to the rendered job, e.g. to train for more epochs.
+	// Patch can't change apiVersion, kind and metadata of the job.
+	RunSpecPatch string `json:"runSpecPatch,omitempty"`
+}
+
+// RepetitionSpec describes the repeated trials for noisy objectives.
+// Each suggested parameter assignment is run Count times with different seeds,
+// trial counts of the experiment include the repeated trials.
+type RepetitionSpec struct {
+	// How many trials are run for each parameter assignment. Defaults to 1.
+	Count int32 `json:"count,omitempty"`
+
+	// Name of the trial parameter with the seed of the repeated trial, e.g. ${trialParameters.seed}.
+	// The seed is the index of the repetition, starting from 0. Defaults to seed.
+	SeedParameterName string `json:"seedParameterName,omitempty"`
+
+	// How observations of the repeated trials are aggregated before they are reported
+	// to the suggestion service and compared to find the best trial. Defaults to mean.
+	// With std the mean is compared and the standard deviation is reported alongside
+	// as the <metric name>-std metric.
+	Aggregation AggregationType `json:"aggregation,omitempty"`
+}
+
+// AggregationType describes how metric values of the repeated trials are aggregated.
+type AggregationType string
+
+const (
+	AggregationMean   AggregationType = "mean"
+	AggregationMedian AggregationType = "median"
+	AggregationStd    AggregationType = "std"
+)
+
 type ParameterSpec struct {
 	Name          string        `json:"name,omitempty"`
 	ParameterType ParameterType `json:"parameterType,omitempty"`
@@ -195,8 +291,31 @@ type FeasibleSpace struct {
 	Min  string   `json:"min,omitempty"`
 	List []string `json:"list,omitempty"`
 	Step string   `json:"step,omitempty"`
+
+	// Distribution is the prior distribution of the double or int parameter.
+	// Step quantizes values of uniform and normal distributions, logUniform
+	// doesn't support Step and qLogUniform requires it. Default is uniform.
+	Distribution Distribution `json:"distribution,omitempty"`
 }
 
+// Distribution describes the prior distribution of the parameter values.
+type Distribution string
+
+const (
+	// DistributionUniform samples values uniformly between Min and Max.
+	DistributionUniform Distribution = "uniform"
+	// DistributionLogUniform samples the logarithm of values uniformly between
+	// Min and Max. Min must be positive.
+	DistributionLogUniform Distribution = "logUniform"
+	// DistributionQLogUniform samples values as DistributionLogUniform and
+	// quantizes them by Step. Min must be positive.
+	DistributionQLogUniform Distribution = "qLogUniform"
+	// DistributionNormal samples values from the normal distribution with
+	// mean (Min + Max) / 2 and standard deviation (Max - Min) / 6. Values are
+	// clipped to Min and Max.
+	DistributionNormal Distribution = "normal"
+)
+
 // TrialTemplate describes structure of trial template
 type TrialTemplate struct {
 	// Retain indicates that trial resources must be not cleanup
@@ -64,6 +64,10 @@ type SuggestionStatus struct {
 
 	// List of observed runtime conditions for this Suggestion.
 	Conditions []SuggestionCondition `json:"conditions,omitempty"`
+
+	// Endpoint of the shared algorithm service which serves this Suggestion.
+	// It is empty if the Suggestion has own Deployment and Service.
+	Endpoint string `json:"endpoint,omitempty"`
 }
 
 // TrialAssignment is the assignment for one trial.
@@ -78,6 +78,9 @@ type TrialStatus struct {
 
 	// Results of the Trial - objectives and other metrics values.
 	Observation *common.Observation `json:"observation,omitempty"`
+
+	// Checkpoints, models and other outputs reported by the Trial.
+	Artifacts []common.Artifact `json:"artifacts,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -168,6 +168,13 @@ func (in *TrialStatus) DeepCopyInto(out *TrialStatus) {
 		*out = new(commonv1beta1.Observation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]commonv1beta1.Artifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -33,6 +33,16 @@ It has these top-level messages:
 	DeleteObservationLogReply
 	GetObservationLogRequest
 	GetObservationLogReply
+	ReportTrialLogsRequest
+	ReportTrialLogsReply
+	GetTrialLogsRequest
+	GetTrialLogsReply
+	Artifact
+	ArtifactMetadata
+	ReportArtifactsRequest
+	ReportArtifactsReply
+	GetArtifactsRequest
+	GetArtifactsReply
 	GetSuggestionsRequest
 	GetSuggestionsReply
 	ValidateAlgorithmSettingsRequest
@@ -93,6 +103,35 @@ func (x ParameterType) String() string {
 }
 func (ParameterType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+// *
+// Prior distribution of values for Double and Int HyperParameter.
+type Distribution int32
+
+const (
+	Distribution_UNIFORM      Distribution = 0
+	Distribution_LOG_UNIFORM  Distribution = 1
+	Distribution_NORMAL       Distribution = 2
+	Distribution_QLOG_UNIFORM Distribution = 3
+)
+
+var Distribution_name = map[int32]string{
+	0: "UNIFORM",
+	1: "LOG_UNIFORM",
+	2: "NORMAL",
+	3: "QLOG_UNIFORM",
+}
+var Distribution_value = map[string]int32{
+	"UNIFORM":      0,
+	"LOG_UNIFORM":  1,
+	"NORMAL":       2,
+	"QLOG_UNIFORM": 3,
+}
+
+func (x Distribution) String() string {
+	return proto.EnumName(Distribution_name, int32(x))
+}
+func (Distribution) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
 // *
 // Direction of optimization. Minimize or Maximize.
 type ObjectiveType int32
@@ -117,7 +156,7 @@ var ObjectiveType_value = map[string]int32{
 func (x ObjectiveType) String() string {
 	return proto.EnumName(ObjectiveType_name, int32(x))
 }
-func (ObjectiveType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (ObjectiveType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 type TrialStatus_TrialConditionType int32
 
@@ -158,11 +197,14 @@ func (TrialStatus_TrialConditionType) EnumDescriptor() ([]byte, []int) {
 // Feasible space for optimization.
 // Int and Double type use Max/Min.
 // Discrete and Categorical type use List.
+// Int and Double type can use Distribution. Step quantizes values of uniform and normal Distribution,
+// log uniform Distribution doesn't support Step and quantized log uniform Distribution requires it.
 type FeasibleSpace struct {
-	Max  string   `protobuf:"bytes,1,opt,name=max" json:"max,omitempty"`
-	Min  string   `protobuf:"bytes,2,opt,name=min" json:"min,omitempty"`
-	List []string `protobuf:"bytes,3,rep,name=list" json:"list,omitempty"`
-	Step string   `protobuf:"bytes,4,opt,name=step" json:"step,omitempty"`
+	Max          string       `protobuf:"bytes,1,opt,name=max" json:"max,omitempty"`
+	Min          string       `protobuf:"bytes,2,opt,name=min" json:"min,omitempty"`
+	List         []string     `protobuf:"bytes,3,rep,name=list" json:"list,omitempty"`
+	Step         string       `protobuf:"bytes,4,opt,name=step" json:"step,omitempty"`
+	Distribution Distribution `protobuf:"varint,5,opt,name=distribution,enum=api.v1.beta1.Distribution" json:"distribution,omitempty"`
 }
 
 func (m *FeasibleSpace) Reset()                    { *m = FeasibleSpace{} }
@@ -198,6 +240,13 @@ func (m *FeasibleSpace) GetStep() string {
 	return ""
 }
 
+func (m *FeasibleSpace) GetDistribution() Distribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return Distribution_UNIFORM
+}
+
 // *
 // Config for a Hyper parameter.
 // Katib will create each Hyper parameter from this config.
@@ -554,8 +603,10 @@ func (m *ExperimentSpec_ParameterSpecs) GetParameters() []*ParameterSpec {
 }
 
 type Experiment struct {
-	Name string          `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Spec *ExperimentSpec `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+	Name      string          `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Spec      *ExperimentSpec `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+	Namespace string          `protobuf:"bytes,3,opt,name=namespace" json:"namespace,omitempty"`
+	Uid       string          `protobuf:"bytes,4,opt,name=uid" json:"uid,omitempty"`
 }
 
 func (m *Experiment) Reset()                    { *m = Experiment{} }
@@ -577,6 +628,20 @@ func (m *Experiment) GetSpec() *ExperimentSpec {
 	return nil
 }
 
+func (m *Experiment) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Experiment) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
 type ParameterAssignment struct {
 	Name  string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
@@ -824,6 +889,8 @@ func (m *Trial) GetStatus() *TrialStatus {
 type ReportObservationLogRequest struct {
 	TrialName      string          `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
 	ObservationLog *ObservationLog `protobuf:"bytes,2,opt,name=observation_log,json=observationLog" json:"observation_log,omitempty"`
+	Namespace      string          `protobuf:"bytes,3,opt,name=namespace" json:"namespace,omitempty"`
+	TrialUid       string          `protobuf:"bytes,4,opt,name=trial_uid,json=trialUid" json:"trial_uid,omitempty"`
 }
 
 func (m *ReportObservationLogRequest) Reset()                    { *m = ReportObservationLogRequest{} }
@@ -845,6 +912,20 @@ func (m *ReportObservationLogRequest) GetObservationLog() *ObservationLog {
 	return nil
 }
 
+func (m *ReportObservationLogRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ReportObservationLogRequest) GetTrialUid() string {
+	if m != nil {
+		return m.TrialUid
+	}
+	return ""
+}
+
 type ReportObservationLogReply struct {
 }
 
@@ -855,6 +936,8 @@ func (*ReportObservationLogReply) Descriptor() ([]byte, []int) { return fileDesc
 
 type DeleteObservationLogRequest struct {
 	TrialName string `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	TrialUid  string `protobuf:"bytes,3,opt,name=trial_uid,json=trialUid" json:"trial_uid,omitempty"`
 }
 
 func (m *DeleteObservationLogRequest) Reset()                    { *m = DeleteObservationLogRequest{} }
@@ -869,6 +952,20 @@ func (m *DeleteObservationLogRequest) GetTrialName() string {
 	return ""
 }
 
+func (m *DeleteObservationLogRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteObservationLogRequest) GetTrialUid() string {
+	if m != nil {
+		return m.TrialUid
+	}
+	return ""
+}
+
 type DeleteObservationLogReply struct {
 }
 
@@ -882,6 +979,8 @@ type GetObservationLogRequest struct {
 	MetricName string `protobuf:"bytes,2,opt,name=metric_name,json=metricName" json:"metric_name,omitempty"`
 	StartTime  string `protobuf:"bytes,3,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
 	EndTime    string `protobuf:"bytes,4,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
+	Namespace  string `protobuf:"bytes,5,opt,name=namespace" json:"namespace,omitempty"`
+	TrialUid   string `protobuf:"bytes,6,opt,name=trial_uid,json=trialUid" json:"trial_uid,omitempty"`
 }
 
 func (m *GetObservationLogRequest) Reset()                    { *m = GetObservationLogRequest{} }
@@ -917,6 +1016,20 @@ func (m *GetObservationLogRequest) GetEndTime() string {
 	return ""
 }
 
+func (m *GetObservationLogRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetObservationLogRequest) GetTrialUid() string {
+	if m != nil {
+		return m.TrialUid
+	}
+	return ""
+}
+
 type GetObservationLogReply struct {
 	ObservationLog *ObservationLog `protobuf:"bytes,1,opt,name=observation_log,json=observationLog" json:"observation_log,omitempty"`
 }
@@ -933,6 +1046,256 @@ func (m *GetObservationLogReply) GetObservationLog() *ObservationLog {
 	return nil
 }
 
+type ReportTrialLogsRequest struct {
+	TrialName string `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	TrialUid  string `protobuf:"bytes,3,opt,name=trial_uid,json=trialUid" json:"trial_uid,omitempty"`
+	Logs      string `protobuf:"bytes,4,opt,name=logs" json:"logs,omitempty"`
+}
+
+func (m *ReportTrialLogsRequest) Reset()                    { *m = ReportTrialLogsRequest{} }
+func (m *ReportTrialLogsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReportTrialLogsRequest) ProtoMessage()               {}
+func (*ReportTrialLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *ReportTrialLogsRequest) GetTrialName() string {
+	if m != nil {
+		return m.TrialName
+	}
+	return ""
+}
+
+func (m *ReportTrialLogsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ReportTrialLogsRequest) GetTrialUid() string {
+	if m != nil {
+		return m.TrialUid
+	}
+	return ""
+}
+
+func (m *ReportTrialLogsRequest) GetLogs() string {
+	if m != nil {
+		return m.Logs
+	}
+	return ""
+}
+
+type ReportTrialLogsReply struct {
+}
+
+func (m *ReportTrialLogsReply) Reset()                    { *m = ReportTrialLogsReply{} }
+func (m *ReportTrialLogsReply) String() string            { return proto.CompactTextString(m) }
+func (*ReportTrialLogsReply) ProtoMessage()               {}
+func (*ReportTrialLogsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+type GetTrialLogsRequest struct {
+	TrialName string `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	TrialUid  string `protobuf:"bytes,3,opt,name=trial_uid,json=trialUid" json:"trial_uid,omitempty"`
+}
+
+func (m *GetTrialLogsRequest) Reset()                    { *m = GetTrialLogsRequest{} }
+func (m *GetTrialLogsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTrialLogsRequest) ProtoMessage()               {}
+func (*GetTrialLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *GetTrialLogsRequest) GetTrialName() string {
+	if m != nil {
+		return m.TrialName
+	}
+	return ""
+}
+
+func (m *GetTrialLogsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetTrialLogsRequest) GetTrialUid() string {
+	if m != nil {
+		return m.TrialUid
+	}
+	return ""
+}
+
+type GetTrialLogsReply struct {
+	Logs string `protobuf:"bytes,1,opt,name=logs" json:"logs,omitempty"`
+}
+
+func (m *GetTrialLogsReply) Reset()                    { *m = GetTrialLogsReply{} }
+func (m *GetTrialLogsReply) String() string            { return proto.CompactTextString(m) }
+func (*GetTrialLogsReply) ProtoMessage()               {}
+func (*GetTrialLogsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *GetTrialLogsReply) GetLogs() string {
+	if m != nil {
+		return m.Logs
+	}
+	return ""
+}
+
+// *
+// Artifact is a checkpoint, model or other output written by a Trial.
+type Artifact struct {
+	Name     string              `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Uri      string              `protobuf:"bytes,2,opt,name=uri" json:"uri,omitempty"`
+	Metadata []*ArtifactMetadata `protobuf:"bytes,3,rep,name=metadata" json:"metadata,omitempty"`
+}
+
+func (m *Artifact) Reset()                    { *m = Artifact{} }
+func (m *Artifact) String() string            { return proto.CompactTextString(m) }
+func (*Artifact) ProtoMessage()               {}
+func (*Artifact) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *Artifact) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Artifact) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *Artifact) GetMetadata() []*ArtifactMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type ArtifactMetadata struct {
+	Name  string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *ArtifactMetadata) Reset()                    { *m = ArtifactMetadata{} }
+func (m *ArtifactMetadata) String() string            { return proto.CompactTextString(m) }
+func (*ArtifactMetadata) ProtoMessage()               {}
+func (*ArtifactMetadata) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *ArtifactMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ArtifactMetadata) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type ReportArtifactsRequest struct {
+	TrialName string      `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	Namespace string      `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	TrialUid  string      `protobuf:"bytes,3,opt,name=trial_uid,json=trialUid" json:"trial_uid,omitempty"`
+	Artifacts []*Artifact `protobuf:"bytes,4,rep,name=artifacts" json:"artifacts,omitempty"`
+}
+
+func (m *ReportArtifactsRequest) Reset()                    { *m = ReportArtifactsRequest{} }
+func (m *ReportArtifactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReportArtifactsRequest) ProtoMessage()               {}
+func (*ReportArtifactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *ReportArtifactsRequest) GetTrialName() string {
+	if m != nil {
+		return m.TrialName
+	}
+	return ""
+}
+
+func (m *ReportArtifactsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ReportArtifactsRequest) GetTrialUid() string {
+	if m != nil {
+		return m.TrialUid
+	}
+	return ""
+}
+
+func (m *ReportArtifactsRequest) GetArtifacts() []*Artifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+type ReportArtifactsReply struct {
+}
+
+func (m *ReportArtifactsReply) Reset()                    { *m = ReportArtifactsReply{} }
+func (m *ReportArtifactsReply) String() string            { return proto.CompactTextString(m) }
+func (*ReportArtifactsReply) ProtoMessage()               {}
+func (*ReportArtifactsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+type GetArtifactsRequest struct {
+	TrialName string `protobuf:"bytes,1,opt,name=trial_name,json=trialName" json:"trial_name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	TrialUid  string `protobuf:"bytes,3,opt,name=trial_uid,json=trialUid" json:"trial_uid,omitempty"`
+}
+
+func (m *GetArtifactsRequest) Reset()                    { *m = GetArtifactsRequest{} }
+func (m *GetArtifactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetArtifactsRequest) ProtoMessage()               {}
+func (*GetArtifactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *GetArtifactsRequest) GetTrialName() string {
+	if m != nil {
+		return m.TrialName
+	}
+	return ""
+}
+
+func (m *GetArtifactsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetArtifactsRequest) GetTrialUid() string {
+	if m != nil {
+		return m.TrialUid
+	}
+	return ""
+}
+
+type GetArtifactsReply struct {
+	Artifacts []*Artifact `protobuf:"bytes,1,rep,name=artifacts" json:"artifacts,omitempty"`
+}
+
+func (m *GetArtifactsReply) Reset()                    { *m = GetArtifactsReply{} }
+func (m *GetArtifactsReply) String() string            { return proto.CompactTextString(m) }
+func (*GetArtifactsReply) ProtoMessage()               {}
+func (*GetArtifactsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *GetArtifactsReply) GetArtifacts() []*Artifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
 type GetSuggestionsRequest struct {
 	Experiment    *Experiment `protobuf:"bytes,1,opt,name=experiment" json:"experiment,omitempty"`
 	Trials        []*Trial    `protobuf:"bytes,2,rep,name=trials" json:"trials,omitempty"`
@@ -942,7 +1305,7 @@ type GetSuggestionsRequest struct {
 func (m *GetSuggestionsRequest) Reset()                    { *m = GetSuggestionsRequest{} }
 func (m *GetSuggestionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetSuggestionsRequest) ProtoMessage()               {}
-func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *GetSuggestionsRequest) GetExperiment() *Experiment {
 	if m != nil {
@@ -973,7 +1336,7 @@ type GetSuggestionsReply struct {
 func (m *GetSuggestionsReply) Reset()                    { *m = GetSuggestionsReply{} }
 func (m *GetSuggestionsReply) String() string            { return proto.CompactTextString(m) }
 func (*GetSuggestionsReply) ProtoMessage()               {}
-func (*GetSuggestionsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*GetSuggestionsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *GetSuggestionsReply) GetParameterAssignments() []*GetSuggestionsReply_ParameterAssignments {
 	if m != nil {
@@ -999,7 +1362,7 @@ func (m *GetSuggestionsReply_ParameterAssignments) Reset() {
 func (m *GetSuggestionsReply_ParameterAssignments) String() string { return proto.CompactTextString(m) }
 func (*GetSuggestionsReply_ParameterAssignments) ProtoMessage()    {}
 func (*GetSuggestionsReply_ParameterAssignments) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{26, 0}
+	return fileDescriptor0, []int{36, 0}
 }
 
 func (m *GetSuggestionsReply_ParameterAssignments) GetAssignments() []*ParameterAssignment {
@@ -1017,7 +1380,7 @@ func (m *ValidateAlgorithmSettingsRequest) Reset()         { *m = ValidateAlgori
 func (m *ValidateAlgorithmSettingsRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidateAlgorithmSettingsRequest) ProtoMessage()    {}
 func (*ValidateAlgorithmSettingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{27}
+	return fileDescriptor0, []int{37}
 }
 
 func (m *ValidateAlgorithmSettingsRequest) GetExperiment() *Experiment {
@@ -1035,7 +1398,7 @@ type ValidateAlgorithmSettingsReply struct {
 func (m *ValidateAlgorithmSettingsReply) Reset()                    { *m = ValidateAlgorithmSettingsReply{} }
 func (m *ValidateAlgorithmSettingsReply) String() string            { return proto.CompactTextString(m) }
 func (*ValidateAlgorithmSettingsReply) ProtoMessage()               {}
-func (*ValidateAlgorithmSettingsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*ValidateAlgorithmSettingsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func init() {
 	proto.RegisterType((*FeasibleSpace)(nil), "api.v1.beta1.FeasibleSpace")
@@ -1067,12 +1430,23 @@ func init() {
 	proto.RegisterType((*DeleteObservationLogReply)(nil), "api.v1.beta1.DeleteObservationLogReply")
 	proto.RegisterType((*GetObservationLogRequest)(nil), "api.v1.beta1.GetObservationLogRequest")
 	proto.RegisterType((*GetObservationLogReply)(nil), "api.v1.beta1.GetObservationLogReply")
+	proto.RegisterType((*ReportTrialLogsRequest)(nil), "api.v1.beta1.ReportTrialLogsRequest")
+	proto.RegisterType((*ReportTrialLogsReply)(nil), "api.v1.beta1.ReportTrialLogsReply")
+	proto.RegisterType((*GetTrialLogsRequest)(nil), "api.v1.beta1.GetTrialLogsRequest")
+	proto.RegisterType((*GetTrialLogsReply)(nil), "api.v1.beta1.GetTrialLogsReply")
+	proto.RegisterType((*Artifact)(nil), "api.v1.beta1.Artifact")
+	proto.RegisterType((*ArtifactMetadata)(nil), "api.v1.beta1.ArtifactMetadata")
+	proto.RegisterType((*ReportArtifactsRequest)(nil), "api.v1.beta1.ReportArtifactsRequest")
+	proto.RegisterType((*ReportArtifactsReply)(nil), "api.v1.beta1.ReportArtifactsReply")
+	proto.RegisterType((*GetArtifactsRequest)(nil), "api.v1.beta1.GetArtifactsRequest")
+	proto.RegisterType((*GetArtifactsReply)(nil), "api.v1.beta1.GetArtifactsReply")
 	proto.RegisterType((*GetSuggestionsRequest)(nil), "api.v1.beta1.GetSuggestionsRequest")
 	proto.RegisterType((*GetSuggestionsReply)(nil), "api.v1.beta1.GetSuggestionsReply")
 	proto.RegisterType((*GetSuggestionsReply_ParameterAssignments)(nil), "api.v1.beta1.GetSuggestionsReply.ParameterAssignments")
 	proto.RegisterType((*ValidateAlgorithmSettingsRequest)(nil), "api.v1.beta1.ValidateAlgorithmSettingsRequest")
 	proto.RegisterType((*ValidateAlgorithmSettingsReply)(nil), "api.v1.beta1.ValidateAlgorithmSettingsReply")
 	proto.RegisterEnum("api.v1.beta1.ParameterType", ParameterType_name, ParameterType_value)
+	proto.RegisterEnum("api.v1.beta1.Distribution", Distribution_name, Distribution_value)
 	proto.RegisterEnum("api.v1.beta1.ObjectiveType", ObjectiveType_name, ObjectiveType_value)
 	proto.RegisterEnum("api.v1.beta1.TrialStatus_TrialConditionType", TrialStatus_TrialConditionType_name, TrialStatus_TrialConditionType_value)
 }
@@ -1100,6 +1474,20 @@ type DBManagerClient interface {
 	// *
 	// Delete all log of Observations for a Trial.
 	DeleteObservationLog(ctx context.Context, in *DeleteObservationLogRequest, opts ...grpc.CallOption) (*DeleteObservationLogReply, error)
+	// *
+	// Report the last part of the primary container logs for a failed Trial.
+	// Katib keeps the logs after the Trial job is deleted.
+	ReportTrialLogs(ctx context.Context, in *ReportTrialLogsRequest, opts ...grpc.CallOption) (*ReportTrialLogsReply, error)
+	// *
+	// Get the reported container logs for a Trial.
+	GetTrialLogs(ctx context.Context, in *GetTrialLogsRequest, opts ...grpc.CallOption) (*GetTrialLogsReply, error)
+	// *
+	// Report the checkpoints, models and other outputs written by a Trial.
+	// Artifacts reported before for the Trial with the same names are replaced.
+	ReportArtifacts(ctx context.Context, in *ReportArtifactsRequest, opts ...grpc.CallOption) (*ReportArtifactsReply, error)
+	// *
+	// Get all reported artifacts for a Trial.
+	GetArtifacts(ctx context.Context, in *GetArtifactsRequest, opts ...grpc.CallOption) (*GetArtifactsReply, error)
 }
 
 type dBManagerClient struct {
@@ -1137,6 +1525,42 @@ func (c *dBManagerClient) DeleteObservationLog(ctx context.Context, in *DeleteOb
 	return out, nil
 }
 
+func (c *dBManagerClient) ReportTrialLogs(ctx context.Context, in *ReportTrialLogsRequest, opts ...grpc.CallOption) (*ReportTrialLogsReply, error) {
+	out := new(ReportTrialLogsReply)
+	err := grpc.Invoke(ctx, "/api.v1.beta1.DBManager/ReportTrialLogs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBManagerClient) GetTrialLogs(ctx context.Context, in *GetTrialLogsRequest, opts ...grpc.CallOption) (*GetTrialLogsReply, error) {
+	out := new(GetTrialLogsReply)
+	err := grpc.Invoke(ctx, "/api.v1.beta1.DBManager/GetTrialLogs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBManagerClient) ReportArtifacts(ctx context.Context, in *ReportArtifactsRequest, opts ...grpc.CallOption) (*ReportArtifactsReply, error) {
+	out := new(ReportArtifactsReply)
+	err := grpc.Invoke(ctx, "/api.v1.beta1.DBManager/ReportArtifacts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBManagerClient) GetArtifacts(ctx context.Context, in *GetArtifactsRequest, opts ...grpc.CallOption) (*GetArtifactsReply, error) {
+	out := new(GetArtifactsReply)
+	err := grpc.Invoke(ctx, "/api.v1.beta1.DBManager/GetArtifacts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for DBManager service
 
 type DBManagerServer interface {
@@ -1152,6 +1576,20 @@ type DBManagerServer interface {
 	// *
 	// Delete all log of Observations for a Trial.
 	DeleteObservationLog(context.Context, *DeleteObservationLogRequest) (*DeleteObservationLogReply, error)
+	// *
+	// Report the last part of the primary container logs for a failed Trial.
+	// Katib keeps the logs after the Trial job is deleted.
+	ReportTrialLogs(context.Context, *ReportTrialLogsRequest) (*ReportTrialLogsReply, error)
+	// *
+	// Get the reported container logs for a Trial.
+	GetTrialLogs(context.Context, *GetTrialLogsRequest) (*GetTrialLogsReply, error)
+	// *
+	// Report the checkpoints, models and other outputs written by a Trial.
+	// Artifacts reported before for the Trial with the same names are replaced.
+	ReportArtifacts(context.Context, *ReportArtifactsRequest) (*ReportArtifactsReply, error)
+	// *
+	// Get all reported artifacts for a Trial.
+	GetArtifacts(context.Context, *GetArtifactsRequest) (*GetArtifactsReply, error)
 }
 
 func RegisterDBManagerServer(s *grpc.Server, srv DBManagerServer) {
@@ -1212,6 +1650,78 @@ func _DBManager_DeleteObservationLog_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DBManager_ReportTrialLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportTrialLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBManagerServer).ReportTrialLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.beta1.DBManager/ReportTrialLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBManagerServer).ReportTrialLogs(ctx, req.(*ReportTrialLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBManager_GetTrialLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrialLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBManagerServer).GetTrialLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.beta1.DBManager/GetTrialLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBManagerServer).GetTrialLogs(ctx, req.(*GetTrialLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBManager_ReportArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBManagerServer).ReportArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.beta1.DBManager/ReportArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBManagerServer).ReportArtifacts(ctx, req.(*ReportArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBManager_GetArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBManagerServer).GetArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.beta1.DBManager/GetArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBManagerServer).GetArtifacts(ctx, req.(*GetArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DBManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.v1.beta1.DBManager",
 	HandlerType: (*DBManagerServer)(nil),
@@ -1228,6 +1738,22 @@ var _DBManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteObservationLog",
 			Handler:    _DBManager_DeleteObservationLog_Handler,
 		},
+		{
+			MethodName: "ReportTrialLogs",
+			Handler:    _DBManager_ReportTrialLogs_Handler,
+		},
+		{
+			MethodName: "GetTrialLogs",
+			Handler:    _DBManager_GetTrialLogs_Handler,
+		},
+		{
+			MethodName: "ReportArtifacts",
+			Handler:    _DBManager_ReportArtifacts_Handler,
+		},
+		{
+			MethodName: "GetArtifacts",
+			Handler:    _DBManager_GetArtifacts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -1363,108 +1889,129 @@ var _EarlyStopping_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x92, 0x1b, 0x49,
-	0x11, 0x76, 0x4b, 0xf3, 0xe3, 0x4e, 0x8d, 0x34, 0x72, 0x8d, 0xbc, 0xd6, 0x8c, 0x97, 0xf5, 0xb8,
-	0x01, 0xaf, 0x59, 0x3b, 0xe4, 0xb5, 0x00, 0x87, 0x89, 0x35, 0x41, 0x8c, 0x25, 0xed, 0x84, 0x76,
-	0x67, 0xa4, 0x8d, 0x92, 0x06, 0x0c, 0x4b, 0x44, 0x47, 0x8d, 0xa6, 0xac, 0x6d, 0xd3, 0x7f, 0x74,
-	0x95, 0x1c, 0x16, 0x5c, 0x88, 0xe0, 0x15, 0x38, 0xed, 0x9d, 0x13, 0x2f, 0x41, 0x70, 0xe0, 0x01,
-	0x38, 0x70, 0xe7, 0x4d, 0x88, 0xca, 0x6a, 0xf5, 0x8f, 0xd4, 0x1a, 0xaf, 0xc7, 0x70, 0xab, 0xca,
-	0xfc, 0x32, 0x2b, 0x33, 0x2b, 0xf3, 0xeb, 0x92, 0xc0, 0x64, 0xa1, 0xd3, 0x0a, 0xa3, 0x40, 0x06,
-	0x64, 0x47, 0x2d, 0x5f, 0x3f, 0x6e, 0x9d, 0x73, 0xc9, 0x1e, 0x1f, 0x7c, 0x38, 0x0d, 0x82, 0xa9,
-	0xcb, 0x1f, 0xb1, 0xd0, 0x79, 0xc4, 0x7c, 0x3f, 0x90, 0x4c, 0x3a, 0x81, 0x2f, 0x34, 0xd6, 0xfa,
-	0x1a, 0xaa, 0x9f, 0x73, 0x26, 0x9c, 0x73, 0x97, 0x8f, 0x42, 0x36, 0xe1, 0xa4, 0x0e, 0x65, 0x8f,
-	0xbd, 0x69, 0x1a, 0x87, 0xc6, 0x7d, 0x93, 0xaa, 0x25, 0x4a, 0x1c, 0xbf, 0x59, 0x8a, 0x25, 0x8e,
-	0x4f, 0x08, 0x6c, 0xb8, 0x8e, 0x90, 0xcd, 0xf2, 0x61, 0xf9, 0xbe, 0x49, 0x71, 0xad, 0x64, 0x42,
-	0xf2, 0xb0, 0xb9, 0x81, 0x30, 0x5c, 0x5b, 0x7f, 0x33, 0xa0, 0xfa, 0x15, 0x8b, 0x98, 0xc7, 0x25,
-	0x8f, 0x46, 0x21, 0x9f, 0x28, 0x94, 0xcf, 0x3c, 0x1e, 0xbb, 0xc7, 0x35, 0x79, 0x0e, 0xb5, 0x70,
-	0x01, 0xb2, 0xe5, 0x3c, 0xe4, 0x78, 0x54, 0xad, 0x7d, 0xbb, 0x95, 0xcd, 0xa3, 0x95, 0x38, 0x1a,
-	0xcf, 0x43, 0x4e, 0xab, 0x61, 0x76, 0xab, 0x7c, 0xbc, 0x8c, 0xd3, 0xb0, 0x85, 0xca, 0xa3, 0x59,
-	0x3e, 0x34, 0xee, 0x57, 0x96, 0x7d, 0xe4, 0x52, 0xa5, 0xd5, 0x97, 0xd9, 0xad, 0xf5, 0x77, 0x03,
-	0xaa, 0xc3, 0xf3, 0x57, 0x7c, 0x22, 0x9d, 0xd7, 0x1c, 0xa3, 0x7d, 0x04, 0x1b, 0x18, 0x8f, 0x51,
-	0x14, 0x4f, 0x02, 0xc5, 0x78, 0x10, 0xa8, 0xd2, 0x9b, 0x06, 0xcc, 0xc5, 0x04, 0x0c, 0x8a, 0x6b,
-	0xd2, 0x86, 0x9b, 0xc1, 0x02, 0x6a, 0x7b, 0x5c, 0x46, 0xce, 0xc4, 0xc6, 0x1a, 0x94, 0xb1, 0x06,
-	0x7b, 0x89, 0xf2, 0x14, 0x75, 0x03, 0x55, 0x92, 0x27, 0x70, 0x8b, 0x5d, 0x5c, 0x38, 0xea, 0xa2,
-	0x98, 0x9b, 0x35, 0x12, 0xcd, 0x0d, 0xac, 0xf9, 0xcd, 0x54, 0x9d, 0x9a, 0x09, 0xeb, 0x19, 0xd4,
-	0x8f, 0xdc, 0x69, 0x10, 0x39, 0xf2, 0x1b, 0x6f, 0xc4, 0xa5, 0x74, 0xfc, 0x69, 0x61, 0xc9, 0x1b,
-	0xb0, 0xf9, 0x9a, 0xb9, 0x33, 0x1e, 0x5f, 0xaa, 0xde, 0x58, 0x7b, 0x70, 0xa3, 0xc7, 0x22, 0x77,
-	0x3e, 0x92, 0x41, 0x18, 0x3a, 0xfe, 0x54, 0xd5, 0xc0, 0xfa, 0xb7, 0x01, 0xd5, 0xd4, 0xa7, 0xaa,
-	0xca, 0x0f, 0xa1, 0xc6, 0x16, 0x02, 0x3b, 0xe3, 0xba, 0x9a, 0x48, 0x31, 0x87, 0x53, 0x20, 0x29,
-	0x4c, 0xe8, 0x60, 0x44, 0xb3, 0x74, 0x58, 0xbe, 0x5f, 0x69, 0x7f, 0x94, 0x2f, 0xe5, 0x72, 0xcc,
-	0xf4, 0x06, 0x5b, 0x92, 0x08, 0x32, 0x84, 0x3d, 0xae, 0x82, 0xb3, 0x45, 0x1c, 0x9d, 0x2d, 0x42,
-	0x3e, 0x89, 0xaf, 0xf9, 0x4e, 0xde, 0xdf, 0x4a, 0x16, 0xf4, 0x06, 0x5f, 0x49, 0xec, 0x5f, 0x06,
-	0x98, 0x03, 0x26, 0x3a, 0x81, 0xff, 0xd2, 0x99, 0x92, 0x67, 0xb0, 0x33, 0x8d, 0x58, 0xf8, 0x8d,
-	0x3d, 0xc1, 0x3d, 0xa6, 0x54, 0x69, 0xef, 0xe7, 0xfd, 0x1e, 0x2b, 0x84, 0x36, 0xa0, 0x95, 0x69,
-	0xba, 0x21, 0xcf, 0x01, 0x82, 0x90, 0x47, 0x7a, 0xb2, 0xb0, 0xa8, 0x95, 0xb6, 0x95, 0xb7, 0x4d,
-	0x8e, 0x6a, 0x0d, 0x13, 0x24, 0xcd, 0x58, 0x1d, 0x74, 0x00, 0x52, 0x0d, 0xf9, 0x29, 0x98, 0x89,
-	0xae, 0x69, 0x60, 0xd1, 0x6e, 0x2d, 0xf5, 0xdf, 0x42, 0x4d, 0x53, 0xa4, 0x15, 0x42, 0x25, 0x13,
-	0x24, 0xf9, 0x1e, 0x80, 0x3f, 0xf3, 0x6c, 0x97, 0xcd, 0x79, 0x24, 0x30, 0xa7, 0x4d, 0x6a, 0xfa,
-	0x33, 0xef, 0x04, 0x05, 0xe4, 0x0e, 0x54, 0x1c, 0x3f, 0x9c, 0x49, 0x5b, 0x38, 0x7f, 0xe0, 0xfa,
-	0x6e, 0x36, 0x29, 0xa0, 0x68, 0xa4, 0x24, 0xe4, 0x2e, 0xec, 0x04, 0x33, 0x99, 0x22, 0xca, 0x88,
-	0xa8, 0x68, 0x19, 0x42, 0xb0, 0x8c, 0x49, 0x28, 0xaa, 0x37, 0x92, 0x60, 0xec, 0x64, 0x76, 0x4c,
-	0x5a, 0x4d, 0xa4, 0x38, 0xae, 0x43, 0xd8, 0x4d, 0x47, 0x5e, 0xdd, 0xe3, 0xa2, 0x68, 0xf7, 0xd6,
-	0xe4, 0xd8, 0xca, 0xd1, 0x88, 0xa0, 0x29, 0x63, 0xe0, 0xfe, 0xe0, 0x14, 0x6a, 0x79, 0x04, 0xf9,
-	0x0c, 0x20, 0xc1, 0x88, 0xb8, 0x82, 0xeb, 0x18, 0x05, 0x5b, 0x24, 0x03, 0xb7, 0xbe, 0xdd, 0x80,
-	0x5a, 0xef, 0x4d, 0xc8, 0x23, 0xc7, 0xe3, 0xbe, 0xc4, 0xae, 0x1f, 0xaf, 0x86, 0xac, 0x7b, 0xe4,
-	0xc1, 0x52, 0xef, 0xe5, 0xcc, 0xde, 0x12, 0x37, 0xf9, 0x19, 0x98, 0xc9, 0xfc, 0xc7, 0x25, 0x58,
-	0x47, 0x33, 0x18, 0x64, 0x8a, 0x56, 0xa6, 0xc9, 0x94, 0x14, 0xb3, 0x5d, 0x6e, 0x6c, 0x69, 0x8a,
-	0x56, 0xb7, 0x24, 0x23, 0x87, 0xb9, 0xb6, 0xe4, 0x5e, 0xe8, 0x32, 0xc9, 0x63, 0xd6, 0xae, 0xa2,
-	0x74, 0x1c, 0x0b, 0xc9, 0x4f, 0xe0, 0x03, 0x4d, 0x3d, 0xc2, 0x9e, 0x04, 0xae, 0xcb, 0x27, 0x32,
-	0xd0, 0xa9, 0x37, 0x37, 0x11, 0xde, 0x88, 0xb5, 0x9d, 0x85, 0x12, 0x0b, 0xf5, 0x29, 0x34, 0x54,
-	0x92, 0xae, 0xcb, 0x5d, 0x5b, 0x9f, 0x32, 0x09, 0x66, 0xbe, 0x6c, 0x6e, 0x61, 0xf7, 0x91, 0x85,
-	0x6e, 0xac, 0x54, 0x1d, 0xa5, 0x21, 0xf7, 0x60, 0xd7, 0x63, 0x6f, 0x72, 0xe0, 0x6d, 0x04, 0x57,
-	0x3d, 0xf6, 0x26, 0x83, 0x7b, 0x02, 0xe0, 0x33, 0xb1, 0x98, 0xd0, 0xeb, 0x98, 0xf2, 0xad, 0x35,
-	0x53, 0x46, 0x4d, 0x7f, 0xb1, 0xfc, 0x5f, 0x37, 0x07, 0x05, 0x48, 0x2f, 0xb9, 0x90, 0x5e, 0x3f,
-	0x85, 0x0d, 0x2c, 0x93, 0xbe, 0xd0, 0x0f, 0x2f, 0x6b, 0x10, 0x8a, 0x48, 0xeb, 0x17, 0xb0, 0x97,
-	0x1c, 0x78, 0x24, 0x84, 0x33, 0xf5, 0xd7, 0x3a, 0x2f, 0xe6, 0xee, 0x36, 0x6c, 0xe9, 0x0f, 0xc1,
-	0x3b, 0xd8, 0xbc, 0x00, 0x53, 0xdb, 0x9c, 0x04, 0x48, 0x15, 0xd2, 0xf1, 0xb8, 0x2d, 0x24, 0xf3,
-	0xc2, 0xd8, 0xd8, 0x54, 0x92, 0x91, 0x12, 0x90, 0x87, 0xb0, 0xa5, 0x6f, 0x3b, 0x4e, 0xaa, 0x91,
-	0x4f, 0x4a, 0xfb, 0xa1, 0x31, 0xc6, 0xfa, 0x39, 0x54, 0x86, 0xe7, 0x82, 0x47, 0xaf, 0x35, 0x2b,
-	0xb4, 0x60, 0x3b, 0x6e, 0x95, 0xb8, 0xd6, 0xc5, 0xd6, 0x0b, 0x90, 0xf5, 0x05, 0xd4, 0x32, 0xe6,
-	0x2a, 0xba, 0xa7, 0x50, 0x89, 0xbf, 0x82, 0x6e, 0x30, 0x15, 0xc5, 0x84, 0x98, 0xe4, 0x42, 0xc1,
-	0x5b, 0x2c, 0x85, 0xf5, 0xa7, 0x32, 0x98, 0xd8, 0x43, 0xd8, 0x9c, 0x1f, 0xc3, 0x2e, 0x4f, 0xea,
-	0x9f, 0xfd, 0x78, 0xd5, 0x52, 0x31, 0x7e, 0xbd, 0xde, 0x63, 0x30, 0x19, 0xdc, 0x4c, 0x99, 0x82,
-	0x25, 0x97, 0x29, 0xe2, 0x21, 0x7d, 0x98, 0x77, 0x93, 0xc4, 0xd6, 0x2a, 0x68, 0x00, 0x41, 0x1b,
-	0x61, 0x81, 0x94, 0xec, 0xc3, 0xf5, 0x68, 0xe6, 0xeb, 0x59, 0xd4, 0xa3, 0xbb, 0x1d, 0xcd, 0x7c,
-	0xcc, 0xf0, 0x4a, 0x43, 0x7b, 0xf0, 0x35, 0x34, 0x8a, 0x8e, 0x27, 0x1d, 0xa8, 0x64, 0x33, 0xd0,
-	0x75, 0xbf, 0xbb, 0x66, 0x52, 0x52, 0x43, 0x9a, 0xb5, 0xb2, 0xfe, 0x51, 0x82, 0x8a, 0x4e, 0x53,
-	0x32, 0x39, 0x13, 0xaa, 0xd5, 0x84, 0x64, 0x91, 0xb4, 0x55, 0x7b, 0x2d, 0x5a, 0x0d, 0x25, 0x63,
-	0xc7, 0xe3, 0xea, 0x8e, 0x26, 0x81, 0x17, 0xba, 0x5c, 0x7f, 0x44, 0x14, 0x46, 0xb7, 0x6d, 0x2d,
-	0x15, 0x23, 0xf0, 0x0b, 0x30, 0x27, 0x81, 0xaf, 0xdf, 0x41, 0x58, 0xdc, 0x5a, 0x71, 0x71, 0xf1,
-	0xd4, 0x56, 0x4c, 0x24, 0x31, 0x1e, 0x1f, 0x6d, 0xa9, 0x39, 0xf9, 0x0c, 0x2a, 0x41, 0xda, 0x72,
-	0x58, 0xd4, 0x95, 0xcf, 0x7f, 0xa6, 0x27, 0x69, 0x16, 0x6d, 0x9d, 0x03, 0x59, 0xf5, 0x4e, 0x2a,
-	0xb0, 0xdd, 0xa1, 0xbd, 0xa3, 0x71, 0xaf, 0x5b, 0xbf, 0xa6, 0x36, 0xf4, 0x6c, 0x30, 0xe8, 0x0f,
-	0x8e, 0xeb, 0x06, 0xa9, 0x82, 0x39, 0x3a, 0xeb, 0x74, 0x7a, 0xbd, 0x6e, 0xaf, 0x5b, 0x2f, 0x11,
-	0x80, 0xad, 0x2f, 0xfb, 0x27, 0x27, 0xbd, 0x6e, 0xbd, 0xac, 0xd6, 0x9f, 0x1f, 0xf5, 0xd5, 0x7a,
-	0x43, 0xd9, 0x9c, 0x0d, 0xbe, 0x1c, 0x0c, 0x7f, 0x35, 0xa8, 0x6f, 0x5a, 0x7f, 0x84, 0x4d, 0x3c,
-	0xa3, 0x70, 0xbe, 0x1f, 0xe4, 0x08, 0xe7, 0xd6, 0x9a, 0x0e, 0xd3, 0x5c, 0x43, 0x1e, 0xc3, 0x96,
-	0xc0, 0x92, 0xc4, 0x0d, 0xb9, 0xbf, 0xb6, 0x66, 0x34, 0x06, 0x5a, 0x7f, 0x36, 0xe0, 0x36, 0xe5,
-	0x61, 0x10, 0xc9, 0xfc, 0x5c, 0x52, 0xfe, 0xfb, 0x19, 0x17, 0x12, 0xc9, 0x03, 0xd9, 0x3b, 0x13,
-	0x99, 0x89, 0x12, 0x1c, 0xa6, 0x1e, 0xec, 0x66, 0xca, 0xa5, 0x46, 0xb8, 0x98, 0x1a, 0x97, 0x9c,
-	0xd7, 0x82, 0xdc, 0xde, 0xba, 0x0d, 0xfb, 0xc5, 0x41, 0x84, 0xee, 0xdc, 0x7a, 0x06, 0xb7, 0xbb,
-	0xdc, 0xe5, 0x92, 0x5f, 0x25, 0x42, 0xe5, 0xba, 0xd8, 0x5a, 0xb9, 0xfe, 0x8b, 0x01, 0xcd, 0x63,
-	0x7e, 0xb5, 0xd4, 0xef, 0x24, 0xc4, 0x85, 0x7a, 0xdd, 0xc8, 0x31, 0x3f, 0x21, 0x20, 0x3f, 0x0c,
-	0xe5, 0xe5, 0x61, 0xd8, 0x87, 0xeb, 0xdc, 0xbf, 0xd0, 0xca, 0x78, 0xd2, 0xb9, 0x7f, 0xa1, 0x54,
-	0x96, 0x0d, 0x1f, 0x14, 0x44, 0x15, 0xba, 0xf3, 0xa2, 0x7a, 0x1b, 0x57, 0xa8, 0xf7, 0x5f, 0x0d,
-	0xb8, 0x79, 0xcc, 0xe5, 0x68, 0x36, 0x9d, 0x72, 0xa1, 0x1f, 0xac, 0x71, 0xd2, 0x4f, 0x01, 0x52,
-	0xbe, 0x8c, 0x7d, 0x37, 0xd7, 0x7d, 0xe6, 0x68, 0x06, 0x4b, 0x1e, 0xc0, 0x16, 0x16, 0x67, 0xf1,
-	0x4b, 0x60, 0xaf, 0xa0, 0xf9, 0x68, 0x0c, 0x51, 0xef, 0x94, 0x48, 0x9f, 0x68, 0xfb, 0x33, 0xef,
-	0x9c, 0x47, 0x58, 0x9f, 0x4d, 0x5a, 0x8d, 0xa5, 0x03, 0x14, 0x5a, 0xdf, 0x96, 0x60, 0x6f, 0x39,
-	0x4e, 0x55, 0x86, 0xdf, 0xad, 0x23, 0x62, 0x4d, 0x63, 0x4f, 0x96, 0x1e, 0xf7, 0xab, 0x1e, 0xde,
-	0x85, 0x92, 0x73, 0xcf, 0xb1, 0xd2, 0xbb, 0x3c, 0xc7, 0xfe, 0xbf, 0xe4, 0xfb, 0x5b, 0x38, 0xfc,
-	0x25, 0x73, 0x9d, 0x0b, 0x26, 0xf9, 0xf2, 0xcf, 0xac, 0xf7, 0xbf, 0x4e, 0xeb, 0x10, 0x3e, 0xba,
-	0xc4, 0x7b, 0xe8, 0xce, 0x3f, 0x39, 0xcb, 0xfc, 0x05, 0x80, 0xb4, 0x58, 0x87, 0x9d, 0x98, 0xd5,
-	0xec, 0xf1, 0xaf, 0xbf, 0xea, 0xd5, 0xaf, 0x29, 0xce, 0xeb, 0x0e, 0xcf, 0x9e, 0x9f, 0xf4, 0xea,
-	0x06, 0xd9, 0x86, 0x72, 0x7f, 0x30, 0xae, 0x97, 0xc8, 0x0e, 0x5c, 0xef, 0xf6, 0x47, 0x1d, 0xda,
-	0x1b, 0xf7, 0xea, 0x65, 0xb2, 0x0b, 0x95, 0xce, 0xd1, 0xb8, 0x77, 0x3c, 0xa4, 0xfd, 0xce, 0xd1,
-	0x49, 0x7d, 0xe3, 0x93, 0xa7, 0x99, 0xdf, 0xea, 0x0b, 0xb6, 0x5d, 0x90, 0xe5, 0x35, 0x65, 0x7c,
-	0xda, 0x1f, 0xf4, 0x4f, 0xfb, 0xbf, 0x51, 0x3e, 0xd5, 0xee, 0xe8, 0x85, 0xde, 0x95, 0xda, 0xff,
-	0x2c, 0x81, 0xd9, 0x7d, 0x7e, 0xca, 0x7c, 0x36, 0xe5, 0x11, 0x79, 0x05, 0x8d, 0x22, 0x4e, 0x21,
-	0x3f, 0xca, 0xa7, 0x7f, 0x09, 0xf9, 0x1d, 0x7c, 0xfc, 0x5d, 0xa0, 0xaa, 0x1f, 0x19, 0xdc, 0x58,
-	0x19, 0x58, 0x72, 0x6f, 0xa5, 0x0b, 0x8b, 0x4f, 0xf9, 0xc1, 0x5b, 0x71, 0xea, 0x88, 0x57, 0xd0,
-	0x28, 0xe2, 0xb1, 0xe5, 0x74, 0x2e, 0x61, 0xca, 0xe5, 0x74, 0xd6, 0xd2, 0x62, 0xfb, 0x3f, 0x06,
-	0x40, 0x3a, 0x31, 0xe4, 0x05, 0xd4, 0xf2, 0x23, 0x44, 0xbe, 0x7f, 0xf9, 0x80, 0xe9, 0xe3, 0xee,
-	0xbe, 0x75, 0x0a, 0xc9, 0x1c, 0xf6, 0xd7, 0x36, 0x19, 0x69, 0xe5, 0xed, 0xdf, 0xd6, 0xeb, 0x07,
-	0x0f, 0xbf, 0x33, 0x5e, 0xe5, 0xb8, 0x0b, 0xd5, 0xdc, 0x9f, 0x09, 0xe7, 0x5b, 0xf8, 0xb7, 0xd9,
-	0x8f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x01, 0x58, 0xa0, 0xd5, 0x6f, 0x13, 0x00, 0x00,
+	// 1977 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5f, 0x73, 0x1b, 0x49,
+	0x11, 0xcf, 0x4a, 0x96, 0xed, 0x6d, 0x59, 0xb2, 0x3c, 0x76, 0x1c, 0x59, 0x0e, 0x67, 0x67, 0x39,
+	0x12, 0x93, 0xa4, 0x94, 0x8b, 0x38, 0x52, 0x81, 0x3b, 0xa0, 0x14, 0x49, 0x71, 0x29, 0x67, 0x49,
+	0xc7, 0x48, 0x86, 0xc0, 0x51, 0xb5, 0x35, 0x96, 0x26, 0xba, 0x0d, 0xfb, 0x8f, 0xdd, 0x51, 0x88,
+	0xe0, 0x85, 0xe2, 0x81, 0x0f, 0x71, 0x3c, 0x53, 0x75, 0x55, 0x7c, 0x07, 0x8a, 0xe2, 0x0b, 0x50,
+	0xc5, 0x03, 0xef, 0x7c, 0x13, 0x6a, 0x66, 0xff, 0xaf, 0x56, 0x72, 0x9c, 0xbb, 0xe4, 0x6d, 0xb6,
+	0xe7, 0x37, 0xd3, 0xbf, 0xee, 0xe9, 0xee, 0xe9, 0x91, 0x40, 0x26, 0xb6, 0x56, 0xb7, 0x1d, 0x8b,
+	0x59, 0x68, 0x8b, 0x0f, 0x5f, 0x3d, 0xac, 0x5f, 0x50, 0x46, 0x1e, 0xd6, 0x6e, 0x4e, 0x2d, 0x6b,
+	0xaa, 0xd3, 0x07, 0xc4, 0xd6, 0x1e, 0x10, 0xd3, 0xb4, 0x18, 0x61, 0x9a, 0x65, 0xba, 0x1e, 0x56,
+	0xf9, 0xab, 0x04, 0xa5, 0xa7, 0x94, 0xb8, 0xda, 0x85, 0x4e, 0x87, 0x36, 0x19, 0x53, 0x54, 0x81,
+	0xbc, 0x41, 0x5e, 0x57, 0xa5, 0x63, 0xe9, 0x44, 0xc6, 0x7c, 0x28, 0x24, 0x9a, 0x59, 0xcd, 0xf9,
+	0x12, 0xcd, 0x44, 0x08, 0xd6, 0x74, 0xcd, 0x65, 0xd5, 0xfc, 0x71, 0xfe, 0x44, 0xc6, 0x62, 0xcc,
+	0x65, 0x2e, 0xa3, 0x76, 0x75, 0x4d, 0xc0, 0xc4, 0x18, 0xfd, 0x14, 0xb6, 0x26, 0x9a, 0xcb, 0x1c,
+	0xed, 0x62, 0xc6, 0x95, 0x56, 0x0b, 0xc7, 0xd2, 0x49, 0xb9, 0x51, 0xab, 0xc7, 0x09, 0xd6, 0xdb,
+	0x31, 0x04, 0x4e, 0xe0, 0x95, 0xbf, 0x4b, 0x50, 0xfa, 0x9c, 0x38, 0xc4, 0xa0, 0x8c, 0x3a, 0x43,
+	0x9b, 0x8e, 0xb9, 0x16, 0x93, 0x18, 0xd4, 0xa7, 0x27, 0xc6, 0xe8, 0x09, 0x94, 0xed, 0x00, 0xa4,
+	0xb2, 0xb9, 0x4d, 0x05, 0xd5, 0x72, 0xe3, 0x30, 0xa9, 0x27, 0xdc, 0x68, 0x34, 0xb7, 0x29, 0x2e,
+	0xd9, 0xf1, 0x4f, 0xbe, 0xc7, 0x0b, 0xdf, 0x0d, 0xaa, 0xcb, 0xfd, 0x50, 0xcd, 0x1f, 0x4b, 0x27,
+	0xc5, 0xf4, 0x1e, 0x09, 0x57, 0xe1, 0xd2, 0x8b, 0xf8, 0xa7, 0xf2, 0x4f, 0x09, 0x4a, 0x83, 0x8b,
+	0x97, 0x74, 0xcc, 0xb4, 0x57, 0x54, 0xb0, 0x7d, 0x00, 0x6b, 0x82, 0x8f, 0x94, 0xc5, 0x27, 0x84,
+	0x0a, 0x3e, 0x02, 0xc8, 0xcd, 0x9b, 0x5a, 0x44, 0x17, 0x06, 0x48, 0x58, 0x8c, 0x51, 0x03, 0xae,
+	0x5b, 0x01, 0x54, 0x35, 0x28, 0x73, 0xb4, 0xb1, 0x2a, 0x7c, 0x90, 0x17, 0x3e, 0xd8, 0x0d, 0x27,
+	0x7b, 0x62, 0xae, 0xcf, 0x5d, 0xf2, 0x08, 0x6e, 0x90, 0xc9, 0x44, 0xe3, 0x4e, 0x24, 0x7a, 0x7c,
+	0x91, 0x5b, 0x5d, 0x13, 0x67, 0x76, 0x3d, 0x9a, 0x8e, 0x96, 0xb9, 0xca, 0xa7, 0x50, 0x69, 0xea,
+	0x53, 0xcb, 0xd1, 0xd8, 0x97, 0xc6, 0x90, 0x32, 0xa6, 0x99, 0xd3, 0x4c, 0x97, 0xef, 0x41, 0xe1,
+	0x15, 0xd1, 0x67, 0xd4, 0x0f, 0x0a, 0xef, 0x43, 0xd9, 0x85, 0x9d, 0x0e, 0x71, 0xf4, 0xf9, 0x90,
+	0x59, 0xb6, 0xad, 0x99, 0x53, 0xee, 0x03, 0xe5, 0xbf, 0x12, 0x94, 0xa2, 0x3d, 0xb9, 0x57, 0xbe,
+	0x07, 0x65, 0x12, 0x08, 0xd4, 0xd8, 0xd6, 0xa5, 0x50, 0x2a, 0x6c, 0xe8, 0x01, 0x8a, 0x60, 0xae,
+	0x47, 0xc6, 0xad, 0xe6, 0x8e, 0xf3, 0x27, 0xc5, 0xc6, 0x07, 0x49, 0x57, 0xa6, 0x39, 0xe3, 0x1d,
+	0x92, 0x92, 0xb8, 0x68, 0x00, 0xbb, 0x94, 0x93, 0x53, 0x5d, 0x9f, 0x9d, 0xea, 0xda, 0x74, 0xec,
+	0x1f, 0xf3, 0x51, 0x72, 0xbf, 0x05, 0x2b, 0xf0, 0x0e, 0x5d, 0x30, 0xec, 0x3f, 0x12, 0xc8, 0x7d,
+	0xe2, 0xb6, 0x2c, 0xf3, 0x85, 0x36, 0x45, 0x9f, 0xc2, 0xd6, 0xd4, 0x21, 0xf6, 0x97, 0xea, 0x58,
+	0x7c, 0x0b, 0x93, 0x8a, 0x8d, 0x83, 0xe4, 0xbe, 0xa7, 0x1c, 0xe1, 0x2d, 0xc0, 0xc5, 0x69, 0xf4,
+	0x81, 0x9e, 0x00, 0x58, 0x36, 0x75, 0xbc, 0xd4, 0x14, 0x4e, 0x2d, 0x36, 0x94, 0xe4, 0xda, 0x50,
+	0x55, 0x7d, 0x10, 0x22, 0x71, 0x6c, 0x55, 0xad, 0x05, 0x10, 0xcd, 0xa0, 0x1f, 0x82, 0x1c, 0xce,
+	0x55, 0x25, 0xe1, 0xb4, 0x1b, 0xa9, 0xf8, 0x0b, 0xa6, 0x71, 0x84, 0x54, 0x6c, 0x28, 0xc6, 0x48,
+	0xa2, 0xef, 0x00, 0x98, 0x33, 0x43, 0xd5, 0xc9, 0x9c, 0x3a, 0xae, 0xb0, 0xa9, 0x80, 0x65, 0x73,
+	0x66, 0x9c, 0x09, 0x01, 0x3a, 0x82, 0xa2, 0x66, 0xda, 0x33, 0xa6, 0xba, 0xda, 0x1f, 0xa8, 0x77,
+	0x36, 0x05, 0x0c, 0x42, 0x34, 0xe4, 0x12, 0x74, 0x0b, 0xb6, 0xac, 0x19, 0x8b, 0x10, 0x79, 0x81,
+	0x28, 0x7a, 0x32, 0x01, 0x11, 0x6e, 0x0c, 0xa9, 0xf0, 0xd8, 0x08, 0xc9, 0xa8, 0x61, 0xee, 0xc8,
+	0xb8, 0x14, 0x4a, 0x45, 0xba, 0x0e, 0x60, 0x3b, 0x4a, 0x79, 0x7e, 0x8e, 0x81, 0xd3, 0x6e, 0x2f,
+	0xb1, 0xb1, 0x9e, 0x28, 0x23, 0x2e, 0x2e, 0xdb, 0x89, 0xef, 0x5a, 0x0f, 0xca, 0x49, 0x04, 0xfa,
+	0x04, 0x20, 0xc4, 0xb8, 0xbe, 0x07, 0x97, 0x55, 0x14, 0x11, 0x22, 0x31, 0xb8, 0xf2, 0xd5, 0x1a,
+	0x94, 0x3b, 0xaf, 0x6d, 0xea, 0x68, 0x06, 0x35, 0x19, 0x9f, 0x46, 0xa3, 0x45, 0xca, 0x5e, 0x8c,
+	0xdc, 0x4b, 0xc5, 0x5e, 0x62, 0xd9, 0x25, 0xbc, 0xd1, 0x8f, 0x40, 0x0e, 0xf3, 0xdf, 0x77, 0xc1,
+	0xb2, 0x32, 0x23, 0x48, 0x46, 0x68, 0xbe, 0x34, 0xcc, 0x92, 0xec, 0x6a, 0x97, 0x48, 0x5b, 0x1c,
+	0xa1, 0xf9, 0x29, 0x31, 0x47, 0x23, 0xba, 0xca, 0xa8, 0x61, 0xeb, 0x84, 0x51, 0xbf, 0xea, 0x97,
+	0x84, 0x74, 0xe4, 0x0b, 0xd1, 0xc7, 0xb0, 0xef, 0x95, 0x1e, 0x57, 0x1d, 0x5b, 0xba, 0x4e, 0xc7,
+	0xcc, 0xf2, 0x4c, 0x17, 0x17, 0x81, 0x8c, 0xf7, 0xfc, 0xd9, 0x56, 0x30, 0x29, 0x1c, 0xf5, 0x11,
+	0xec, 0x71, 0x23, 0x75, 0x9d, 0xea, 0xaa, 0xa7, 0x65, 0x6c, 0xcd, 0x4c, 0x56, 0x5d, 0x17, 0xd1,
+	0x87, 0x82, 0xb9, 0x11, 0x9f, 0x6a, 0xf1, 0x19, 0x74, 0x1b, 0xb6, 0x0d, 0xf2, 0x3a, 0x01, 0xde,
+	0x10, 0xe0, 0x92, 0x41, 0x5e, 0xc7, 0x70, 0x8f, 0x00, 0x4c, 0xe2, 0x06, 0x19, 0xba, 0x79, 0x2c,
+	0x2d, 0x26, 0x45, 0x98, 0x65, 0x58, 0x36, 0x83, 0xe1, 0xb7, 0x1d, 0x1c, 0x7f, 0x96, 0x00, 0xa2,
+	0x53, 0xce, 0xac, 0xaf, 0x1f, 0xc1, 0x9a, 0xf0, 0x93, 0x77, 0xa2, 0x37, 0x57, 0x45, 0x08, 0x16,
+	0x48, 0x74, 0x13, 0x64, 0xbe, 0x32, 0xba, 0xbb, 0x64, 0x1c, 0x09, 0xf8, 0x15, 0x3e, 0xd3, 0x26,
+	0xfe, 0x29, 0xf1, 0xa1, 0xf2, 0x33, 0xd8, 0x0d, 0x19, 0x36, 0x5d, 0x57, 0x9b, 0x9a, 0x4b, 0xc9,
+	0x64, 0x17, 0xfb, 0x06, 0xac, 0x7b, 0x37, 0xc7, 0x15, 0xd6, 0x3c, 0x07, 0xd9, 0x5b, 0x73, 0x66,
+	0x89, 0xda, 0xc2, 0x34, 0x83, 0xaa, 0x2e, 0x23, 0x86, 0xed, 0x2f, 0x96, 0xb9, 0x64, 0xc8, 0x05,
+	0xe8, 0x3e, 0xac, 0x7b, 0xe1, 0xe1, 0x3b, 0x61, 0x2f, 0xe9, 0x04, 0x6f, 0x1f, 0xec, 0x63, 0x94,
+	0x9f, 0x40, 0x71, 0x70, 0xe1, 0x52, 0xe7, 0x95, 0x57, 0x46, 0xea, 0xb0, 0xe1, 0x4d, 0x04, 0x87,
+	0x93, 0xbd, 0x3a, 0x00, 0x29, 0xcf, 0xa0, 0x1c, 0x5b, 0xce, 0xd9, 0x3d, 0x86, 0xa2, 0x7f, 0x6d,
+	0xea, 0xd6, 0xd4, 0xcd, 0xae, 0xa0, 0xa1, 0x2d, 0x18, 0x8c, 0x60, 0xe8, 0x2a, 0x7f, 0xca, 0x83,
+	0x2c, 0x82, 0x4e, 0x44, 0xf3, 0x1d, 0xd8, 0xa6, 0xe1, 0x79, 0xc5, 0x6f, 0xbb, 0x72, 0x24, 0x16,
+	0xd7, 0xdd, 0x37, 0xc8, 0x64, 0x02, 0xd7, 0xa3, 0xd2, 0x42, 0xc2, 0xc3, 0x74, 0xfd, 0xac, 0xbe,
+	0x9f, 0xdc, 0x26, 0xe4, 0x56, 0xcf, 0x08, 0x00, 0x17, 0xef, 0xd9, 0x19, 0x52, 0x74, 0x00, 0x9b,
+	0xce, 0xcc, 0xf4, 0x92, 0xd7, 0x8b, 0xa2, 0x0d, 0x67, 0x66, 0x0a, 0x0b, 0xdf, 0x2a, 0xcb, 0x6b,
+	0x5f, 0xc0, 0x5e, 0x96, 0x7a, 0xd4, 0x82, 0x62, 0xdc, 0x02, 0xcf, 0xef, 0xb7, 0x96, 0xa4, 0x56,
+	0xb4, 0x10, 0xc7, 0x57, 0x29, 0xff, 0xca, 0x41, 0xd1, 0x33, 0x93, 0x11, 0x36, 0x73, 0x79, 0xa8,
+	0xb9, 0x8c, 0x38, 0x4c, 0x65, 0x5a, 0xe8, 0x7f, 0x59, 0x48, 0x46, 0x9a, 0x41, 0xf9, 0x19, 0x8d,
+	0x2d, 0xc3, 0xd6, 0xa9, 0x77, 0xeb, 0x68, 0x86, 0x77, 0x00, 0x32, 0x2e, 0x47, 0x62, 0x01, 0x7c,
+	0x06, 0xf2, 0xd8, 0x32, 0xbd, 0xc6, 0x49, 0x38, 0xb7, 0x9c, 0xed, 0x5c, 0xa1, 0xb5, 0xee, 0x57,
+	0x1e, 0x1f, 0x2f, 0xba, 0xbc, 0x68, 0x39, 0xfa, 0x04, 0x8a, 0x56, 0x14, 0x72, 0xd5, 0xb5, 0xac,
+	0x7e, 0x21, 0x16, 0x93, 0x38, 0x8e, 0x56, 0x2e, 0x00, 0x2d, 0xee, 0x8e, 0x8a, 0xb0, 0xd1, 0xc2,
+	0x9d, 0xe6, 0xa8, 0xd3, 0xae, 0x5c, 0xe3, 0x1f, 0xf8, 0xbc, 0xdf, 0xef, 0xf6, 0x4f, 0x2b, 0x12,
+	0x2a, 0x81, 0x3c, 0x3c, 0x6f, 0xb5, 0x3a, 0x9d, 0x76, 0xa7, 0x5d, 0xc9, 0x21, 0x80, 0xf5, 0xcf,
+	0xba, 0x67, 0x67, 0x9d, 0x76, 0x25, 0xcf, 0xc7, 0x4f, 0x9b, 0x5d, 0x3e, 0x5e, 0xe3, 0x6b, 0xce,
+	0xfb, 0x9f, 0xf5, 0x07, 0xbf, 0xec, 0x57, 0x0a, 0xca, 0x1f, 0xa1, 0x20, 0x74, 0x64, 0xe6, 0xf7,
+	0xbd, 0x44, 0x81, 0xba, 0xb1, 0x24, 0xc2, 0xfc, 0xda, 0xf4, 0x10, 0xd6, 0x5d, 0xe1, 0x92, 0x6a,
+	0x3e, 0xcb, 0xca, 0x98, 0xcf, 0xb0, 0x0f, 0x54, 0xfe, 0x21, 0xc1, 0x21, 0xa6, 0xb6, 0xe5, 0xb0,
+	0x64, 0x5e, 0x62, 0xfa, 0xbb, 0x19, 0x75, 0x99, 0x28, 0x1e, 0xa2, 0xdc, 0xc7, 0x98, 0xc9, 0x42,
+	0x22, 0x92, 0xa9, 0x03, 0xdb, 0x31, 0x77, 0xf1, 0x14, 0xce, 0x2e, 0xa5, 0xa9, 0xcd, 0xcb, 0x56,
+	0xe2, 0xfb, 0x92, 0xa2, 0x7a, 0x08, 0x9e, 0x46, 0x35, 0x2a, 0xad, 0x9b, 0x42, 0x70, 0xae, 0x4d,
+	0x94, 0x43, 0x38, 0xc8, 0xe6, 0x6f, 0xeb, 0x73, 0xe5, 0xf7, 0x70, 0xd8, 0xa6, 0x3a, 0x65, 0xf4,
+	0xad, 0x8c, 0x4b, 0xb0, 0xca, 0xad, 0x64, 0x95, 0x5f, 0x64, 0x95, 0xad, 0x98, 0xb3, 0xfa, 0xb7,
+	0x04, 0xd5, 0x53, 0xfa, 0x76, 0x0e, 0x3f, 0x0a, 0xcb, 0xa5, 0x98, 0xf7, 0x58, 0xf9, 0x55, 0x51,
+	0x00, 0x92, 0x29, 0x98, 0x4f, 0xa7, 0xe0, 0x01, 0x6c, 0x52, 0x73, 0xe2, 0x4d, 0xfa, 0xf5, 0x85,
+	0x9a, 0x93, 0x91, 0x96, 0x36, 0xb7, 0xb0, 0xd2, 0xdc, 0xf5, 0x94, 0xb9, 0x2a, 0xec, 0x67, 0x18,
+	0x64, 0xeb, 0xf3, 0xac, 0x00, 0x91, 0xae, 0x1e, 0x20, 0xca, 0x5f, 0x24, 0xd8, 0xf7, 0x8e, 0x59,
+	0x04, 0x31, 0xaf, 0xff, 0xef, 0xfc, 0x10, 0xc5, 0xeb, 0x9b, 0xdf, 0x49, 0xfe, 0x4b, 0x9b, 0x8f,
+	0x95, 0x7d, 0xd8, 0x5b, 0xe0, 0xc1, 0xcf, 0xd4, 0x82, 0xdd, 0x53, 0xfa, 0x1e, 0xc9, 0x29, 0x77,
+	0x60, 0x27, 0xa9, 0x90, 0x7b, 0x3b, 0x60, 0x2c, 0xc5, 0x18, 0xeb, 0xb0, 0xd9, 0x74, 0x98, 0xf6,
+	0x82, 0x8c, 0xb3, 0xbb, 0x0e, 0xde, 0xb2, 0x38, 0x5a, 0xf0, 0xab, 0xc3, 0xcc, 0xd1, 0xd0, 0x8f,
+	0x61, 0xd3, 0xa0, 0x8c, 0x4c, 0x08, 0x23, 0xd5, 0x7c, 0xe6, 0x33, 0xd0, 0xdf, 0xaf, 0xe7, 0xa3,
+	0x70, 0x88, 0x17, 0x0f, 0xdb, 0xd4, 0xec, 0x15, 0xfa, 0x96, 0xaf, 0xc3, 0x63, 0x0e, 0x36, 0x79,
+	0x0f, 0xc7, 0xfc, 0x31, 0xc8, 0x24, 0xd0, 0x26, 0x5e, 0xed, 0xc5, 0xc6, 0x7e, 0xb6, 0xbd, 0x38,
+	0x02, 0x46, 0x81, 0x10, 0x63, 0x1a, 0x05, 0xc2, 0xfb, 0xa3, 0xaf, 0x74, 0x61, 0x27, 0xa9, 0x90,
+	0x07, 0x42, 0xc2, 0x26, 0xe9, 0x4d, 0x6d, 0xfa, 0x9b, 0x04, 0xd7, 0x4f, 0x29, 0x1b, 0xce, 0xa6,
+	0x53, 0xea, 0x7a, 0x0f, 0x5f, 0x9f, 0xfe, 0x63, 0x80, 0xa8, 0x8d, 0xf2, 0x33, 0xb8, 0xba, 0xac,
+	0x5b, 0xc6, 0x31, 0x2c, 0xba, 0x07, 0xeb, 0x82, 0x6a, 0xf0, 0x8b, 0xc2, 0x6e, 0xc6, 0x9d, 0x84,
+	0x7d, 0x08, 0x7f, 0xef, 0x38, 0x9e, 0x46, 0xd5, 0x9c, 0x19, 0x17, 0xd4, 0x11, 0xd6, 0x16, 0x70,
+	0xc9, 0x97, 0xf6, 0x85, 0x50, 0xf9, 0x2a, 0x07, 0xbb, 0x69, 0x9e, 0xdc, 0xea, 0xdf, 0x2e, 0xeb,
+	0xcf, 0x3c, 0x0f, 0x3c, 0x4a, 0xfd, 0x48, 0xb0, 0xb8, 0xc3, 0x55, 0x3a, 0xb5, 0xc4, 0xb3, 0x2e,
+	0x77, 0x95, 0x67, 0xdd, 0xbb, 0xed, 0xc9, 0x7e, 0x03, 0xc7, 0xbf, 0x20, 0xba, 0x36, 0x21, 0x8c,
+	0xa6, 0x7f, 0xae, 0xf9, 0xe6, 0xc7, 0xa9, 0x1c, 0xc3, 0x07, 0x2b, 0x76, 0xb7, 0xf5, 0xf9, 0xdd,
+	0xf3, 0xd8, 0x4f, 0x89, 0xa2, 0x5b, 0xaa, 0xc0, 0x96, 0xdf, 0xec, 0xa8, 0xa3, 0x5f, 0x7d, 0xde,
+	0xa9, 0x5c, 0xe3, 0xad, 0x50, 0x7b, 0x70, 0xfe, 0xe4, 0xac, 0x53, 0x91, 0xd0, 0x06, 0xe4, 0xbb,
+	0xfd, 0x51, 0x25, 0x87, 0xb6, 0x60, 0xb3, 0xdd, 0x1d, 0xb6, 0x70, 0x67, 0xd4, 0xa9, 0xe4, 0xd1,
+	0x36, 0x14, 0x5b, 0xcd, 0x51, 0xe7, 0x74, 0x80, 0xbb, 0xad, 0xe6, 0x59, 0x65, 0xed, 0xee, 0x33,
+	0xd8, 0x8a, 0xff, 0x80, 0xe9, 0xb5, 0x50, 0xdd, 0xa7, 0x03, 0xdc, 0xab, 0x5c, 0xe3, 0xe8, 0xb3,
+	0xc1, 0xa9, 0x1a, 0x08, 0x24, 0xae, 0xa1, 0x3f, 0xc0, 0xbd, 0xe6, 0x59, 0x25, 0xc7, 0xf5, 0xff,
+	0x3c, 0x3e, 0x9b, 0xbf, 0xfb, 0x38, 0xf6, 0xfb, 0x61, 0xd0, 0xd0, 0x05, 0xfd, 0xd8, 0x35, 0x4e,
+	0xa4, 0xd7, 0xed, 0x77, 0x7b, 0xdd, 0x5f, 0x73, 0x7e, 0xfc, 0xab, 0xf9, 0xdc, 0xfb, 0xca, 0x35,
+	0xbe, 0x2e, 0x80, 0xdc, 0x7e, 0xd2, 0x23, 0x26, 0x99, 0x52, 0x07, 0xbd, 0x0c, 0x6a, 0x40, 0xea,
+	0x4d, 0xf3, 0xfd, 0xa4, 0x2b, 0x57, 0xf4, 0x57, 0xb5, 0x3b, 0x6f, 0x02, 0xe5, 0xb1, 0x4d, 0x44,
+	0x9a, 0xa7, 0x14, 0xdd, 0x5e, 0x88, 0xe8, 0x6c, 0x2d, 0x1f, 0x5e, 0x8a, 0xe3, 0x2a, 0x5e, 0xc2,
+	0x5e, 0x56, 0xd3, 0x92, 0x36, 0x67, 0x45, 0x47, 0x55, 0xbb, 0xf3, 0x26, 0x50, 0xae, 0xeb, 0x0b,
+	0xd8, 0x4e, 0xdd, 0xa3, 0xe8, 0xc3, 0x2c, 0x57, 0xa4, 0x6f, 0xd4, 0x9a, 0x72, 0x09, 0x8a, 0x6f,
+	0x8e, 0x61, 0x2b, 0x7e, 0x37, 0xa2, 0x5b, 0x0b, 0xe6, 0x2f, 0x6c, 0x7b, 0xb4, 0x0a, 0x92, 0x20,
+	0x1c, 0x56, 0xda, 0x6c, 0xc2, 0xe9, 0xca, 0x5f, 0x53, 0x2e, 0x41, 0x45, 0x84, 0xa3, 0x9d, 0x17,
+	0x09, 0x2f, 0x6c, 0x7b, 0xb4, 0x0a, 0x62, 0xeb, 0xf3, 0xc6, 0xff, 0x24, 0x80, 0xa8, 0xbe, 0xa1,
+	0xe7, 0x50, 0x4e, 0x16, 0x3c, 0xf4, 0xdd, 0xd5, 0xe5, 0xd0, 0x53, 0x73, 0xeb, 0xd2, 0x9a, 0x89,
+	0xe6, 0x70, 0xb0, 0xb4, 0x24, 0xa0, 0x7a, 0x72, 0xfd, 0x65, 0x95, 0xa9, 0x76, 0xff, 0x8d, 0xf1,
+	0xdc, 0xc6, 0x6d, 0x28, 0x25, 0x7e, 0x42, 0xbe, 0x58, 0x17, 0xff, 0xb6, 0xfc, 0xe0, 0xff, 0x03,
+	0x00, 0xd5, 0xe0, 0x1a, 0x6d, 0xa6, 0x19, 0x00, 0x00,
 }
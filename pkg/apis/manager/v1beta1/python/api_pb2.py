@@ -21,7 +21,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='api.proto',
   package='api.v1.beta1',
   syntax='proto3',
-  serialized_pb=_b('\n\tapi.proto\x12\x0c\x61pi.v1.beta1\x1a\x1cgoogle/api/annotations.proto\"E\n\rFeasibleSpace\x12\x0b\n\x03max\x18\x01 \x01(\t\x12\x0b\n\x03min\x18\x02 \x01(\t\x12\x0c\n\x04list\x18\x03 \x03(\t\x12\x0c\n\x04step\x18\x04 \x01(\t\"\x87\x01\n\rParameterSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x33\n\x0eparameter_type\x18\x02 \x01(\x0e\x32\x1b.api.v1.beta1.ParameterType\x12\x33\n\x0e\x66\x65\x61sible_space\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.FeasibleSpace\"\x88\x01\n\rObjectiveSpec\x12)\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveType\x12\x0c\n\x04goal\x18\x02 \x01(\x01\x12\x1d\n\x15objective_metric_name\x18\x03 \x01(\t\x12\x1f\n\x17\x61\x64\x64itional_metric_names\x18\x04 \x03(\t\"/\n\x10\x41lgorithmSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\x13\n\x11\x45\x61rlyStoppingSpec\"\xa1\x01\n\rAlgorithmSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12:\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\x1e.api.v1.beta1.AlgorithmSetting\x12<\n\x13\x65\x61rly_stopping_spec\x18\x03 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpec\"\xae\x01\n\tNasConfig\x12/\n\x0cgraph_config\x18\x01 \x01(\x0b\x32\x19.api.v1.beta1.GraphConfig\x12\x36\n\noperations\x18\x02 \x01(\x0b\x32\".api.v1.beta1.NasConfig.Operations\x1a\x38\n\nOperations\x12*\n\toperation\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.Operation\"L\n\x0bGraphConfig\x12\x12\n\nnum_layers\x18\x01 \x01(\x05\x12\x13\n\x0binput_sizes\x18\x02 \x03(\x05\x12\x14\n\x0coutput_sizes\x18\x03 \x03(\x05\"\xa7\x01\n\tOperation\x12\x16\n\x0eoperation_type\x18\x01 \x01(\t\x12?\n\x0fparameter_specs\x18\x02 \x01(\x0b\x32&.api.v1.beta1.Operation.ParameterSpecs\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"\x95\x03\n\x0e\x45xperimentSpec\x12\x44\n\x0fparameter_specs\x18\x01 \x01(\x0b\x32+.api.v1.beta1.ExperimentSpec.ParameterSpecs\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12.\n\talgorithm\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12\x16\n\x0etrial_template\x18\x04 \x01(\t\x12\x1e\n\x16metrics_collector_spec\x18\x05 \x01(\t\x12\x1c\n\x14parallel_trial_count\x18\x06 \x01(\x05\x12\x17\n\x0fmax_trial_count\x18\x07 \x01(\x05\x12+\n\nnas_config\x18\x08 \x01(\x0b\x32\x17.api.v1.beta1.NasConfig\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"F\n\nExperiment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12*\n\x04spec\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ExperimentSpec\"2\n\x13ParameterAssignment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"%\n\x06Metric\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"E\n\tMetricLog\x12\x12\n\ntime_stamp\x18\x01 \x01(\t\x12$\n\x06metric\x18\x02 \x01(\x0b\x32\x14.api.v1.beta1.Metric\"4\n\x0bObservation\x12%\n\x07metrics\x18\x01 \x03(\x0b\x32\x14.api.v1.beta1.Metric\">\n\x0eObservationLog\x12,\n\x0bmetric_logs\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.MetricLog\"\xa3\x02\n\tTrialSpec\x12\x17\n\x0f\x65xperiment_name\x18\x01 \x01(\t\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12K\n\x15parameter_assignments\x18\x03 \x01(\x0b\x32,.api.v1.beta1.TrialSpec.ParameterAssignments\x12\x10\n\x08run_spec\x18\x04 \x01(\t\x12\x1e\n\x16metrics_collector_spec\x18\x05 \x01(\t\x1aN\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\"\x8f\x02\n\x0bTrialStatus\x12\x12\n\nstart_time\x18\x01 \x01(\t\x12\x17\n\x0f\x63ompletion_time\x18\x02 \x01(\t\x12?\n\tcondition\x18\x03 \x01(\x0e\x32,.api.v1.beta1.TrialStatus.TrialConditionType\x12.\n\x0bobservation\x18\x04 \x01(\x0b\x32\x19.api.v1.beta1.Observation\"b\n\x12TrialConditionType\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tSUCCEEDED\x10\x02\x12\n\n\x06KILLED\x10\x03\x12\n\n\x06\x46\x41ILED\x10\x04\x12\x0b\n\x07UNKNOWN\x10\x05\"g\n\x05Trial\x12\x0c\n\x04name\x18\x01 \x01(\t\x12%\n\x04spec\x18\x02 \x01(\x0b\x32\x17.api.v1.beta1.TrialSpec\x12)\n\x06status\x18\x03 \x01(\x0b\x32\x19.api.v1.beta1.TrialStatus\"h\n\x1bReportObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x35\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"\x1b\n\x19ReportObservationLogReply\"1\n\x1b\x44\x65leteObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\"\x1b\n\x19\x44\x65leteObservationLogReply\"i\n\x18GetObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x13\n\x0bmetric_name\x18\x02 \x01(\t\x12\x12\n\nstart_time\x18\x03 \x01(\t\x12\x10\n\x08\x65nd_time\x18\x04 \x01(\t\"O\n\x16GetObservationLogReply\x12\x35\n\x0fobservation_log\x18\x01 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"\x82\x01\n\x15GetSuggestionsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x16\n\x0erequest_number\x18\x03 \x01(\x05\"\xec\x01\n\x13GetSuggestionsReply\x12U\n\x15parameter_assignments\x18\x01 \x03(\x0b\x32\x36.api.v1.beta1.GetSuggestionsReply.ParameterAssignments\x12.\n\talgorithm\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x1aN\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\"P\n ValidateAlgorithmSettingsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\" \n\x1eValidateAlgorithmSettingsReply*U\n\rParameterType\x12\x10\n\x0cUNKNOWN_TYPE\x10\x00\x12\n\n\x06\x44OUBLE\x10\x01\x12\x07\n\x03INT\x10\x02\x12\x0c\n\x08\x44ISCRETE\x10\x03\x12\x0f\n\x0b\x43\x41TEGORICAL\x10\x04*8\n\rObjectiveType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08MINIMIZE\x10\x01\x12\x0c\n\x08MAXIMIZE\x10\x02\x32\xc6\x02\n\tDBManager\x12j\n\x14ReportObservationLog\x12).api.v1.beta1.ReportObservationLogRequest\x1a\'.api.v1.beta1.ReportObservationLogReply\x12\x61\n\x11GetObservationLog\x12&.api.v1.beta1.GetObservationLogRequest\x1a$.api.v1.beta1.GetObservationLogReply\x12j\n\x14\x44\x65leteObservationLog\x12).api.v1.beta1.DeleteObservationLogRequest\x1a\'.api.v1.beta1.DeleteObservationLogReply2\xe1\x01\n\nSuggestion\x12X\n\x0eGetSuggestions\x12#.api.v1.beta1.GetSuggestionsRequest\x1a!.api.v1.beta1.GetSuggestionsReply\x12y\n\x19ValidateAlgorithmSettings\x12..api.v1.beta1.ValidateAlgorithmSettingsRequest\x1a,.api.v1.beta1.ValidateAlgorithmSettingsReply2\x0f\n\rEarlyStoppingb\x06proto3')
+  serialized_pb=_b('\n\tapi.proto\x12\x0c\x61pi.v1.beta1\x1a\x1cgoogle/api/annotations.proto\"w\n\rFeasibleSpace\x12\x0b\n\x03max\x18\x01 \x01(\t\x12\x0b\n\x03min\x18\x02 \x01(\t\x12\x0c\n\x04list\x18\x03 \x03(\t\x12\x0c\n\x04step\x18\x04 \x01(\t\x12\x30\n\x0c\x64istribution\x18\x05 \x01(\x0e\x32\x1a.api.v1.beta1.Distribution\"\x87\x01\n\rParameterSpec\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x33\n\x0eparameter_type\x18\x02 \x01(\x0e\x32\x1b.api.v1.beta1.ParameterType\x12\x33\n\x0e\x66\x65\x61sible_space\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.FeasibleSpace\"\x88\x01\n\rObjectiveSpec\x12)\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveType\x12\x0c\n\x04goal\x18\x02 \x01(\x01\x12\x1d\n\x15objective_metric_name\x18\x03 \x01(\t\x12\x1f\n\x17\x61\x64\x64itional_metric_names\x18\x04 \x03(\t\"/\n\x10\x41lgorithmSetting\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"\x13\n\x11\x45\x61rlyStoppingSpec\"\xa1\x01\n\rAlgorithmSpec\x12\x16\n\x0e\x61lgorithm_name\x18\x01 \x01(\t\x12:\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\x1e.api.v1.beta1.AlgorithmSetting\x12<\n\x13\x65\x61rly_stopping_spec\x18\x03 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpec\"\xae\x01\n\tNasConfig\x12/\n\x0cgraph_config\x18\x01 \x01(\x0b\x32\x19.api.v1.beta1.GraphConfig\x12\x36\n\noperations\x18\x02 \x01(\x0b\x32\".api.v1.beta1.NasConfig.Operations\x1a\x38\n\nOperations\x12*\n\toperation\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.Operation\"L\n\x0bGraphConfig\x12\x12\n\nnum_layers\x18\x01 \x01(\x05\x12\x13\n\x0binput_sizes\x18\x02 \x03(\x05\x12\x14\n\x0coutput_sizes\x18\x03 \x03(\x05\"\xa7\x01\n\tOperation\x12\x16\n\x0eoperation_type\x18\x01 \x01(\t\x12?\n\x0fparameter_specs\x18\x02 \x01(\x0b\x32&.api.v1.beta1.Operation.ParameterSpecs\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"\x95\x03\n\x0e\x45xperimentSpec\x12\x44\n\x0fparameter_specs\x18\x01 \x01(\x0b\x32+.api.v1.beta1.ExperimentSpec.ParameterSpecs\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12.\n\talgorithm\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x12\x16\n\x0etrial_template\x18\x04 \x01(\t\x12\x1e\n\x16metrics_collector_spec\x18\x05 \x01(\t\x12\x1c\n\x14parallel_trial_count\x18\x06 \x01(\x05\x12\x17\n\x0fmax_trial_count\x18\x07 \x01(\x05\x12+\n\nnas_config\x18\x08 \x01(\x0b\x32\x17.api.v1.beta1.NasConfig\x1a\x41\n\x0eParameterSpecs\x12/\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpec\"f\n\nExperiment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12*\n\x04spec\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ExperimentSpec\x12\x11\n\tnamespace\x18\x03 \x01(\t\x12\x0b\n\x03uid\x18\x04 \x01(\t\"2\n\x13ParameterAssignment\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"%\n\x06Metric\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"E\n\tMetricLog\x12\x12\n\ntime_stamp\x18\x01 \x01(\t\x12$\n\x06metric\x18\x02 \x01(\x0b\x32\x14.api.v1.beta1.Metric\"4\n\x0bObservation\x12%\n\x07metrics\x18\x01 \x03(\x0b\x32\x14.api.v1.beta1.Metric\">\n\x0eObservationLog\x12,\n\x0bmetric_logs\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.MetricLog\"\xa3\x02\n\tTrialSpec\x12\x17\n\x0f\x65xperiment_name\x18\x01 \x01(\t\x12.\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpec\x12K\n\x15parameter_assignments\x18\x03 \x01(\x0b\x32,.api.v1.beta1.TrialSpec.ParameterAssignments\x12\x10\n\x08run_spec\x18\x04 \x01(\t\x12\x1e\n\x16metrics_collector_spec\x18\x05 \x01(\t\x1aN\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\"\x8f\x02\n\x0bTrialStatus\x12\x12\n\nstart_time\x18\x01 \x01(\t\x12\x17\n\x0f\x63ompletion_time\x18\x02 \x01(\t\x12?\n\tcondition\x18\x03 \x01(\x0e\x32,.api.v1.beta1.TrialStatus.TrialConditionType\x12.\n\x0bobservation\x18\x04 \x01(\x0b\x32\x19.api.v1.beta1.Observation\"b\n\x12TrialConditionType\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tSUCCEEDED\x10\x02\x12\n\n\x06KILLED\x10\x03\x12\n\n\x06\x46\x41ILED\x10\x04\x12\x0b\n\x07UNKNOWN\x10\x05\"g\n\x05Trial\x12\x0c\n\x04name\x18\x01 \x01(\t\x12%\n\x04spec\x18\x02 \x01(\x0b\x32\x17.api.v1.beta1.TrialSpec\x12)\n\x06status\x18\x03 \x01(\x0b\x32\x19.api.v1.beta1.TrialStatus\"\x8e\x01\n\x1bReportObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x35\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\x12\x11\n\tnamespace\x18\x03 \x01(\t\x12\x11\n\ttrial_uid\x18\x04 \x01(\t\"\x1b\n\x19ReportObservationLogReply\"W\n\x1b\x44\x65leteObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\x12\x11\n\ttrial_uid\x18\x03 \x01(\t\"\x1b\n\x19\x44\x65leteObservationLogReply\"\x8f\x01\n\x18GetObservationLogRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x13\n\x0bmetric_name\x18\x02 \x01(\t\x12\x12\n\nstart_time\x18\x03 \x01(\t\x12\x10\n\x08\x65nd_time\x18\x04 \x01(\t\x12\x11\n\tnamespace\x18\x05 \x01(\t\x12\x11\n\ttrial_uid\x18\x06 \x01(\t\"O\n\x16GetObservationLogReply\x12\x35\n\x0fobservation_log\x18\x01 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLog\"`\n\x16ReportTrialLogsRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\x12\x11\n\ttrial_uid\x18\x03 \x01(\t\x12\x0c\n\x04logs\x18\x04 \x01(\t\"\x16\n\x14ReportTrialLogsReply\"O\n\x13GetTrialLogsRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\x12\x11\n\ttrial_uid\x18\x03 \x01(\t\"!\n\x11GetTrialLogsReply\x12\x0c\n\x04logs\x18\x01 \x01(\t\"W\n\x08\x41rtifact\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0b\n\x03uri\x18\x02 \x01(\t\x12\x30\n\x08metadata\x18\x03 \x03(\x0b\x32\x1e.api.v1.beta1.ArtifactMetadata\"/\n\x10\x41rtifactMetadata\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\"}\n\x16ReportArtifactsRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\x12\x11\n\ttrial_uid\x18\x03 \x01(\t\x12)\n\tartifacts\x18\x04 \x03(\x0b\x32\x16.api.v1.beta1.Artifact\"\x16\n\x14ReportArtifactsReply\"O\n\x13GetArtifactsRequest\x12\x12\n\ntrial_name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\x12\x11\n\ttrial_uid\x18\x03 \x01(\t\">\n\x11GetArtifactsReply\x12)\n\tartifacts\x18\x01 \x03(\x0b\x32\x16.api.v1.beta1.Artifact\"\x82\x01\n\x15GetSuggestionsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\x12#\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.Trial\x12\x16\n\x0erequest_number\x18\x03 \x01(\x05\"\xec\x01\n\x13GetSuggestionsReply\x12U\n\x15parameter_assignments\x18\x01 \x03(\x0b\x32\x36.api.v1.beta1.GetSuggestionsReply.ParameterAssignments\x12.\n\talgorithm\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpec\x1aN\n\x14ParameterAssignments\x12\x36\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignment\"P\n ValidateAlgorithmSettingsRequest\x12,\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.Experiment\" \n\x1eValidateAlgorithmSettingsReply*U\n\rParameterType\x12\x10\n\x0cUNKNOWN_TYPE\x10\x00\x12\n\n\x06\x44OUBLE\x10\x01\x12\x07\n\x03INT\x10\x02\x12\x0c\n\x08\x44ISCRETE\x10\x03\x12\x0f\n\x0b\x43\x41TEGORICAL\x10\x04*J\n\x0c\x44istribution\x12\x0b\n\x07UNIFORM\x10\x00\x12\x0f\n\x0bLOG_UNIFORM\x10\x01\x12\n\n\x06NORMAL\x10\x02\x12\x10\n\x0cQLOG_UNIFORM\x10\x03*8\n\rObjectiveType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08MINIMIZE\x10\x01\x12\x0c\n\x08MAXIMIZE\x10\x02\x32\xa8\x05\n\tDBManager\x12j\n\x14ReportObservationLog\x12).api.v1.beta1.ReportObservationLogRequest\x1a\'.api.v1.beta1.ReportObservationLogReply\x12\x61\n\x11GetObservationLog\x12&.api.v1.beta1.GetObservationLogRequest\x1a$.api.v1.beta1.GetObservationLogReply\x12j\n\x14\x44\x65leteObservationLog\x12).api.v1.beta1.DeleteObservationLogRequest\x1a\'.api.v1.beta1.DeleteObservationLogReply\x12[\n\x0fReportTrialLogs\x12$.api.v1.beta1.ReportTrialLogsRequest\x1a\".api.v1.beta1.ReportTrialLogsReply\x12R\n\x0cGetTrialLogs\x12!.api.v1.beta1.GetTrialLogsRequest\x1a\x1f.api.v1.beta1.GetTrialLogsReply\x12[\n\x0fReportArtifacts\x12$.api.v1.beta1.ReportArtifactsRequest\x1a\".api.v1.beta1.ReportArtifactsReply\x12R\n\x0cGetArtifacts\x12!.api.v1.beta1.GetArtifactsRequest\x1a\x1f.api.v1.beta1.GetArtifactsReply2\xe1\x01\n\nSuggestion\x12X\n\x0eGetSuggestions\x12#.api.v1.beta1.GetSuggestionsRequest\x1a!.api.v1.beta1.GetSuggestionsReply\x12y\n\x19ValidateAlgorithmSettings\x12..api.v1.beta1.ValidateAlgorithmSettingsRequest\x1a,.api.v1.beta1.ValidateAlgorithmSettingsReply2\x0f\n\rEarlyStoppingb\x06proto3')
   ,
   dependencies=[google_dot_api_dot_annotations__pb2.DESCRIPTOR,])
 
@@ -54,12 +54,43 @@ _PARAMETERTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=3388,
-  serialized_end=3473,
+  serialized_start=4258,
+  serialized_end=4343,
 )
 _sym_db.RegisterEnumDescriptor(_PARAMETERTYPE)
 
 ParameterType = enum_type_wrapper.EnumTypeWrapper(_PARAMETERTYPE)
+_DISTRIBUTION = _descriptor.EnumDescriptor(
+  name='Distribution',
+  full_name='api.v1.beta1.Distribution',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='UNIFORM', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='LOG_UNIFORM', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='NORMAL', index=2, number=2,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='QLOG_UNIFORM', index=3, number=3,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=4345,
+  serialized_end=4419,
+)
+_sym_db.RegisterEnumDescriptor(_DISTRIBUTION)
+
+Distribution = enum_type_wrapper.EnumTypeWrapper(_DISTRIBUTION)
 _OBJECTIVETYPE = _descriptor.EnumDescriptor(
   name='ObjectiveType',
   full_name='api.v1.beta1.ObjectiveType',
@@ -81,8 +112,8 @@ _OBJECTIVETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=3475,
-  serialized_end=3531,
+  serialized_start=4421,
+  serialized_end=4477,
 )
 _sym_db.RegisterEnumDescriptor(_OBJECTIVETYPE)
 
@@ -92,6 +123,10 @@ DOUBLE = 1
 INT = 2
 DISCRETE = 3
 CATEGORICAL = 4
+UNIFORM = 0
+LOG_UNIFORM = 1
+NORMAL = 2
+QLOG_UNIFORM = 3
 UNKNOWN = 0
 MINIMIZE = 1
 MAXIMIZE = 2
@@ -130,8 +165,8 @@ _TRIALSTATUS_TRIALCONDITIONTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=2292,
-  serialized_end=2390,
+  serialized_start=2374,
+  serialized_end=2472,
 )
 _sym_db.RegisterEnumDescriptor(_TRIALSTATUS_TRIALCONDITIONTYPE)
 
@@ -171,6 +206,13 @@ _FEASIBLESPACE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='distribution', full_name='api.v1.beta1.FeasibleSpace.distribution', index=4,
+      number=5, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -184,7 +226,7 @@ _FEASIBLESPACE = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=57,
-  serialized_end=126,
+  serialized_end=176,
 )
 
 
@@ -228,8 +270,8 @@ _PARAMETERSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=129,
-  serialized_end=264,
+  serialized_start=179,
+  serialized_end=314,
 )
 
 
@@ -280,8 +322,8 @@ _OBJECTIVESPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=267,
-  serialized_end=403,
+  serialized_start=317,
+  serialized_end=453,
 )
 
 
@@ -318,8 +360,8 @@ _ALGORITHMSETTING = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=405,
-  serialized_end=452,
+  serialized_start=455,
+  serialized_end=502,
 )
 
 
@@ -342,8 +384,8 @@ _EARLYSTOPPINGSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=454,
-  serialized_end=473,
+  serialized_start=504,
+  serialized_end=523,
 )
 
 
@@ -387,8 +429,8 @@ _ALGORITHMSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=476,
-  serialized_end=637,
+  serialized_start=526,
+  serialized_end=687,
 )
 
 
@@ -418,8 +460,8 @@ _NASCONFIG_OPERATIONS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=758,
-  serialized_end=814,
+  serialized_start=808,
+  serialized_end=864,
 )
 
 _NASCONFIG = _descriptor.Descriptor(
@@ -455,8 +497,8 @@ _NASCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=640,
-  serialized_end=814,
+  serialized_start=690,
+  serialized_end=864,
 )
 
 
@@ -500,8 +542,8 @@ _GRAPHCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=816,
-  serialized_end=892,
+  serialized_start=866,
+  serialized_end=942,
 )
 
 
@@ -531,8 +573,8 @@ _OPERATION_PARAMETERSPECS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=997,
-  serialized_end=1062,
+  serialized_start=1047,
+  serialized_end=1112,
 )
 
 _OPERATION = _descriptor.Descriptor(
@@ -568,8 +610,8 @@ _OPERATION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=895,
-  serialized_end=1062,
+  serialized_start=945,
+  serialized_end=1112,
 )
 
 
@@ -599,8 +641,8 @@ _EXPERIMENTSPEC_PARAMETERSPECS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=997,
-  serialized_end=1062,
+  serialized_start=1047,
+  serialized_end=1112,
 )
 
 _EXPERIMENTSPEC = _descriptor.Descriptor(
@@ -678,8 +720,8 @@ _EXPERIMENTSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1065,
-  serialized_end=1470,
+  serialized_start=1115,
+  serialized_end=1520,
 )
 
 
@@ -704,6 +746,20 @@ _EXPERIMENT = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.Experiment.namespace', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='uid', full_name='api.v1.beta1.Experiment.uid', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -716,8 +772,8 @@ _EXPERIMENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1472,
-  serialized_end=1542,
+  serialized_start=1522,
+  serialized_end=1624,
 )
 
 
@@ -754,8 +810,8 @@ _PARAMETERASSIGNMENT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1544,
-  serialized_end=1594,
+  serialized_start=1626,
+  serialized_end=1676,
 )
 
 
@@ -792,8 +848,8 @@ _METRIC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1596,
-  serialized_end=1633,
+  serialized_start=1678,
+  serialized_end=1715,
 )
 
 
@@ -830,8 +886,8 @@ _METRICLOG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1635,
-  serialized_end=1704,
+  serialized_start=1717,
+  serialized_end=1786,
 )
 
 
@@ -861,8 +917,8 @@ _OBSERVATION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1706,
-  serialized_end=1758,
+  serialized_start=1788,
+  serialized_end=1840,
 )
 
 
@@ -892,8 +948,8 @@ _OBSERVATIONLOG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1760,
-  serialized_end=1822,
+  serialized_start=1842,
+  serialized_end=1904,
 )
 
 
@@ -923,8 +979,8 @@ _TRIALSPEC_PARAMETERASSIGNMENTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2038,
-  serialized_end=2116,
+  serialized_start=2120,
+  serialized_end=2198,
 )
 
 _TRIALSPEC = _descriptor.Descriptor(
@@ -981,8 +1037,8 @@ _TRIALSPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1825,
-  serialized_end=2116,
+  serialized_start=1907,
+  serialized_end=2198,
 )
 
 
@@ -1034,8 +1090,8 @@ _TRIALSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2119,
-  serialized_end=2390,
+  serialized_start=2201,
+  serialized_end=2472,
 )
 
 
@@ -1079,8 +1135,8 @@ _TRIAL = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2392,
-  serialized_end=2495,
+  serialized_start=2474,
+  serialized_end=2577,
 )
 
 
@@ -1105,6 +1161,20 @@ _REPORTOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.ReportObservationLogRequest.namespace', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_uid', full_name='api.v1.beta1.ReportObservationLogRequest.trial_uid', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1117,8 +1187,8 @@ _REPORTOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2497,
-  serialized_end=2601,
+  serialized_start=2580,
+  serialized_end=2722,
 )
 
 
@@ -1141,8 +1211,8 @@ _REPORTOBSERVATIONLOGREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2603,
-  serialized_end=2630,
+  serialized_start=2724,
+  serialized_end=2751,
 )
 
 
@@ -1160,6 +1230,20 @@ _DELETEOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.DeleteObservationLogRequest.namespace', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_uid', full_name='api.v1.beta1.DeleteObservationLogRequest.trial_uid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1172,8 +1256,8 @@ _DELETEOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2632,
-  serialized_end=2681,
+  serialized_start=2753,
+  serialized_end=2840,
 )
 
 
@@ -1196,8 +1280,8 @@ _DELETEOBSERVATIONLOGREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2683,
-  serialized_end=2710,
+  serialized_start=2842,
+  serialized_end=2869,
 )
 
 
@@ -1236,6 +1320,20 @@ _GETOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.GetObservationLogRequest.namespace', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_uid', full_name='api.v1.beta1.GetObservationLogRequest.trial_uid', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -1248,8 +1346,8 @@ _GETOBSERVATIONLOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2712,
-  serialized_end=2817,
+  serialized_start=2872,
+  serialized_end=3015,
 )
 
 
@@ -1279,8 +1377,395 @@ _GETOBSERVATIONLOGREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2819,
-  serialized_end=2898,
+  serialized_start=3017,
+  serialized_end=3096,
+)
+
+
+_REPORTTRIALLOGSREQUEST = _descriptor.Descriptor(
+  name='ReportTrialLogsRequest',
+  full_name='api.v1.beta1.ReportTrialLogsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_name', full_name='api.v1.beta1.ReportTrialLogsRequest.trial_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.ReportTrialLogsRequest.namespace', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_uid', full_name='api.v1.beta1.ReportTrialLogsRequest.trial_uid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='logs', full_name='api.v1.beta1.ReportTrialLogsRequest.logs', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3098,
+  serialized_end=3194,
+)
+
+
+_REPORTTRIALLOGSREPLY = _descriptor.Descriptor(
+  name='ReportTrialLogsReply',
+  full_name='api.v1.beta1.ReportTrialLogsReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3196,
+  serialized_end=3218,
+)
+
+
+_GETTRIALLOGSREQUEST = _descriptor.Descriptor(
+  name='GetTrialLogsRequest',
+  full_name='api.v1.beta1.GetTrialLogsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_name', full_name='api.v1.beta1.GetTrialLogsRequest.trial_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.GetTrialLogsRequest.namespace', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_uid', full_name='api.v1.beta1.GetTrialLogsRequest.trial_uid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3220,
+  serialized_end=3299,
+)
+
+
+_GETTRIALLOGSREPLY = _descriptor.Descriptor(
+  name='GetTrialLogsReply',
+  full_name='api.v1.beta1.GetTrialLogsReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='logs', full_name='api.v1.beta1.GetTrialLogsReply.logs', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3301,
+  serialized_end=3334,
+)
+
+
+_ARTIFACT = _descriptor.Descriptor(
+  name='Artifact',
+  full_name='api.v1.beta1.Artifact',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='api.v1.beta1.Artifact.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='uri', full_name='api.v1.beta1.Artifact.uri', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='metadata', full_name='api.v1.beta1.Artifact.metadata', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3336,
+  serialized_end=3423,
+)
+
+
+_ARTIFACTMETADATA = _descriptor.Descriptor(
+  name='ArtifactMetadata',
+  full_name='api.v1.beta1.ArtifactMetadata',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='api.v1.beta1.ArtifactMetadata.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='api.v1.beta1.ArtifactMetadata.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3425,
+  serialized_end=3472,
+)
+
+
+_REPORTARTIFACTSREQUEST = _descriptor.Descriptor(
+  name='ReportArtifactsRequest',
+  full_name='api.v1.beta1.ReportArtifactsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_name', full_name='api.v1.beta1.ReportArtifactsRequest.trial_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.ReportArtifactsRequest.namespace', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_uid', full_name='api.v1.beta1.ReportArtifactsRequest.trial_uid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='artifacts', full_name='api.v1.beta1.ReportArtifactsRequest.artifacts', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3474,
+  serialized_end=3599,
+)
+
+
+_REPORTARTIFACTSREPLY = _descriptor.Descriptor(
+  name='ReportArtifactsReply',
+  full_name='api.v1.beta1.ReportArtifactsReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3601,
+  serialized_end=3623,
+)
+
+
+_GETARTIFACTSREQUEST = _descriptor.Descriptor(
+  name='GetArtifactsRequest',
+  full_name='api.v1.beta1.GetArtifactsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='trial_name', full_name='api.v1.beta1.GetArtifactsRequest.trial_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='namespace', full_name='api.v1.beta1.GetArtifactsRequest.namespace', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='trial_uid', full_name='api.v1.beta1.GetArtifactsRequest.trial_uid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3625,
+  serialized_end=3704,
+)
+
+
+_GETARTIFACTSREPLY = _descriptor.Descriptor(
+  name='GetArtifactsReply',
+  full_name='api.v1.beta1.GetArtifactsReply',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='artifacts', full_name='api.v1.beta1.GetArtifactsReply.artifacts', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3706,
+  serialized_end=3768,
 )
 
 
@@ -1324,8 +1809,8 @@ _GETSUGGESTIONSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2901,
-  serialized_end=3031,
+  serialized_start=3771,
+  serialized_end=3901,
 )
 
 
@@ -1355,8 +1840,8 @@ _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2038,
-  serialized_end=2116,
+  serialized_start=2120,
+  serialized_end=2198,
 )
 
 _GETSUGGESTIONSREPLY = _descriptor.Descriptor(
@@ -1392,8 +1877,8 @@ _GETSUGGESTIONSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3034,
-  serialized_end=3270,
+  serialized_start=3904,
+  serialized_end=4140,
 )
 
 
@@ -1423,8 +1908,8 @@ _VALIDATEALGORITHMSETTINGSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3272,
-  serialized_end=3352,
+  serialized_start=4142,
+  serialized_end=4222,
 )
 
 
@@ -1447,10 +1932,11 @@ _VALIDATEALGORITHMSETTINGSREPLY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3354,
-  serialized_end=3386,
+  serialized_start=4224,
+  serialized_end=4256,
 )
 
+_FEASIBLESPACE.fields_by_name['distribution'].enum_type = _DISTRIBUTION
 _PARAMETERSPEC.fields_by_name['parameter_type'].enum_type = _PARAMETERTYPE
 _PARAMETERSPEC.fields_by_name['feasible_space'].message_type = _FEASIBLESPACE
 _OBJECTIVESPEC.fields_by_name['type'].enum_type = _OBJECTIVETYPE
@@ -1484,6 +1970,9 @@ _TRIAL.fields_by_name['spec'].message_type = _TRIALSPEC
 _TRIAL.fields_by_name['status'].message_type = _TRIALSTATUS
 _REPORTOBSERVATIONLOGREQUEST.fields_by_name['observation_log'].message_type = _OBSERVATIONLOG
 _GETOBSERVATIONLOGREPLY.fields_by_name['observation_log'].message_type = _OBSERVATIONLOG
+_ARTIFACT.fields_by_name['metadata'].message_type = _ARTIFACTMETADATA
+_REPORTARTIFACTSREQUEST.fields_by_name['artifacts'].message_type = _ARTIFACT
+_GETARTIFACTSREPLY.fields_by_name['artifacts'].message_type = _ARTIFACT
 _GETSUGGESTIONSREQUEST.fields_by_name['experiment'].message_type = _EXPERIMENT
 _GETSUGGESTIONSREQUEST.fields_by_name['trials'].message_type = _TRIAL
 _GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS.fields_by_name['assignments'].message_type = _PARAMETERASSIGNMENT
@@ -1516,11 +2005,22 @@ DESCRIPTOR.message_types_by_name['DeleteObservationLogRequest'] = _DELETEOBSERVA
 DESCRIPTOR.message_types_by_name['DeleteObservationLogReply'] = _DELETEOBSERVATIONLOGREPLY
 DESCRIPTOR.message_types_by_name['GetObservationLogRequest'] = _GETOBSERVATIONLOGREQUEST
 DESCRIPTOR.message_types_by_name['GetObservationLogReply'] = _GETOBSERVATIONLOGREPLY
+DESCRIPTOR.message_types_by_name['ReportTrialLogsRequest'] = _REPORTTRIALLOGSREQUEST
+DESCRIPTOR.message_types_by_name['ReportTrialLogsReply'] = _REPORTTRIALLOGSREPLY
+DESCRIPTOR.message_types_by_name['GetTrialLogsRequest'] = _GETTRIALLOGSREQUEST
+DESCRIPTOR.message_types_by_name['GetTrialLogsReply'] = _GETTRIALLOGSREPLY
+DESCRIPTOR.message_types_by_name['Artifact'] = _ARTIFACT
+DESCRIPTOR.message_types_by_name['ArtifactMetadata'] = _ARTIFACTMETADATA
+DESCRIPTOR.message_types_by_name['ReportArtifactsRequest'] = _REPORTARTIFACTSREQUEST
+DESCRIPTOR.message_types_by_name['ReportArtifactsReply'] = _REPORTARTIFACTSREPLY
+DESCRIPTOR.message_types_by_name['GetArtifactsRequest'] = _GETARTIFACTSREQUEST
+DESCRIPTOR.message_types_by_name['GetArtifactsReply'] = _GETARTIFACTSREPLY
 DESCRIPTOR.message_types_by_name['GetSuggestionsRequest'] = _GETSUGGESTIONSREQUEST
 DESCRIPTOR.message_types_by_name['GetSuggestionsReply'] = _GETSUGGESTIONSREPLY
 DESCRIPTOR.message_types_by_name['ValidateAlgorithmSettingsRequest'] = _VALIDATEALGORITHMSETTINGSREQUEST
 DESCRIPTOR.message_types_by_name['ValidateAlgorithmSettingsReply'] = _VALIDATEALGORITHMSETTINGSREPLY
 DESCRIPTOR.enum_types_by_name['ParameterType'] = _PARAMETERTYPE
+DESCRIPTOR.enum_types_by_name['Distribution'] = _DISTRIBUTION
 DESCRIPTOR.enum_types_by_name['ObjectiveType'] = _OBJECTIVETYPE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
@@ -1731,6 +2231,76 @@ GetObservationLogReply = _reflection.GeneratedProtocolMessageType('GetObservatio
   ))
 _sym_db.RegisterMessage(GetObservationLogReply)
 
+ReportTrialLogsRequest = _reflection.GeneratedProtocolMessageType('ReportTrialLogsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _REPORTTRIALLOGSREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.ReportTrialLogsRequest)
+  ))
+_sym_db.RegisterMessage(ReportTrialLogsRequest)
+
+ReportTrialLogsReply = _reflection.GeneratedProtocolMessageType('ReportTrialLogsReply', (_message.Message,), dict(
+  DESCRIPTOR = _REPORTTRIALLOGSREPLY,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.ReportTrialLogsReply)
+  ))
+_sym_db.RegisterMessage(ReportTrialLogsReply)
+
+GetTrialLogsRequest = _reflection.GeneratedProtocolMessageType('GetTrialLogsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETTRIALLOGSREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.GetTrialLogsRequest)
+  ))
+_sym_db.RegisterMessage(GetTrialLogsRequest)
+
+GetTrialLogsReply = _reflection.GeneratedProtocolMessageType('GetTrialLogsReply', (_message.Message,), dict(
+  DESCRIPTOR = _GETTRIALLOGSREPLY,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.GetTrialLogsReply)
+  ))
+_sym_db.RegisterMessage(GetTrialLogsReply)
+
+Artifact = _reflection.GeneratedProtocolMessageType('Artifact', (_message.Message,), dict(
+  DESCRIPTOR = _ARTIFACT,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.Artifact)
+  ))
+_sym_db.RegisterMessage(Artifact)
+
+ArtifactMetadata = _reflection.GeneratedProtocolMessageType('ArtifactMetadata', (_message.Message,), dict(
+  DESCRIPTOR = _ARTIFACTMETADATA,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.ArtifactMetadata)
+  ))
+_sym_db.RegisterMessage(ArtifactMetadata)
+
+ReportArtifactsRequest = _reflection.GeneratedProtocolMessageType('ReportArtifactsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _REPORTARTIFACTSREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.ReportArtifactsRequest)
+  ))
+_sym_db.RegisterMessage(ReportArtifactsRequest)
+
+ReportArtifactsReply = _reflection.GeneratedProtocolMessageType('ReportArtifactsReply', (_message.Message,), dict(
+  DESCRIPTOR = _REPORTARTIFACTSREPLY,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.ReportArtifactsReply)
+  ))
+_sym_db.RegisterMessage(ReportArtifactsReply)
+
+GetArtifactsRequest = _reflection.GeneratedProtocolMessageType('GetArtifactsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETARTIFACTSREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.GetArtifactsRequest)
+  ))
+_sym_db.RegisterMessage(GetArtifactsRequest)
+
+GetArtifactsReply = _reflection.GeneratedProtocolMessageType('GetArtifactsReply', (_message.Message,), dict(
+  DESCRIPTOR = _GETARTIFACTSREPLY,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:api.v1.beta1.GetArtifactsReply)
+  ))
+_sym_db.RegisterMessage(GetArtifactsReply)
+
 GetSuggestionsRequest = _reflection.GeneratedProtocolMessageType('GetSuggestionsRequest', (_message.Message,), dict(
   DESCRIPTOR = _GETSUGGESTIONSREQUEST,
   __module__ = 'api_pb2'
@@ -1775,8 +2345,8 @@ _DBMANAGER = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   options=None,
-  serialized_start=3534,
-  serialized_end=3860,
+  serialized_start=4480,
+  serialized_end=5160,
   methods=[
   _descriptor.MethodDescriptor(
     name='ReportObservationLog',
@@ -1805,6 +2375,42 @@ _DBMANAGER = _descriptor.ServiceDescriptor(
     output_type=_DELETEOBSERVATIONLOGREPLY,
     options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='ReportTrialLogs',
+    full_name='api.v1.beta1.DBManager.ReportTrialLogs',
+    index=3,
+    containing_service=None,
+    input_type=_REPORTTRIALLOGSREQUEST,
+    output_type=_REPORTTRIALLOGSREPLY,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetTrialLogs',
+    full_name='api.v1.beta1.DBManager.GetTrialLogs',
+    index=4,
+    containing_service=None,
+    input_type=_GETTRIALLOGSREQUEST,
+    output_type=_GETTRIALLOGSREPLY,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ReportArtifacts',
+    full_name='api.v1.beta1.DBManager.ReportArtifacts',
+    index=5,
+    containing_service=None,
+    input_type=_REPORTARTIFACTSREQUEST,
+    output_type=_REPORTARTIFACTSREPLY,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetArtifacts',
+    full_name='api.v1.beta1.DBManager.GetArtifacts',
+    index=6,
+    containing_service=None,
+    input_type=_GETARTIFACTSREQUEST,
+    output_type=_GETARTIFACTSREPLY,
+    options=None,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_DBMANAGER)
 
@@ -1817,8 +2423,8 @@ _SUGGESTION = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=1,
   options=None,
-  serialized_start=3863,
-  serialized_end=4088,
+  serialized_start=5163,
+  serialized_end=5388,
   methods=[
   _descriptor.MethodDescriptor(
     name='GetSuggestions',
@@ -1850,8 +2456,8 @@ _EARLYSTOPPING = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=2,
   options=None,
-  serialized_start=4090,
-  serialized_end=4105,
+  serialized_start=5390,
+  serialized_end=5405,
   methods=[
 ])
 _sym_db.RegisterServiceDescriptor(_EARLYSTOPPING)
@@ -1894,6 +2500,26 @@ try:
           request_serializer=DeleteObservationLogRequest.SerializeToString,
           response_deserializer=DeleteObservationLogReply.FromString,
           )
+      self.ReportTrialLogs = channel.unary_unary(
+          '/api.v1.beta1.DBManager/ReportTrialLogs',
+          request_serializer=ReportTrialLogsRequest.SerializeToString,
+          response_deserializer=ReportTrialLogsReply.FromString,
+          )
+      self.GetTrialLogs = channel.unary_unary(
+          '/api.v1.beta1.DBManager/GetTrialLogs',
+          request_serializer=GetTrialLogsRequest.SerializeToString,
+          response_deserializer=GetTrialLogsReply.FromString,
+          )
+      self.ReportArtifacts = channel.unary_unary(
+          '/api.v1.beta1.DBManager/ReportArtifacts',
+          request_serializer=ReportArtifactsRequest.SerializeToString,
+          response_deserializer=ReportArtifactsReply.FromString,
+          )
+      self.GetArtifacts = channel.unary_unary(
+          '/api.v1.beta1.DBManager/GetArtifacts',
+          request_serializer=GetArtifactsRequest.SerializeToString,
+          response_deserializer=GetArtifactsReply.FromString,
+          )
 
 
   class DBManagerServicer(object):
@@ -1928,6 +2554,40 @@ try:
       context.set_details('Method not implemented!')
       raise NotImplementedError('Method not implemented!')
 
+    def ReportTrialLogs(self, request, context):
+      """*
+      Report the last part of the primary container logs for a failed Trial.
+      Katib keeps the logs after the Trial job is deleted.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def GetTrialLogs(self, request, context):
+      """*
+      Get the reported container logs for a Trial.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def ReportArtifacts(self, request, context):
+      """*
+      Report the checkpoints, models and other outputs written by a Trial.
+      Artifacts reported before for the Trial with the same names are replaced.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def GetArtifacts(self, request, context):
+      """*
+      Get all reported artifacts for a Trial.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
 
   def add_DBManagerServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -1946,6 +2606,26 @@ try:
             request_deserializer=DeleteObservationLogRequest.FromString,
             response_serializer=DeleteObservationLogReply.SerializeToString,
         ),
+        'ReportTrialLogs': grpc.unary_unary_rpc_method_handler(
+            servicer.ReportTrialLogs,
+            request_deserializer=ReportTrialLogsRequest.FromString,
+            response_serializer=ReportTrialLogsReply.SerializeToString,
+        ),
+        'GetTrialLogs': grpc.unary_unary_rpc_method_handler(
+            servicer.GetTrialLogs,
+            request_deserializer=GetTrialLogsRequest.FromString,
+            response_serializer=GetTrialLogsReply.SerializeToString,
+        ),
+        'ReportArtifacts': grpc.unary_unary_rpc_method_handler(
+            servicer.ReportArtifacts,
+            request_deserializer=ReportArtifactsRequest.FromString,
+            response_serializer=ReportArtifactsReply.SerializeToString,
+        ),
+        'GetArtifacts': grpc.unary_unary_rpc_method_handler(
+            servicer.GetArtifacts,
+            request_deserializer=GetArtifactsRequest.FromString,
+            response_serializer=GetArtifactsReply.SerializeToString,
+        ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
         'api.v1.beta1.DBManager', rpc_method_handlers)
@@ -2065,6 +2745,28 @@ try:
       Delete all log of Observations for a Trial.
       """
       context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def ReportTrialLogs(self, request, context):
+      """*
+      Report the last part of the primary container logs for a failed Trial.
+      Katib keeps the logs after the Trial job is deleted.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def GetTrialLogs(self, request, context):
+      """*
+      Get the reported container logs for a Trial.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def ReportArtifacts(self, request, context):
+      """*
+      Report the checkpoints, models and other outputs written by a Trial.
+      Artifacts reported before for the Trial with the same names are replaced.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def GetArtifacts(self, request, context):
+      """*
+      Get all reported artifacts for a Trial.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
 
 
   class BetaDBManagerStub(object):
@@ -2097,6 +2799,32 @@ try:
       """
       raise NotImplementedError()
     DeleteObservationLog.future = None
+    def ReportTrialLogs(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """*
+      Report the last part of the primary container logs for a failed Trial.
+      Katib keeps the logs after the Trial job is deleted.
+      """
+      raise NotImplementedError()
+    ReportTrialLogs.future = None
+    def GetTrialLogs(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """*
+      Get the reported container logs for a Trial.
+      """
+      raise NotImplementedError()
+    GetTrialLogs.future = None
+    def ReportArtifacts(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """*
+      Report the checkpoints, models and other outputs written by a Trial.
+      Artifacts reported before for the Trial with the same names are replaced.
+      """
+      raise NotImplementedError()
+    ReportArtifacts.future = None
+    def GetArtifacts(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """*
+      Get all reported artifacts for a Trial.
+      """
+      raise NotImplementedError()
+    GetArtifacts.future = None
 
 
   def beta_create_DBManager_server(servicer, pool=None, pool_size=None, default_timeout=None, maximum_timeout=None):
@@ -2107,18 +2835,30 @@ try:
     generated only to ease transition from grpcio<0.15.0 to grpcio>=0.15.0"""
     request_deserializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogRequest.FromString,
+      ('api.v1.beta1.DBManager', 'GetArtifacts'): GetArtifactsRequest.FromString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogRequest.FromString,
+      ('api.v1.beta1.DBManager', 'GetTrialLogs'): GetTrialLogsRequest.FromString,
+      ('api.v1.beta1.DBManager', 'ReportArtifacts'): ReportArtifactsRequest.FromString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogRequest.FromString,
+      ('api.v1.beta1.DBManager', 'ReportTrialLogs'): ReportTrialLogsRequest.FromString,
     }
     response_serializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogReply.SerializeToString,
+      ('api.v1.beta1.DBManager', 'GetArtifacts'): GetArtifactsReply.SerializeToString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogReply.SerializeToString,
+      ('api.v1.beta1.DBManager', 'GetTrialLogs'): GetTrialLogsReply.SerializeToString,
+      ('api.v1.beta1.DBManager', 'ReportArtifacts'): ReportArtifactsReply.SerializeToString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogReply.SerializeToString,
+      ('api.v1.beta1.DBManager', 'ReportTrialLogs'): ReportTrialLogsReply.SerializeToString,
     }
     method_implementations = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): face_utilities.unary_unary_inline(servicer.DeleteObservationLog),
+      ('api.v1.beta1.DBManager', 'GetArtifacts'): face_utilities.unary_unary_inline(servicer.GetArtifacts),
       ('api.v1.beta1.DBManager', 'GetObservationLog'): face_utilities.unary_unary_inline(servicer.GetObservationLog),
+      ('api.v1.beta1.DBManager', 'GetTrialLogs'): face_utilities.unary_unary_inline(servicer.GetTrialLogs),
+      ('api.v1.beta1.DBManager', 'ReportArtifacts'): face_utilities.unary_unary_inline(servicer.ReportArtifacts),
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): face_utilities.unary_unary_inline(servicer.ReportObservationLog),
+      ('api.v1.beta1.DBManager', 'ReportTrialLogs'): face_utilities.unary_unary_inline(servicer.ReportTrialLogs),
     }
     server_options = beta_implementations.server_options(request_deserializers=request_deserializers, response_serializers=response_serializers, thread_pool=pool, thread_pool_size=pool_size, default_timeout=default_timeout, maximum_timeout=maximum_timeout)
     return beta_implementations.server(method_implementations, options=server_options)
@@ -2132,18 +2872,30 @@ try:
     generated only to ease transition from grpcio<0.15.0 to grpcio>=0.15.0"""
     request_serializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogRequest.SerializeToString,
+      ('api.v1.beta1.DBManager', 'GetArtifacts'): GetArtifactsRequest.SerializeToString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogRequest.SerializeToString,
+      ('api.v1.beta1.DBManager', 'GetTrialLogs'): GetTrialLogsRequest.SerializeToString,
+      ('api.v1.beta1.DBManager', 'ReportArtifacts'): ReportArtifactsRequest.SerializeToString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogRequest.SerializeToString,
+      ('api.v1.beta1.DBManager', 'ReportTrialLogs'): ReportTrialLogsRequest.SerializeToString,
     }
     response_deserializers = {
       ('api.v1.beta1.DBManager', 'DeleteObservationLog'): DeleteObservationLogReply.FromString,
+      ('api.v1.beta1.DBManager', 'GetArtifacts'): GetArtifactsReply.FromString,
       ('api.v1.beta1.DBManager', 'GetObservationLog'): GetObservationLogReply.FromString,
+      ('api.v1.beta1.DBManager', 'GetTrialLogs'): GetTrialLogsReply.FromString,
+      ('api.v1.beta1.DBManager', 'ReportArtifacts'): ReportArtifactsReply.FromString,
       ('api.v1.beta1.DBManager', 'ReportObservationLog'): ReportObservationLogReply.FromString,
+      ('api.v1.beta1.DBManager', 'ReportTrialLogs'): ReportTrialLogsReply.FromString,
     }
     cardinalities = {
       'DeleteObservationLog': cardinality.Cardinality.UNARY_UNARY,
+      'GetArtifacts': cardinality.Cardinality.UNARY_UNARY,
       'GetObservationLog': cardinality.Cardinality.UNARY_UNARY,
+      'GetTrialLogs': cardinality.Cardinality.UNARY_UNARY,
+      'ReportArtifacts': cardinality.Cardinality.UNARY_UNARY,
       'ReportObservationLog': cardinality.Cardinality.UNARY_UNARY,
+      'ReportTrialLogs': cardinality.Cardinality.UNARY_UNARY,
     }
     stub_options = beta_implementations.stub_options(host=host, metadata_transformer=metadata_transformer, request_serializers=request_serializers, response_deserializers=response_deserializers, thread_pool=pool, thread_pool_size=pool_size)
     return beta_implementations.dynamic_stub(channel, 'api.v1.beta1.DBManager', cardinalities, options=stub_options)
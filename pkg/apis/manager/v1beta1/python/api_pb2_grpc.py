@@ -30,6 +30,26 @@ class DBManagerStub(object):
         request_serializer=api__pb2.DeleteObservationLogRequest.SerializeToString,
         response_deserializer=api__pb2.DeleteObservationLogReply.FromString,
         )
+    self.ReportTrialLogs = channel.unary_unary(
+        '/api.v1.beta1.DBManager/ReportTrialLogs',
+        request_serializer=api__pb2.ReportTrialLogsRequest.SerializeToString,
+        response_deserializer=api__pb2.ReportTrialLogsReply.FromString,
+        )
+    self.GetTrialLogs = channel.unary_unary(
+        '/api.v1.beta1.DBManager/GetTrialLogs',
+        request_serializer=api__pb2.GetTrialLogsRequest.SerializeToString,
+        response_deserializer=api__pb2.GetTrialLogsReply.FromString,
+        )
+    self.ReportArtifacts = channel.unary_unary(
+        '/api.v1.beta1.DBManager/ReportArtifacts',
+        request_serializer=api__pb2.ReportArtifactsRequest.SerializeToString,
+        response_deserializer=api__pb2.ReportArtifactsReply.FromString,
+        )
+    self.GetArtifacts = channel.unary_unary(
+        '/api.v1.beta1.DBManager/GetArtifacts',
+        request_serializer=api__pb2.GetArtifactsRequest.SerializeToString,
+        response_deserializer=api__pb2.GetArtifactsReply.FromString,
+        )
 
 
 class DBManagerServicer(object):
@@ -64,6 +84,40 @@ class DBManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def ReportTrialLogs(self, request, context):
+    """*
+    Report the last part of the primary container logs for a failed Trial.
+    Katib keeps the logs after the Trial job is deleted.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetTrialLogs(self, request, context):
+    """*
+    Get the reported container logs for a Trial.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ReportArtifacts(self, request, context):
+    """*
+    Report the checkpoints, models and other outputs written by a Trial.
+    Artifacts reported before for the Trial with the same names are replaced.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetArtifacts(self, request, context):
+    """*
+    Get all reported artifacts for a Trial.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_DBManagerServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -82,6 +136,26 @@ def add_DBManagerServicer_to_server(servicer, server):
           request_deserializer=api__pb2.DeleteObservationLogRequest.FromString,
           response_serializer=api__pb2.DeleteObservationLogReply.SerializeToString,
       ),
+      'ReportTrialLogs': grpc.unary_unary_rpc_method_handler(
+          servicer.ReportTrialLogs,
+          request_deserializer=api__pb2.ReportTrialLogsRequest.FromString,
+          response_serializer=api__pb2.ReportTrialLogsReply.SerializeToString,
+      ),
+      'GetTrialLogs': grpc.unary_unary_rpc_method_handler(
+          servicer.GetTrialLogs,
+          request_deserializer=api__pb2.GetTrialLogsRequest.FromString,
+          response_serializer=api__pb2.GetTrialLogsReply.SerializeToString,
+      ),
+      'ReportArtifacts': grpc.unary_unary_rpc_method_handler(
+          servicer.ReportArtifacts,
+          request_deserializer=api__pb2.ReportArtifactsRequest.FromString,
+          response_serializer=api__pb2.ReportArtifactsReply.SerializeToString,
+      ),
+      'GetArtifacts': grpc.unary_unary_rpc_method_handler(
+          servicer.GetArtifacts,
+          request_deserializer=api__pb2.GetArtifactsRequest.FromString,
+          response_serializer=api__pb2.GetArtifactsReply.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'api.v1.beta1.DBManager', rpc_method_handlers)
@@ -83,6 +83,44 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			Dependencies: []string{
 				"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSetting", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec"},
 		},
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Artifact": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "Artifact is a checkpoint, model or other output written by a Trial.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Type:   []string{"string"},
+								Format: "",
+							},
+						},
+						"uri": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Location of the artifact, e.g. s3://bucket/model or /mnt/models/trial-1.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"metadata": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Key-value pairs describing the artifact, e.g. framework or format.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+					},
+					Required: []string{"name", "uri"},
+				},
+			},
+			Dependencies: []string{},
+		},
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.CollectorSpec": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -228,6 +266,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format: "",
 							},
 						},
+						"value": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Value computed from the observation log by avg, lastNAvg, median or percentile strategy.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 				},
 			},
@@ -249,6 +294,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format: "",
 							},
 						},
+						"lastN": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Number of the last metric values for lastNAvg strategy. Defaults to 10.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"percentile": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Percentile from 0 to 100 for percentile strategy.",
+								Type:        []string{"number"},
+								Format:      "double",
+							},
+						},
 					},
 				},
 			},
@@ -642,11 +701,36 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"notifications": {
+							SchemaProps: spec.SchemaProps{
+								Description: "List of webhooks which are notified about the experiment events.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NotificationSpec"),
+										},
+									},
+								},
+							},
+						},
+						"promoteBestTrial": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Describes the job which is created from the best trial when the experiment is succeeded.",
+								Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.PromotionSpec"),
+							},
+						},
+						"repetitions": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Describes how many times each suggested parameter assignment is run and how observations of the repeated trials are aggregated.",
+								Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.RepetitionSpec"),
+							},
+						},
 					},
 				},
 			},
 			Dependencies: []string{
-				"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate"},
+				"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NotificationSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.PromotionSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.RepetitionSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate"},
 		},
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentStatus": {
 			Schema: spec.Schema{
@@ -801,11 +885,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "int32",
 							},
 						},
+						"promotedJob": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Reference to the job which is created from the best trial.",
+								Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+							},
+						},
 					},
 				},
 			},
 			Dependencies: []string{
-				"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+				"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 		},
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace": {
 			Schema: spec.Schema{
@@ -842,6 +932,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format: "",
 							},
 						},
+						"distribution": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Distribution is the prior distribution of the double or int parameter. Step quantizes values of uniform and normal distributions, logUniform doesn't support Step and qLogUniform requires it. Default is uniform.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 				},
 			},
@@ -917,6 +1014,59 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			Dependencies: []string{
 				"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.GraphConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Operation"},
 		},
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NotificationSpec": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "NotificationSpec describes the webhook which is called on the experiment events.",
+					Properties: map[string]spec.Schema{
+						"url": {
+							SchemaProps: spec.SchemaProps{
+								Description: "URL of the HTTP endpoint. The payload is sent with the POST request.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"events": {
+							SchemaProps: spec.SchemaProps{
+								Description: "List of events which trigger the notification. Defaults to Succeeded and Failed.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"payloadTemplate": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Go template of the JSON payload. The template is executed with .Event, .Experiment and .BestTrial fields. Default payload contains the experiment, the event, the condition and the best trial.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"authSecretRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Secret key in the experiment namespace with the value of the Authorization header.",
+								Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							},
+						},
+						"maxRetries": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Max number of retries with exponential backoff if the request fails. Defaults to 3",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+					},
+					Required: []string{"url"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/api/core/v1.SecretKeySelector"},
+		},
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Operation": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -977,12 +1127,39 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation"),
 							},
 						},
+						"repetitionTrialNames": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Names of the repeated trials with the same parameter assignments. Observation is aggregated from these trials if repetitions are set.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"artifacts": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Checkpoints, models and other outputs reported by the best trial.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Artifact"),
+										},
+									},
+								},
+							},
+						},
 					},
 					Required: []string{"bestTrialName", "parameterAssignments"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"},
+				"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Artifact", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"},
 		},
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec": {
 			Schema: spec.Schema{
@@ -1011,6 +1188,75 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			Dependencies: []string{
 				"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace"},
 		},
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.PromotionSpec": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "PromotionSpec describes the standalone job which is rendered from the trial template with the parameter assignments of the best trial. The job isn't owned by the experiment, so it isn't deleted with the experiment.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name of the job. Defaults to <experiment name>-best.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"parameterOverrides": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Parameter assignments which override the assignments of the best trial.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"),
+										},
+									},
+								},
+							},
+						},
+						"runSpecPatch": {
+							SchemaProps: spec.SchemaProps{
+								Description: "JSON merge patch which is applied to the rendered job, e.g. to train for more epochs. Patch can't change apiVersion, kind and metadata of the job.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"},
+		},
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.RepetitionSpec": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "RepetitionSpec describes the repeated trials for noisy objectives. Each suggested parameter assignment is run Count times with different seeds, trial counts of the experiment include the repeated trials.",
+					Properties: map[string]spec.Schema{
+						"count": {
+							SchemaProps: spec.SchemaProps{
+								Description: "How many trials are run for each parameter assignment. Defaults to 1.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"seedParameterName": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name of the trial parameter with the seed of the repeated trial, e.g. ${trialParameters.seed}. The seed is the index of the repetition, starting from 0. Defaults to seed.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"aggregation": {
+							SchemaProps: spec.SchemaProps{
+								Description: "How observations of the repeated trials are aggregated before they are reported to the suggestion service and compared to find the best trial. Defaults to mean. With std the mean is compared and the standard deviation is reported alongside as the <metric name>-std metric.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{},
+		},
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -1379,6 +1625,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								},
 							},
 						},
+						"endpoint": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Endpoint of the shared algorithm service which serves this Suggestion. It is empty if the Suggestion has own Deployment and Service.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 				},
 			},
@@ -1676,11 +1929,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation"),
 							},
 						},
+						"artifacts": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Checkpoints, models and other outputs reported by the Trial.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Artifact"),
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 			Dependencies: []string{
-				"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+				"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Artifact", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 		},
 	}
 }
@@ -0,0 +1,190 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cli implements the kubectl katib plugin which manages Katib Experiments and Trials.
+// Kubernetes objects are accessed with katibclient, observation logs are read from Katib DB Manager.
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
+)
+
+const (
+	// DefaultWatchInterval is the interval to poll objects and observation logs with --watch and --follow.
+	DefaultWatchInterval = 2 * time.Second
+
+	defaultNamespace = "default"
+
+	outputYAML = "yaml"
+	outputJSON = "json"
+	outputWide = "wide"
+)
+
+// Options are shared by all commands.
+type Options struct {
+	// Namespace of the objects, the kubeconfig context namespace is used if empty.
+	Namespace string
+	// DBManagerAddress is the address of Katib DB Manager to read observation logs.
+	DBManagerAddress string
+	// WatchInterval is the interval to poll objects and observation logs.
+	WatchInterval time.Duration
+	// Stop stops watching and following, commands watch until interrupted if it is nil.
+	Stop <-chan struct{}
+	Out  io.Writer
+
+	// NewKatibClient and NewDBManagerClient create clients, they are replaced in tests.
+	NewKatibClient     func() (katibclient.Client, error)
+	NewDBManagerClient func(address string) (api_pb.DBManagerClient, error)
+
+	katibClient katibclient.Client
+}
+
+// NewOptions returns the options with default values.
+func NewOptions() *Options {
+	return &Options{
+		DBManagerAddress: common.GetDBManagerAddr(),
+		WatchInterval:    DefaultWatchInterval,
+		Out:              os.Stdout,
+		NewKatibClient: func() (katibclient.Client, error) {
+			return katibclient.NewClient(client.Options{})
+		},
+		NewDBManagerClient: newDBManagerClient,
+	}
+}
+
+// NewCommand returns the root command of the kubectl katib plugin.
+func NewCommand(o *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "kubectl-katib",
+		Short:        "Manage Katib Experiments and Trials",
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().StringVarP(&o.Namespace, "namespace", "n", o.Namespace, "Namespace of the Experiments and Trials")
+	cmd.PersistentFlags().StringVar(&o.DBManagerAddress, "db-manager-address", o.DBManagerAddress, "Address of Katib DB Manager to read observation logs")
+	// --kubeconfig flag is registered by controller-runtime
+	cmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+
+	cmd.AddCommand(
+		newCreateCommand(o),
+		newGetCommand(o),
+		newOptimalCommand(o),
+		newLogsCommand(o),
+		newExtendCommand(o),
+		newDeleteCommand(o),
+	)
+	return cmd
+}
+
+// client returns the Katib client, it is created on the first call.
+func (o *Options) client() (katibclient.Client, error) {
+	if o.katibClient == nil {
+		c, err := o.NewKatibClient()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create Katib client: %v", err)
+		}
+		o.katibClient = c
+	}
+	return o.katibClient, nil
+}
+
+// namespace returns the namespace from the flag or from the kubeconfig context.
+func (o *Options) namespace() string {
+	if o.Namespace != "" {
+		return o.Namespace
+	}
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if f := flag.Lookup("kubeconfig"); f != nil && f.Value.String() != "" {
+		rules.ExplicitPath = f.Value.String()
+	}
+	namespace, _, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{}).Namespace()
+	if err != nil || namespace == "" {
+		namespace = defaultNamespace
+	}
+	o.Namespace = namespace
+	return namespace
+}
+
+// newDBManagerClient connects to Katib DB Manager with TLS from the environment variables.
+func newDBManagerClient(address string) (api_pb.DBManagerClient, error) {
+	tlsOpt, err := grpctls.ConfigFromEnv().DialOption()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(address, tlsOpt)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to connect to Katib DB Manager %v: %v", address, err)
+	}
+	return api_pb.NewDBManagerClient(conn), nil
+}
+
+// printObject prints the object in yaml or json format.
+func printObject(w io.Writer, obj interface{}, output string) error {
+	var out []byte
+	var err error
+	switch output {
+	case outputJSON:
+		out, err = json.MarshalIndent(obj, "", "  ")
+		out = append(out, '\n')
+	case outputYAML:
+		out, err = yaml.Marshal(obj)
+	default:
+		return fmt.Errorf("Unknown output format: %v", output)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// formatAge returns the age of the object in the short form like kubectl.
+func formatAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
+
+// sleep waits for the watch interval, it returns false if watching is stopped.
+func (o *Options) sleep() bool {
+	select {
+	case <-o.Stop:
+		return false
+	case <-time.After(o.WatchInterval):
+		return true
+	}
+}
@@ -0,0 +1,315 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
+	katibclientmock "github.com/kubeflow/katib/pkg/mock/v1beta1/util/katibclient"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
+)
+
+const experimentsYAML = `apiVersion: kubeflow.org/v1beta1
+kind: Experiment
+metadata:
+  name: random-example
+spec:
+  maxTrialCount: 3
+---
+apiVersion: kubeflow.org/v1beta1
+kind: Experiment
+metadata:
+  name: grid-example
+  namespace: kubeflow
+`
+
+func newTestOptions(c katibclient.Client, dbManager api_pb.DBManagerClient) (*Options, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+	return &Options{
+		Namespace:     "default",
+		WatchInterval: 10 * time.Millisecond,
+		Out:           out,
+		NewKatibClient: func() (katibclient.Client, error) {
+			return c, nil
+		},
+		NewDBManagerClient: func(address string) (api_pb.DBManagerClient, error) {
+			return dbManager, nil
+		},
+	}, out
+}
+
+func newExperiment(name string, maxTrialCount, trials int32) *experimentsv1beta1.Experiment {
+	parallelTrialCount := int32(2)
+	return &experimentsv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: "1"},
+		Spec: experimentsv1beta1.ExperimentSpec{
+			Objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMinimize,
+				ObjectiveMetricName: "loss",
+				MetricStrategies:    []commonv1beta1.MetricStrategy{{Name: "loss", Value: commonv1beta1.ExtractByMin}},
+			},
+			MaxTrialCount:      &maxTrialCount,
+			ParallelTrialCount: &parallelTrialCount,
+		},
+		Status: experimentsv1beta1.ExperimentStatus{
+			Trials:          trials,
+			TrialsSucceeded: trials,
+			CurrentOptimalTrial: experimentsv1beta1.OptimalTrial{
+				BestTrialName:        name + "-best",
+				ParameterAssignments: []commonv1beta1.ParameterAssignment{{Name: "lr", Value: "0.01"}},
+				Observation: commonv1beta1.Observation{
+					Metrics: []commonv1beta1.Metric{{Name: "loss", Min: "0.1", Max: "0.5", Latest: "0.2"}},
+				},
+			},
+		},
+	}
+}
+
+func TestCreateExperiments(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	c := katibclientmock.NewMockClient(mockCtrl)
+	namespaces := []string{}
+	c.EXPECT().CreateRuntimeObject(gomock.Any()).DoAndReturn(func(obj runtime.Object) error {
+		namespaces = append(namespaces, obj.(*experimentsv1beta1.Experiment).Namespace)
+		return nil
+	}).Times(2)
+
+	o, out := newTestOptions(c, nil)
+	if err := o.createExperiments(strings.NewReader(experimentsYAML)); err != nil {
+		t.Fatalf("createExperiments failed: %v", err)
+	}
+	if namespaces[0] != "default" || namespaces[1] != "kubeflow" {
+		t.Errorf("Unexpected namespaces %v", namespaces)
+	}
+	expected := "experiment.kubeflow.org/random-example created\nexperiment.kubeflow.org/grid-example created\n"
+	if out.String() != expected {
+		t.Errorf("Expected output\n%v\ngot\n%v", expected, out.String())
+	}
+
+	err := o.createExperiments(strings.NewReader("apiVersion: kubeflow.org/v1alpha3\nkind: Experiment\n"))
+	if err == nil || !strings.Contains(err.Error(), "Expected kubeflow.org/v1beta1 Experiment") {
+		t.Errorf("Expected error for v1alpha3 Experiment, got %v", err)
+	}
+}
+
+func TestExtendExperiment(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	c := katibclientmock.NewMockClient(mockCtrl)
+	running := newExperiment("running", 3, 3)
+	running.MarkExperimentStatusRunning("", "")
+	restartable := newExperiment("restartable", 3, 3)
+	restartable.Spec.ResumePolicy = experimentsv1beta1.LongRunning
+	restartable.MarkExperimentStatusSucceeded(util.ExperimentMaxTrialsReachedReason, "")
+	succeeded := newExperiment("succeeded", 3, 3)
+	succeeded.Spec.ResumePolicy = experimentsv1beta1.NeverResume
+	succeeded.MarkExperimentStatusSucceeded(util.ExperimentMaxTrialsReachedReason, "")
+	c.EXPECT().GetExperiment("running", "default").Return(running, nil)
+	c.EXPECT().GetExperiment("restartable", "default").Return(restartable, nil)
+	c.EXPECT().GetExperiment("succeeded", "default").Return(succeeded, nil)
+	c.EXPECT().UpdateRuntimeObject(gomock.Any()).Return(nil).Times(2)
+
+	o, out := newTestOptions(c, nil)
+	if err := o.extendExperiment("running", 0, 0); err != nil {
+		t.Fatalf("extendExperiment failed: %v", err)
+	}
+	if *running.Spec.MaxTrialCount != 5 {
+		t.Errorf("Expected maxTrialCount to be increased by parallelTrialCount to 5, got %v", *running.Spec.MaxTrialCount)
+	}
+	if err := o.extendExperiment("restartable", 10, 0); err != nil {
+		t.Fatalf("extendExperiment failed: %v", err)
+	}
+	expected := "experiment.kubeflow.org/running extended, maxTrialCount: 3 -> 5\n" +
+		"experiment.kubeflow.org/restartable restarted, maxTrialCount: 3 -> 10\n"
+	if out.String() != expected {
+		t.Errorf("Expected output\n%v\ngot\n%v", expected, out.String())
+	}
+
+	if err := o.extendExperiment("succeeded", 0, 0); err == nil {
+		t.Errorf("Expected error for not restartable Experiment")
+	}
+}
+
+func TestGetExperiments(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	c := katibclientmock.NewMockClient(mockCtrl)
+	first := newExperiment("first", 3, 1)
+	first.MarkExperimentStatusRunning("", "")
+	second := newExperiment("second", 3, 3)
+	second.MarkExperimentStatusSucceeded("", "")
+	updated := first.DeepCopy()
+	updated.ResourceVersion = "2"
+	updated.Status.Trials = 2
+
+	stop := make(chan struct{})
+	calls := 0
+	c.EXPECT().GetExperimentList("default").DoAndReturn(func(namespace ...string) (*experimentsv1beta1.ExperimentList, error) {
+		calls++
+		switch calls {
+		case 1:
+			return &experimentsv1beta1.ExperimentList{Items: []experimentsv1beta1.Experiment{*first, *second}}, nil
+		case 3:
+			close(stop)
+		}
+		return &experimentsv1beta1.ExperimentList{Items: []experimentsv1beta1.Experiment{*updated}}, nil
+	}).Times(3)
+
+	o, out := newTestOptions(c, nil)
+	o.Stop = stop
+	cmd := NewCommand(o)
+	cmd.SetArgs([]string{"get", "experiments", "--watch"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("get experiments failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	expected := [][]string{
+		{"NAME", "STATUS", "TRIALS", "RUNNING", "SUCCEEDED", "FAILED", "OPTIMAL-TRIAL", "OBJECTIVE", "AGE"},
+		{"first", "Running", "1", "0", "1", "0", "first-best", "0.1"},
+		{"second", "Succeeded", "3", "0", "3", "0", "second-best", "0.1"},
+		{"first", "Running", "2", "0", "1", "0", "first-best", "0.1"},
+		{"second", "Deleted", "3", "0", "3", "0", "second-best", "0.1"},
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %v lines, got\n%v", len(expected), out.String())
+	}
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < len(expected[i]) || strings.Join(fields[:len(expected[i])], " ") != strings.Join(expected[i], " ") {
+			t.Errorf("Expected line %v, got %v", expected[i], line)
+		}
+	}
+}
+
+func TestGetTrials(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	c := katibclientmock.NewMockClient(mockCtrl)
+	experiment := newExperiment("random-example", 3, 1)
+	trial := trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{Name: "random-example-abc", Namespace: "default"},
+		Spec: trialsv1beta1.TrialSpec{
+			Objective:            experiment.Spec.Objective,
+			ParameterAssignments: []commonv1beta1.ParameterAssignment{{Name: "lr", Value: "0.01"}, {Name: "momentum", Value: "0.9"}},
+		},
+		Status: trialsv1beta1.TrialStatus{
+			Observation: &experiment.Status.CurrentOptimalTrial.Observation,
+		},
+	}
+	trial.MarkTrialStatusSucceeded(v1.ConditionTrue, "", "")
+	c.EXPECT().GetTrialList("random-example", "default").Return(&trialsv1beta1.TrialList{Items: []trialsv1beta1.Trial{trial}}, nil)
+
+	o, out := newTestOptions(c, nil)
+	cmd := NewCommand(o)
+	cmd.SetArgs([]string{"get", "trials", "-e", "random-example", "-o", "wide"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("get trials failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected header and 1 Trial, got\n%v", out.String())
+	}
+	fields := strings.Fields(lines[1])
+	if fields[0] != "random-example-abc" || fields[1] != "Succeeded" || fields[2] != "0.1" || fields[3] != "lr=0.01,momentum=0.9" ||
+		fields[len(fields)-1] != "loss=0.2" {
+		t.Errorf("Unexpected Trial row %v", lines[1])
+	}
+}
+
+func TestOptimalTrial(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	c := katibclientmock.NewMockClient(mockCtrl)
+	c.EXPECT().GetExperiment("random-example", "default").Return(newExperiment("random-example", 3, 3), nil)
+
+	o, out := newTestOptions(c, nil)
+	cmd := NewCommand(o)
+	cmd.SetArgs([]string{"optimal", "random-example"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("optimal failed: %v", err)
+	}
+	for _, expected := range []string{"Trial:      random-example-best", "Objective:  loss = 0.1", "  lr", "0.01"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected %q in output\n%v", expected, out.String())
+		}
+	}
+}
+
+type fakeDBManager struct {
+	api_pb.DBManagerClient
+	requests []*api_pb.GetObservationLogRequest
+	replies  []*api_pb.ObservationLog
+}
+
+func (f *fakeDBManager) GetObservationLog(ctx context.Context, in *api_pb.GetObservationLogRequest, opts ...grpc.CallOption) (*api_pb.GetObservationLogReply, error) {
+	reply := f.replies[len(f.requests)]
+	f.requests = append(f.requests, in)
+	return &api_pb.GetObservationLogReply{ObservationLog: reply}, nil
+}
+
+func newMetricLog(timestamp, name, value string) *api_pb.MetricLog {
+	return &api_pb.MetricLog{TimeStamp: timestamp, Metric: &api_pb.Metric{Name: name, Value: value}}
+}
+
+func TestFollowObservationLogs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	c := katibclientmock.NewMockClient(mockCtrl)
+	trial := &trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Name: "random-example-abc", Namespace: "default", UID: "trial-uid"}}
+	trial.MarkTrialStatusRunning("", "")
+	completed := trial.DeepCopy()
+	completed.MarkTrialStatusSucceeded(v1.ConditionTrue, "", "")
+	gomock.InOrder(
+		c.EXPECT().GetTrial("random-example-abc", "default").Return(trial, nil),
+		c.EXPECT().GetTrial("random-example-abc", "default").Return(completed, nil),
+	)
+	dbManager := &fakeDBManager{
+		replies: []*api_pb.ObservationLog{
+			{MetricLogs: []*api_pb.MetricLog{
+				newMetricLog("2020-01-01T00:00:00Z", "loss", "0.5"),
+				newMetricLog("2020-01-01T00:00:00Z", "accuracy", "0.7"),
+			}},
+			{MetricLogs: []*api_pb.MetricLog{
+				newMetricLog("2020-01-01T00:00:00Z", "loss", "0.5"),
+				newMetricLog("2020-01-01T00:00:00Z", "accuracy", "0.7"),
+				newMetricLog("2020-01-01T00:01:00Z", "loss", "0.25"),
+			}},
+		},
+	}
+
+	o, out := newTestOptions(c, dbManager)
+	if err := o.printObservationLogs("random-example-abc", "", true); err != nil {
+		t.Fatalf("printObservationLogs failed: %v", err)
+	}
+	if len(dbManager.requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %v", len(dbManager.requests))
+	}
+	if request := dbManager.requests[1]; request.StartTime != "2020-01-01T00:00:00Z" || request.TrialUid != "trial-uid" {
+		t.Errorf("Unexpected request %+v", request)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 || strings.Join(strings.Fields(lines[3]), " ") != "2020-01-01T00:01:00Z loss 0.25" {
+		t.Errorf("Expected header and 3 metric logs, got\n%v", out.String())
+	}
+}
@@ -0,0 +1,250 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/util/retry"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
+)
+
+const (
+	kindExperiment = "Experiment"
+	bufferSize     = 1024
+)
+
+func newCreateCommand(o *Options) *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "create -f FILE",
+		Short: "Create Experiments from the YAML file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file == "" {
+				return fmt.Errorf("Experiment file must be specified with -f flag")
+			}
+			r := io.Reader(os.Stdin)
+			if file != "-" {
+				f, err := os.Open(file)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+			return o.createExperiments(r)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "Path to the YAML file with Experiments, - to read from stdin")
+	return cmd
+}
+
+// createExperiments creates all Experiments from YAML or JSON documents.
+func (o *Options) createExperiments(r io.Reader) error {
+	c, err := o.client()
+	if err != nil {
+		return err
+	}
+	decoder := k8syaml.NewYAMLOrJSONDecoder(r, bufferSize)
+	for {
+		experiment := &experimentsv1beta1.Experiment{}
+		if err := decoder.Decode(experiment); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("Failed to decode Experiment: %v", err)
+		}
+		if experiment.Kind == "" && experiment.Name == "" {
+			continue
+		}
+		if experiment.APIVersion != experimentsv1beta1.SchemeGroupVersion.String() || experiment.Kind != kindExperiment {
+			return fmt.Errorf("Expected %v %v, got %v %v", experimentsv1beta1.SchemeGroupVersion, kindExperiment,
+				experiment.APIVersion, experiment.Kind)
+		}
+		if experiment.Namespace == "" {
+			experiment.Namespace = o.namespace()
+		}
+		if err := c.CreateRuntimeObject(experiment); err != nil {
+			return fmt.Errorf("Failed to create Experiment %v: %v", experiment.Name, err)
+		}
+		fmt.Fprintf(o.Out, "experiment.kubeflow.org/%v created\n", experiment.Name)
+	}
+}
+
+func newDeleteCommand(o *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete EXPERIMENT...",
+		Short: "Delete Experiments with their Trials and Suggestions",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.client()
+			if err != nil {
+				return err
+			}
+			for _, name := range args {
+				experiment := &experimentsv1beta1.Experiment{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: o.namespace()},
+				}
+				if err := c.DeleteRuntimeObject(experiment); err != nil {
+					return fmt.Errorf("Failed to delete Experiment %v: %v", name, err)
+				}
+				fmt.Fprintf(o.Out, "experiment.kubeflow.org/%v deleted\n", name)
+			}
+			return nil
+		},
+	}
+}
+
+func newExtendCommand(o *Options) *cobra.Command {
+	var maxTrialCount, trials int32
+	cmd := &cobra.Command{
+		Use:     "extend EXPERIMENT",
+		Aliases: []string{"restart"},
+		Short:   "Increase spec.maxTrialCount of the running Experiment or restart the completed Experiment",
+		Long: `Increase spec.maxTrialCount of the Experiment by --trials, parallelTrialCount by default,
+or set it to --max-trial-count. The completed Experiment is restarted if it succeeded by
+reaching max trials and spec.resumePolicy is LongRunning or FromVolume.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.extendExperiment(args[0], maxTrialCount, trials)
+		},
+	}
+	cmd.Flags().Int32Var(&maxTrialCount, "max-trial-count", 0, "New spec.maxTrialCount of the Experiment")
+	cmd.Flags().Int32Var(&trials, "trials", 0, "Number of Trials to add to spec.maxTrialCount, parallelTrialCount by default")
+	return cmd
+}
+
+// extendExperiment updates spec.maxTrialCount, the update is retried on conflict with the controller.
+func (o *Options) extendExperiment(name string, maxTrialCount, trials int32) error {
+	c, err := o.client()
+	if err != nil {
+		return err
+	}
+	var oldMaxTrialCount *int32
+	var newMaxTrialCount int32
+	var restarted bool
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		experiment, err := c.GetExperiment(name, o.namespace())
+		if err != nil {
+			return err
+		}
+		if experiment.IsCompleted() && !util.IsCompletedExperimentRestartable(experiment) {
+			return fmt.Errorf("Experiment %v can't be restarted, it must be succeeded by reaching max trials "+
+				"with spec.resumePolicy = %v or %v", name, experimentsv1beta1.LongRunning, experimentsv1beta1.FromVolume)
+		}
+		newMaxTrialCount = maxTrialCount
+		if newMaxTrialCount == 0 {
+			if experiment.Spec.MaxTrialCount == nil {
+				return fmt.Errorf("Experiment %v runs without spec.maxTrialCount, set it with --max-trial-count", name)
+			}
+			if trials == 0 {
+				trials = 1
+				if experiment.Spec.ParallelTrialCount != nil {
+					trials = *experiment.Spec.ParallelTrialCount
+				}
+			}
+			newMaxTrialCount = *experiment.Spec.MaxTrialCount + trials
+		}
+		if newMaxTrialCount <= experiment.Status.Trials {
+			return fmt.Errorf("spec.maxTrialCount must be greater than the number of Trials %v, got %v",
+				experiment.Status.Trials, newMaxTrialCount)
+		}
+		oldMaxTrialCount = experiment.Spec.MaxTrialCount
+		restarted = experiment.IsCompleted()
+		experiment.Spec.MaxTrialCount = &newMaxTrialCount
+		return c.UpdateRuntimeObject(experiment)
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to extend Experiment %v: %v", name, err)
+	}
+
+	action := "extended"
+	if restarted {
+		action = "restarted"
+	}
+	old := "unlimited"
+	if oldMaxTrialCount != nil {
+		old = fmt.Sprint(*oldMaxTrialCount)
+	}
+	fmt.Fprintf(o.Out, "experiment.kubeflow.org/%v %v, maxTrialCount: %v -> %v\n", name, action, old, newMaxTrialCount)
+	return nil
+}
+
+func newOptimalCommand(o *Options) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:     "optimal EXPERIMENT",
+		Aliases: []string{"best"},
+		Short:   "Print the optimal Trial of the Experiment",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.client()
+			if err != nil {
+				return err
+			}
+			experiment, err := c.GetExperiment(args[0], o.namespace())
+			if err != nil {
+				return err
+			}
+			optimalTrial := experiment.Status.CurrentOptimalTrial
+			if optimalTrial.BestTrialName == "" {
+				return fmt.Errorf("Experiment %v doesn't have the optimal Trial", experiment.Name)
+			}
+			if output != "" {
+				return printObject(o.Out, optimalTrial, output)
+			}
+			return printOptimalTrial(o.Out, experiment)
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format, yaml or json")
+	return cmd
+}
+
+func printOptimalTrial(w io.Writer, experiment *experimentsv1beta1.Experiment) error {
+	optimalTrial := experiment.Status.CurrentOptimalTrial
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Trial:\t%v\n", optimalTrial.BestTrialName)
+	if len(optimalTrial.RepetitionTrialNames) > 0 {
+		fmt.Fprintf(tw, "Repetitions:\t%v\n", strings.Join(optimalTrial.RepetitionTrialNames, ", "))
+	}
+	fmt.Fprintf(tw, "Objective:\t%v = %v\n", experiment.Spec.Objective.ObjectiveMetricName,
+		util.GetObjectiveMetricValue(experiment.Spec.Objective, &optimalTrial.Observation))
+	fmt.Fprintln(tw, "Parameters:")
+	for _, assignment := range optimalTrial.ParameterAssignments {
+		fmt.Fprintf(tw, "  %v\t%v\n", assignment.Name, assignment.Value)
+	}
+	fmt.Fprintln(tw, "Metrics:\tMIN\tMAX\tLATEST\tVALUE")
+	for _, metric := range optimalTrial.Observation.Metrics {
+		fmt.Fprintf(tw, "  %v\t%v\t%v\t%v\t%v\n", metric.Name, metric.Min, metric.Max, metric.Latest, metric.Value)
+	}
+	if len(optimalTrial.Artifacts) > 0 {
+		fmt.Fprintln(tw, "Artifacts:")
+		for _, artifact := range optimalTrial.Artifacts {
+			fmt.Fprintf(tw, "  %v\t%v\n", artifact.Name, artifact.URI)
+		}
+	}
+	return tw.Flush()
+}
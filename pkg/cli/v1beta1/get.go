@@ -0,0 +1,336 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
+)
+
+// statusColumn is the index of the STATUS column in all tables.
+const statusColumn = 1
+
+// tableRow is the row of the status table for the object.
+type tableRow struct {
+	name            string
+	resourceVersion string
+	cells           []string
+	object          interface{}
+}
+
+// listFunc lists the objects as table rows.
+type listFunc func(wide bool) ([]tableRow, error)
+
+type getOptions struct {
+	output string
+	watch  bool
+}
+
+func newGetCommand(o *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Display Experiments or Trials",
+	}
+	cmd.AddCommand(newGetExperimentsCommand(o), newGetTrialsCommand(o))
+	return cmd
+}
+
+func newGetExperimentsCommand(o *Options) *cobra.Command {
+	g := &getOptions{}
+	cmd := &cobra.Command{
+		Use:     "experiments [NAME...]",
+		Aliases: []string{"experiment", "exp"},
+		Short:   "Display Experiments with the Trial counts and the optimal Trial",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			header := []string{"NAME", "STATUS", "TRIALS", "RUNNING", "SUCCEEDED", "FAILED", "OPTIMAL-TRIAL", "OBJECTIVE", "AGE"}
+			if g.output == outputWide {
+				header = append(header, "ALGORITHM", "MAX-TRIALS", "PARALLEL")
+			}
+			return o.get(g, header, args, o.listExperiments(args))
+		},
+	}
+	addGetFlags(cmd, g)
+	return cmd
+}
+
+func newGetTrialsCommand(o *Options) *cobra.Command {
+	g := &getOptions{}
+	var experimentName string
+	cmd := &cobra.Command{
+		Use:     "trials [NAME...]",
+		Aliases: []string{"trial"},
+		Short:   "Display Trials with the parameter assignments and the objective metric value",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			header := []string{"NAME", "STATUS", "OBJECTIVE", "PARAMETERS", "AGE"}
+			if g.output == outputWide {
+				header = append(header, "EXPERIMENT", "METRICS")
+			}
+			return o.get(g, header, args, o.listTrials(experimentName, args))
+		},
+	}
+	addGetFlags(cmd, g)
+	cmd.Flags().StringVarP(&experimentName, "experiment", "e", "", "Display only Trials of the Experiment")
+	return cmd
+}
+
+func addGetFlags(cmd *cobra.Command, g *getOptions) {
+	cmd.Flags().StringVarP(&g.output, "output", "o", "", "Output format, wide, yaml or json")
+	cmd.Flags().BoolVarP(&g.watch, "watch", "w", false, "Print the changed objects after listing them")
+}
+
+// get prints the objects as yaml, json or table. Changed objects are printed again with --watch,
+// objects are polled since katibclient doesn't support watch.
+func (o *Options) get(g *getOptions, header []string, names []string, list listFunc) error {
+	wide := g.output == outputWide
+	rows, err := list(wide)
+	if err != nil {
+		return err
+	}
+	if g.output == outputYAML || g.output == outputJSON {
+		if g.watch {
+			return fmt.Errorf("--watch is supported only for tables")
+		}
+		if len(names) == 1 && len(rows) == 1 {
+			return printObject(o.Out, rows[0].object, g.output)
+		}
+		items := make([]interface{}, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, row.object)
+		}
+		return printObject(o.Out, map[string]interface{}{"apiVersion": "v1", "kind": "List", "items": items}, g.output)
+	}
+	if g.output != "" && !wide {
+		return fmt.Errorf("Unknown output format: %v", g.output)
+	}
+
+	if len(rows) == 0 && !g.watch {
+		fmt.Fprintf(o.Out, "No resources found in %v namespace.\n", o.namespace())
+		return nil
+	}
+	if err := printTable(o.Out, header, rows); err != nil {
+		return err
+	}
+	if !g.watch {
+		return nil
+	}
+
+	seen := map[string]tableRow{}
+	for _, row := range rows {
+		seen[row.name] = row
+	}
+	for o.sleep() {
+		rows, err := list(wide)
+		if err != nil {
+			return err
+		}
+		changed := []tableRow{}
+		current := map[string]bool{}
+		for _, row := range rows {
+			current[row.name] = true
+			if old, ok := seen[row.name]; !ok || old.resourceVersion != row.resourceVersion {
+				changed = append(changed, row)
+				seen[row.name] = row
+			}
+		}
+		for name, row := range seen {
+			if !current[name] {
+				row.cells[statusColumn] = "Deleted"
+				changed = append(changed, row)
+				delete(seen, name)
+			}
+		}
+		if err := printTable(o.Out, nil, changed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printTable(w io.Writer, header []string, rows []tableRow) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 3, ' ', 0)
+	if header != nil {
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+	}
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row.cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+func (o *Options) listExperiments(names []string) listFunc {
+	return func(wide bool) ([]tableRow, error) {
+		c, err := o.client()
+		if err != nil {
+			return nil, err
+		}
+		var experiments []*experimentsv1beta1.Experiment
+		if len(names) > 0 {
+			for _, name := range names {
+				experiment, err := c.GetExperiment(name, o.namespace())
+				if err != nil {
+					return nil, err
+				}
+				experiments = append(experiments, experiment)
+			}
+		} else {
+			experimentList, err := c.GetExperimentList(o.namespace())
+			if err != nil {
+				return nil, err
+			}
+			for i := range experimentList.Items {
+				experiments = append(experiments, &experimentList.Items[i])
+			}
+		}
+
+		rows := make([]tableRow, 0, len(experiments))
+		for _, experiment := range experiments {
+			rows = append(rows, experimentRow(experiment, wide))
+		}
+		return rows, nil
+	}
+}
+
+func experimentRow(experiment *experimentsv1beta1.Experiment, wide bool) tableRow {
+	status, _ := experiment.GetLastConditionType()
+	optimalTrial := experiment.Status.CurrentOptimalTrial
+	objective := ""
+	if optimalTrial.BestTrialName != "" {
+		objective = util.GetObjectiveMetricValue(experiment.Spec.Objective, &optimalTrial.Observation)
+	}
+	cells := []string{
+		experiment.Name,
+		string(status),
+		fmt.Sprint(experiment.Status.Trials),
+		fmt.Sprint(experiment.Status.TrialsRunning),
+		fmt.Sprint(experiment.Status.TrialsSucceeded),
+		fmt.Sprint(experiment.Status.TrialsFailed),
+		optimalTrial.BestTrialName,
+		objective,
+		age(experiment.CreationTimestamp),
+	}
+	if wide {
+		algorithm := ""
+		if experiment.Spec.Algorithm != nil {
+			algorithm = experiment.Spec.Algorithm.AlgorithmName
+		}
+		cells = append(cells, algorithm, formatCount(experiment.Spec.MaxTrialCount), formatCount(experiment.Spec.ParallelTrialCount))
+	}
+	return tableRow{
+		name:            experiment.Name,
+		resourceVersion: experiment.ResourceVersion,
+		cells:           cells,
+		object:          experiment,
+	}
+}
+
+func (o *Options) listTrials(experimentName string, names []string) listFunc {
+	return func(wide bool) ([]tableRow, error) {
+		c, err := o.client()
+		if err != nil {
+			return nil, err
+		}
+		var trials []*trialsv1beta1.Trial
+		if len(names) > 0 {
+			for _, name := range names {
+				trial, err := c.GetTrial(name, o.namespace())
+				if err != nil {
+					return nil, err
+				}
+				trials = append(trials, trial)
+			}
+		} else {
+			trialList := &trialsv1beta1.TrialList{}
+			if experimentName != "" {
+				trialList, err = c.GetTrialList(experimentName, o.namespace())
+			} else {
+				err = c.GetClient().List(context.TODO(), client.InNamespace(o.namespace()), trialList)
+			}
+			if err != nil {
+				return nil, err
+			}
+			for i := range trialList.Items {
+				trials = append(trials, &trialList.Items[i])
+			}
+		}
+
+		rows := make([]tableRow, 0, len(trials))
+		for _, trial := range trials {
+			rows = append(rows, trialRow(trial, wide))
+		}
+		return rows, nil
+	}
+}
+
+func trialRow(trial *trialsv1beta1.Trial, wide bool) tableRow {
+	status, _ := trial.GetLastConditionType()
+	objective := ""
+	if trial.Status.Observation != nil {
+		objective = util.GetObjectiveMetricValue(trial.Spec.Objective, trial.Status.Observation)
+	}
+	parameters := make([]string, 0, len(trial.Spec.ParameterAssignments))
+	for _, assignment := range trial.Spec.ParameterAssignments {
+		parameters = append(parameters, assignment.Name+"="+assignment.Value)
+	}
+	cells := []string{
+		trial.Name,
+		string(status),
+		objective,
+		strings.Join(parameters, ","),
+		age(trial.CreationTimestamp),
+	}
+	if wide {
+		metrics := []string{}
+		if trial.Status.Observation != nil {
+			for _, metric := range trial.Status.Observation.Metrics {
+				metrics = append(metrics, metric.Name+"="+metric.Latest)
+			}
+		}
+		cells = append(cells, trial.Labels[consts.LabelExperimentName], strings.Join(metrics, ","))
+	}
+	return tableRow{
+		name:            trial.Name,
+		resourceVersion: trial.ResourceVersion,
+		cells:           cells,
+		object:          trial,
+	}
+}
+
+func age(timestamp metav1.Time) string {
+	if timestamp.IsZero() {
+		return "<unknown>"
+	}
+	return formatAge(time.Since(timestamp.Time))
+}
+
+func formatCount(count *int32) string {
+	if count == nil {
+		return "<none>"
+	}
+	return fmt.Sprint(*count)
+}
@@ -0,0 +1,102 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func newLogsCommand(o *Options) *cobra.Command {
+	var metricName string
+	var follow bool
+	cmd := &cobra.Command{
+		Use:   "logs TRIAL",
+		Short: "Print the observation logs of the Trial from Katib DB Manager",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.printObservationLogs(args[0], metricName, follow)
+		},
+	}
+	cmd.Flags().StringVar(&metricName, "metric", "", "Print only the metric, all metrics are printed if empty")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Print new metrics until the Trial is completed")
+	return cmd
+}
+
+// printObservationLogs prints the metric logs of the Trial. New metric logs are polled with follow
+// by the time of the last printed log until the Trial is completed.
+func (o *Options) printObservationLogs(trialName, metricName string, follow bool) error {
+	c, err := o.client()
+	if err != nil {
+		return err
+	}
+	trial, err := c.GetTrial(trialName, o.namespace())
+	if err != nil {
+		return err
+	}
+	dbManager, err := o.NewDBManagerClient(o.DBManagerAddress)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(o.Out, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tMETRIC\tVALUE")
+	// Logs at the last time are returned again since the start time is inclusive
+	lastTime := ""
+	lastTimeLogs := 0
+	for {
+		completed := trial.IsCompleted()
+		reply, err := dbManager.GetObservationLog(context.Background(), &api_pb.GetObservationLogRequest{
+			TrialName:  trial.Name,
+			MetricName: metricName,
+			StartTime:  lastTime,
+			Namespace:  trial.Namespace,
+			TrialUid:   string(trial.UID),
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to get observation logs of Trial %v: %v", trial.Name, err)
+		}
+		skip := lastTimeLogs
+		for _, metricLog := range reply.GetObservationLog().GetMetricLogs() {
+			if metricLog.TimeStamp == lastTime && skip > 0 {
+				skip--
+				continue
+			}
+			if metricLog.TimeStamp == lastTime {
+				lastTimeLogs++
+			} else {
+				lastTime = metricLog.TimeStamp
+				lastTimeLogs = 1
+			}
+			fmt.Fprintf(tw, "%v\t%v\t%v\n", metricLog.TimeStamp, metricLog.GetMetric().GetName(), metricLog.GetMetric().GetValue())
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+
+		if !follow || completed || !o.sleep() {
+			return nil
+		}
+		if trial, err = c.GetTrial(trial.Name, trial.Namespace); err != nil {
+			return err
+		}
+	}
+}
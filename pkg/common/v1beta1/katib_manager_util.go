@@ -18,17 +18,11 @@ package v1beta1
 import (
 	"context"
 
-	"google.golang.org/grpc"
-
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpcpool"
 )
 
-type katibDBManagerClientAndConn struct {
-	Conn                 *grpc.ClientConn
-	KatibDBManagerClient api_pb.DBManagerClient
-}
-
 // GetDBManagerAddr returns address of Katib DB Manager
 func GetDBManagerAddr() string {
 	dbManagerNS := consts.DefaultKatibDBManagerServiceNamespace
@@ -42,41 +36,72 @@ func GetDBManagerAddr() string {
 	return dbManagerIP + ":" + dbManagerPort
 }
 
-func getKatibDBManagerClientAndConn() (*katibDBManagerClientAndConn, error) {
-	addr := GetDBManagerAddr()
-	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+// getKatibDBManagerClient returns the client of Katib DB Manager and the function to release it.
+// Connection to Katib DB Manager is reused from the connection pool.
+func getKatibDBManagerClient() (api_pb.DBManagerClient, func(), error) {
+	conn, release, err := grpcpool.Get(GetDBManagerAddr())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	kcc := &katibDBManagerClientAndConn{
-		Conn:                 conn,
-		KatibDBManagerClient: api_pb.NewDBManagerClient(conn),
-	}
-	return kcc, nil
-}
-
-func closeKatibDBManagerConnection(kcc *katibDBManagerClientAndConn) {
-	kcc.Conn.Close()
+	return api_pb.NewDBManagerClient(conn), release, nil
 }
 
 func GetObservationLog(request *api_pb.GetObservationLogRequest) (*api_pb.GetObservationLogReply, error) {
 	ctx := context.Background()
-	kcc, err := getKatibDBManagerClientAndConn()
+	kc, release, err := getKatibDBManagerClient()
 	if err != nil {
 		return nil, err
 	}
-	defer closeKatibDBManagerConnection(kcc)
-	kc := kcc.KatibDBManagerClient
+	defer release()
 	return kc.GetObservationLog(ctx, request)
 }
 
 func DeleteObservationLog(request *api_pb.DeleteObservationLogRequest) (*api_pb.DeleteObservationLogReply, error) {
 	ctx := context.Background()
-	kcc, err := getKatibDBManagerClientAndConn()
+	kc, release, err := getKatibDBManagerClient()
 	if err != nil {
 		return nil, err
 	}
-	defer closeKatibDBManagerConnection(kcc)
-	kc := kcc.KatibDBManagerClient
+	defer release()
 	return kc.DeleteObservationLog(ctx, request)
 }
+
+func ReportTrialLogs(request *api_pb.ReportTrialLogsRequest) (*api_pb.ReportTrialLogsReply, error) {
+	ctx := context.Background()
+	kc, release, err := getKatibDBManagerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return kc.ReportTrialLogs(ctx, request)
+}
+
+func GetTrialLogs(request *api_pb.GetTrialLogsRequest) (*api_pb.GetTrialLogsReply, error) {
+	ctx := context.Background()
+	kc, release, err := getKatibDBManagerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return kc.GetTrialLogs(ctx, request)
+}
+
+func ReportArtifacts(request *api_pb.ReportArtifactsRequest) (*api_pb.ReportArtifactsReply, error) {
+	ctx := context.Background()
+	kc, release, err := getKatibDBManagerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return kc.ReportArtifacts(ctx, request)
+}
+
+func GetArtifacts(request *api_pb.GetArtifactsRequest) (*api_pb.GetArtifactsReply, error) {
+	ctx := context.Background()
+	kc, release, err := getKatibDBManagerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return kc.GetArtifacts(ctx, request)
+}
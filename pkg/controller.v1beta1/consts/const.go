@@ -25,11 +25,25 @@ const (
 	// ConfigTrialResources is the config name which indicates
 	// resources list which can be used as trial template
 	ConfigTrialResources = "trial-resources"
+	// ConfigTrialLogsLimitBytes is the config name which indicates
+	// the size of the last part of the failed Trial logs to be stored
+	// in DB. Logs are not stored if it is not positive.
+	ConfigTrialLogsLimitBytes = "trial-logs-limit-bytes"
 
 	// LabelExperimentName is the label of experiment name.
 	LabelExperimentName = "experiment"
 	// LabelSuggestionName is the label of suggestion name.
 	LabelSuggestionName = "suggestion"
+	// LabelPromotedExperimentName is the label of experiment name for the job which is created from the best trial.
+	LabelPromotedExperimentName = "promoted-experiment"
+	// LabelPromotedTrialName is the label of trial name for the job which is created from the best trial.
+	LabelPromotedTrialName = "promoted-trial"
+	// LabelRepetitionGroupName is the label of trial with the name of the repeated parameter assignment.
+	LabelRepetitionGroupName = "repetition-group"
+	// LabelRepetitionSeed is the label of trial with the seed of the repetition.
+	LabelRepetitionSeed = "repetition-seed"
+	// LabelTrialName is the label of the Trial primary pod with the Trial name.
+	LabelTrialName = "trial"
 	// LabelDeploymentName is the label of deployment name.
 	LabelDeploymentName = "deployment"
 
@@ -69,6 +83,16 @@ const (
 	LabelSuggestionTag = "suggestion"
 	// LabelMetricsCollectorSidecar is the name of metrics collector config in configmap.
 	LabelMetricsCollectorSidecar = "metrics-collector-sidecar"
+	// LabelGRPCTLS is the name of gRPC TLS config in configmap.
+	LabelGRPCTLS = "grpc-tls"
+	// LabelLauncher is the name of training container launcher config in configmap.
+	LabelLauncher = "launcher"
+	// DefaultLauncherImage is the default image of training container launcher.
+	DefaultLauncherImage = "gcr.io/kubeflow-images-public/katib/v1beta1/katib-launcher"
+	// DefaultImageCacheTTL is the default TTL of the image tag in the pod webhook image cache.
+	DefaultImageCacheTTL = time.Hour
+	// DefaultImageCacheTimeout is the default timeout of the image config lookup in the pod webhook.
+	DefaultImageCacheTimeout = 10 * time.Second
 	// DefaultImagePullPolicy is the default value for image pull policy.
 	DefaultImagePullPolicy = corev1.PullIfNotPresent
 	// DefaultCPULimit is the default value for CPU limit.
@@ -100,6 +124,23 @@ const (
 	// Full default local path = /tmp/katib/suggestions/<suggestion-name>-<suggestion-algorithm>-<suggestion-namespace>
 	DefaultSuggestionVolumeLocalPathPrefix = "/tmp/katib/suggestions/"
 
+	// SuggestionEndpointNamespacePlaceholder is replaced with the Suggestion namespace
+	// in the endpoint of the shared algorithm service.
+	SuggestionEndpointNamespacePlaceholder = "${namespace}"
+
+	// SuggestionInProcessEndpoint is the Suggestion endpoint if the algorithm runs inside katib-controller.
+	SuggestionInProcessEndpoint = "in-process"
+
+	// GRPCTLSVolumeName is the volume name of the Suggestion server TLS Secret in Suggestion containers.
+	GRPCTLSVolumeName = "katib-grpc-tls"
+	// DefaultGRPCTLSMountPath is the mount path of the Suggestion server TLS Secret.
+	DefaultGRPCTLSMountPath = "/etc/katib/grpc-tls"
+	// GRPCTLSClientVolumeName is the volume name of the client TLS Secret in metrics collector
+	// and Suggestion containers.
+	GRPCTLSClientVolumeName = "katib-grpc-tls-client"
+	// DefaultGRPCTLSClientMountPath is the mount path of the client TLS Secret.
+	DefaultGRPCTLSClientMountPath = "/etc/katib/grpc-tls-client"
+
 	// ReconcileErrorReason is the reason when there is a reconcile error.
 	ReconcileErrorReason = "ReconcileError"
 
@@ -109,11 +150,19 @@ const (
 	JobKindTF = "TFJob"
 	// JobKindPyTorch is the kind of PyTorchJob.
 	JobKindPyTorch = "PyTorchJob"
+	// JobKindMPI is the kind of MPIJob.
+	JobKindMPI = "MPIJob"
+	// JobKindXGBoost is the kind of XGBoostJob.
+	JobKindXGBoost = "XGBoostJob"
 
 	// built-in JobRoles
 	JobRole        = "job-role"
 	JobRoleTF      = "tf-job-role"
 	JobRolePyTorch = "pytorch-job-role"
+	JobRoleMPI     = "mpi-job-role"
+
+	// JobRoleMPILauncher is the value of the MPIJob role label for the launcher replica.
+	JobRoleMPILauncher = "launcher"
 
 	// AnnotationIstioSidecarInjectName is the annotation of Istio Sidecar
 	AnnotationIstioSidecarInjectName = "sidecar.istio.io/inject"
@@ -132,6 +181,15 @@ const (
 
 	// TrialTemplateParamReplaceFormatRegex is the regex for TrialParameters format in Trial template
 	TrialTemplateParamReplaceFormatRegex = "\\$\\{trialParameters\\..+?\\}"
+	// TrialTemplateParamParseFormatRegex is the regex to parse the parameter name or expression
+	// from TrialParameters format in Trial template, e.g. ${trialParameters.learningRate * 10}
+	TrialTemplateParamParseFormatRegex = "\\$\\{trialParameters\\.(.+?)\\}"
+
+	// built-in variables which can be used in Trial template expressions
+	TrialTemplateExprKeyOfExperimentName      = "experiment.name"
+	TrialTemplateExprKeyOfExperimentNamespace = "experiment.namespace"
+	TrialTemplateExprKeyOfTrialName           = "trial.name"
+	TrialTemplateExprKeyOfTrialNamespace      = "trial.namespace"
 
 	// TrialTemplateMetaReplaceFormatRegex is the regex for TrialMetadata format in Trial template
 	TrialTemplateMetaReplaceFormatRegex = "\\$\\{trialSpec\\.(.+?)\\}"
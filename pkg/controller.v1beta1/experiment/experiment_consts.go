@@ -1,5 +1,8 @@
 package experiment
 
 const (
-	ReconcileFailedReason = "ReconcileFailed"
+	ReconcileFailedReason        = "ReconcileFailed"
+	PromoteBestTrialFailedReason = "PromoteBestTrialFailed"
+	PromoteBestTrialReason       = "BestTrialPromoted"
+	BestTrialNotPromotableReason = "BestTrialNotPromotable"
 )
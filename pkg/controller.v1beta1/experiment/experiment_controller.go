@@ -44,8 +44,10 @@ import (
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/manifest"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/notification"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/suggestion"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/promotion"
 )
 
 const (
@@ -74,6 +76,8 @@ func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 	r.Suggestion = newSuggestion(imp, mgr.GetScheme(), mgr.GetClient())
 
 	r.Generator = manifest.New(r.Client)
+	r.Notifier = notification.New(r.Client, r.recorder)
+	r.Promoter = promotion.New(r.Client, r.Generator)
 	r.updateStatusHandler = r.updateStatus
 	r.collector = util.NewExpsCollector(mgr.GetCache(), metrics.Registry)
 	return r
@@ -157,6 +161,8 @@ type ReconcileExperiment struct {
 
 	suggestion.Suggestion
 	manifest.Generator
+	notification.Notifier
+	promotion.Promoter
 	// updateStatusHandler is defined for test purpose.
 	updateStatusHandler updateStatusFunc
 	// collector is a wrapper for experiment metrics.
@@ -198,6 +204,20 @@ func (r *ReconcileExperiment) Reconcile(request reconcile.Request) (reconcile.Re
 				return reconcile.Result{}, err
 			}
 		}
+		// Create the job from the best trial once after Experiment is succeeded.
+		// Experiment without the best trial, e.g. when no trial reported the objective metric, is not promotable.
+		if instance.IsSucceeded() && instance.Spec.PromoteBestTrial != nil && instance.Status.PromotedJob == nil {
+			if instance.Status.CurrentOptimalTrial.BestTrialName == "" {
+				logger.Info("Experiment doesn't have the best trial to promote")
+				r.recorder.Event(instance, corev1.EventTypeWarning, BestTrialNotPromotableReason,
+					"Experiment doesn't have the best trial to promote")
+			} else if err := r.promoteBestTrial(instance); err != nil {
+				logger.Error(err, "promoteBestTrial error")
+				r.recorder.Eventf(instance, corev1.EventTypeWarning, PromoteBestTrialFailedReason,
+					"Failed to promote the best trial: %v", err)
+				return reconcile.Result{}, err
+			}
+		}
 		// Check if experiment is restartable and max trials is reconfigured
 		// That means experiment is restarting
 		if (util.IsCompletedExperimentRestartable(instance) &&
@@ -221,6 +241,14 @@ func (r *ReconcileExperiment) Reconcile(request reconcile.Request) (reconcile.Re
 		} else {
 			// If experiment is completed with no running trials, stop reconcile
 			if !instance.HasRunningTrials() {
+				if !equality.Semantic.DeepEqual(original.Status, instance.Status) {
+					if err := r.updateStatusHandler(instance); err != nil {
+						logger.Info("Update experiment instance status failed, reconciler requeued", "err", err)
+						return reconcile.Result{
+							Requeue: true,
+						}, nil
+					}
+				}
 				return reconcile.Result{}, nil
 			}
 		}
@@ -255,6 +283,9 @@ func (r *ReconcileExperiment) Reconcile(request reconcile.Request) (reconcile.Re
 				Requeue: true,
 			}, nil
 		}
+		for _, event := range notification.GetEvents(original, instance) {
+			r.Notify(instance, event)
+		}
 	}
 
 	return reconcile.Result{}, nil
@@ -348,26 +379,39 @@ func (r *ReconcileExperiment) ReconcileTrials(instance *experimentsv1beta1.Exper
 func (r *ReconcileExperiment) createTrials(instance *experimentsv1beta1.Experiment, trialList []trialsv1beta1.Trial, addCount int32) error {
 
 	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
-	currentCount := int32(len(trialList))
+	// Print created Trial names
+	var trialNames []string
+	defer func() {
+		if len(trialNames) != 0 {
+			logger.Info("Created Trials", "trialNames", trialNames)
+		}
+	}()
+
+	trialNames, err := r.completeRepetitionGroups(instance, trialList, addCount)
+	if err != nil {
+		logger.Error(err, "Create missing repetitions error")
+		return err
+	}
+	addCount -= int32(len(trialNames))
+
+	currentCount, addCount := suggestionCounts(instance, trialList, addCount)
+	if addCount <= 0 {
+		return nil
+	}
 	logger.Info("Reconcile Suggestion", "addCount", addCount)
 	trials, err := r.ReconcileSuggestions(instance, currentCount, addCount)
 	if err != nil {
 		logger.Error(err, "Get suggestions error")
 		return err
 	}
-	var trialNames []string
 	for _, trial := range trials {
-		if err = r.createTrialInstance(instance, &trial); err != nil {
+		names, err := r.createTrialInstances(instance, &trial)
+		trialNames = append(trialNames, names...)
+		if err != nil {
 			logger.Error(err, "Create trial instance error", "trial", trial)
-			continue
+			return err
 		}
-		trialNames = append(trialNames, trial.Name)
 	}
-	// Print created Trial names
-	if len(trialNames) != 0 {
-		logger.Info("Created Trials", "trialNames", trialNames)
-	}
-
 	return nil
 }
 
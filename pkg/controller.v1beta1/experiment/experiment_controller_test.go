@@ -24,10 +24,12 @@ import (
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/notification"
 	experimentUtil "github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
 	util "github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	manifestmock "github.com/kubeflow/katib/pkg/mock/v1beta1/experiment/manifest"
 	suggestionmock "github.com/kubeflow/katib/pkg/mock/v1beta1/experiment/suggestion"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/promotion"
 	kubeflowcommonv1 "github.com/kubeflow/tf-operator/pkg/apis/common/v1"
 	tfv1 "github.com/kubeflow/tf-operator/pkg/apis/tensorflow/v1"
 )
@@ -101,6 +103,8 @@ func TestReconcile(t *testing.T) {
 		scheme:     mgr.GetScheme(),
 		Suggestion: mockSuggestion,
 		Generator:  mockGenerator,
+		Notifier:   notification.New(mgr.GetClient(), mgr.GetRecorder(ControllerName)),
+		Promoter:   promotion.New(mgr.GetClient(), mockGenerator),
 		collector:  experimentUtil.NewExpsCollector(mgr.GetCache(), prometheus.NewRegistry()),
 		recorder:   mgr.GetRecorder(ControllerName),
 	}
@@ -2,25 +2,98 @@ package experiment
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/promotion"
 )
 
 const (
 	updatePrometheusMetrics = "update-prometheus-metrics"
 )
 
-func (r *ReconcileExperiment) createTrialInstance(expInstance *experimentsv1beta1.Experiment, trialAssignment *suggestionsv1beta1.TrialAssignment) error {
+// suggestionCounts returns how many parameter assignments are already suggested and how many
+// should be added for addCount trials. If the Experiment repeats trials, each assignment is run
+// in several trials, so assignments are added only for the complete groups of trials.
+func suggestionCounts(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial, addCount int32) (int32, int32) {
+	repetitions := util.GetRepetitionCount(instance)
+	if repetitions <= 1 {
+		return int32(len(trials)), addCount
+	}
+	return int32(len(util.GroupRepeatedTrials(trials))), addCount / repetitions
+}
+
+// createTrialInstances creates the Trials for the parameter assignment and returns names of the created Trials.
+// It stops at the first error, the missing repetitions are created by completeRepetitionGroups in the next reconcile.
+func (r *ReconcileExperiment) createTrialInstances(expInstance *experimentsv1beta1.Experiment, trialAssignment *suggestionsv1beta1.TrialAssignment) ([]string, error) {
+	var trialNames []string
+	repetitions := util.GetRepetitionCount(expInstance)
+	for seed := int32(0); seed < repetitions; seed++ {
+		name, err := r.createTrialInstance(expInstance, trialAssignment, seed)
+		if err != nil {
+			return trialNames, err
+		}
+		trialNames = append(trialNames, name)
+	}
+	return trialNames, nil
+}
+
+// completeRepetitionGroups creates the missing repetitions of the parameter assignments, e.g. if the
+// Trial create failed, so that every group is run with all seeds. At most maxCount Trials are created.
+// It returns names of the created Trials.
+func (r *ReconcileExperiment) completeRepetitionGroups(expInstance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial, maxCount int32) ([]string, error) {
+	repetitions := util.GetRepetitionCount(expInstance)
+	var trialNames []string
+	if repetitions <= 1 {
+		return trialNames, nil
+	}
+	for _, group := range util.GroupRepeatedTrials(trials) {
+		if int32(len(group)) >= repetitions {
+			continue
+		}
+		seeds := make(map[string]bool)
+		for _, trial := range group {
+			seeds[trial.Labels[consts.LabelRepetitionSeed]] = true
+		}
+		// Trial keeps the suggested parameter assignments without the seed
+		trialAssignment := &suggestionsv1beta1.TrialAssignment{
+			Name:                 util.RepetitionGroupName(&group[0]),
+			ParameterAssignments: group[0].Spec.ParameterAssignments,
+		}
+		for seed := int32(0); seed < repetitions; seed++ {
+			if seeds[strconv.Itoa(int(seed))] {
+				continue
+			}
+			if int32(len(trialNames)) >= maxCount {
+				return trialNames, nil
+			}
+			name, err := r.createTrialInstance(expInstance, trialAssignment, seed)
+			if err != nil {
+				return trialNames, err
+			}
+			trialNames = append(trialNames, name)
+		}
+	}
+	return trialNames, nil
+}
+
+// createTrialInstance creates the Trial for the parameter assignment. If the Experiment repeats trials,
+// seed is the index of the repetition, it is added to the Trial name and labels and passed to the Trial template.
+func (r *ReconcileExperiment) createTrialInstance(expInstance *experimentsv1beta1.Experiment, trialAssignment *suggestionsv1beta1.TrialAssignment, seed int32) (string, error) {
 	logger := log.WithValues("Experiment", types.NamespacedName{Name: expInstance.GetName(), Namespace: expInstance.GetNamespace()})
 
 	trial := &trialsv1beta1.Trial{}
@@ -28,20 +101,29 @@ func (r *ReconcileExperiment) createTrialInstance(expInstance *experimentsv1beta
 	trial.Namespace = expInstance.GetNamespace()
 	trial.Labels = util.TrialLabels(expInstance)
 
+	hps := trialAssignment.ParameterAssignments
+	if seedParameterName := util.GetRepetitionSeedParameterName(expInstance); seedParameterName != "" {
+		seedValue := strconv.Itoa(int(seed))
+		trial.Name = util.RepetitionTrialName(trialAssignment.Name, seed)
+		trial.Labels[consts.LabelRepetitionGroupName] = trialAssignment.Name
+		trial.Labels[consts.LabelRepetitionSeed] = seedValue
+		hps = append(append([]commonv1beta1.ParameterAssignment{}, hps...),
+			commonv1beta1.ParameterAssignment{Name: seedParameterName, Value: seedValue})
+	}
+
 	if err := controllerutil.SetControllerReference(expInstance, trial, r.scheme); err != nil {
 		logger.Error(err, "Set controller reference error")
-		return err
+		return "", err
 	}
 
 	trial.Spec.Objective = expInstance.Spec.Objective
 
-	hps := trialAssignment.ParameterAssignments
 	trial.Spec.ParameterAssignments = trialAssignment.ParameterAssignments
 
 	runSpec, err := r.GetRunSpecWithHyperParameters(expInstance, trial.Name, trial.Namespace, hps)
 	if err != nil {
 		logger.Error(err, "Fail to get RunSpec from experiment", expInstance.Name)
-		return err
+		return "", err
 	}
 
 	trial.Spec.RunSpec = runSpec
@@ -68,10 +150,9 @@ func (r *ReconcileExperiment) createTrialInstance(expInstance *experimentsv1beta
 
 	if err := r.Create(context.TODO(), trial); err != nil {
 		logger.Error(err, "Trial create error", "Trial name", trial.Name)
-		return err
+		return "", err
 	}
-	return nil
-
+	return trial.Name, nil
 }
 
 func needUpdateFinalizers(exp *experimentsv1beta1.Experiment) (bool, []string) {
@@ -183,3 +264,40 @@ func (r *ReconcileExperiment) restartSuggestion(instance *experimentsv1beta1.Exp
 	}
 	return nil
 }
+
+// promoteBestTrial creates the job from the best trial and saves the reference to it in the Experiment status.
+// Job which already exists with the label of the Experiment is considered promoted,
+// e.g. when the status update failed after the job was created.
+func (r *ReconcileExperiment) promoteBestTrial(instance *experimentsv1beta1.Experiment) error {
+	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	job, err := r.PromoteBestTrial(instance, instance.Spec.PromoteBestTrial)
+	if errors.IsAlreadyExists(err) {
+		job, err = r.getPromotedJob(instance)
+	}
+	if err != nil {
+		return err
+	}
+	logger.Info("Best trial is promoted", "Trial", instance.Status.CurrentOptimalTrial.BestTrialName,
+		"Kind", job.GetKind(), "Job", job.GetName())
+	r.recorder.Eventf(instance, corev1.EventTypeNormal, PromoteBestTrialReason,
+		"Created %v %v from the best trial %v", job.GetKind(), job.GetName(), instance.Status.CurrentOptimalTrial.BestTrialName)
+	instance.Status.PromotedJob = promotion.ObjectReference(job)
+	return nil
+}
+
+// getPromotedJob returns the existing job of the best trial if it is created for the Experiment.
+func (r *ReconcileExperiment) getPromotedJob(instance *experimentsv1beta1.Experiment) (*unstructured.Unstructured, error) {
+	desiredJob, err := r.RenderBestTrialJob(instance, instance.Spec.PromoteBestTrial)
+	if err != nil {
+		return nil, err
+	}
+	job := &unstructured.Unstructured{}
+	job.SetGroupVersionKind(desiredJob.GroupVersionKind())
+	if err := r.Get(context.TODO(), types.NamespacedName{Name: desiredJob.GetName(), Namespace: desiredJob.GetNamespace()}, job); err != nil {
+		return nil, err
+	}
+	if job.GetLabels()[consts.LabelPromotedExperimentName] != instance.Name {
+		return nil, fmt.Errorf("%v %v already exists and isn't created from the experiment", job.GetKind(), job.GetName())
+	}
+	return job, nil
+}
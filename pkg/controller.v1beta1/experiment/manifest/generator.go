@@ -14,6 +14,7 @@ import (
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/expression"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
@@ -95,13 +96,30 @@ func (g *DefaultGenerator) applyParameters(experiment *experimentsv1beta1.Experi
 		}
 	}
 
+	placeHolderToValueMap := make(map[string]string)
+
+	// Seed of the repeated Trial is substituted as ${trialParameters.<seedParameterName>}.
+	// Jobs rendered without the seed assignment, e.g. in dry-run, get the seed of the first repetition.
+	seedParameterName := util.GetRepetitionSeedParameterName(experiment)
+	if seedParameterName != "" {
+		placeHolderToValueMap[seedParameterName] = "0"
+	}
+	var hyperParameters []commonapiv1beta1.ParameterAssignment
+	for _, assignment := range assignments {
+		if seedParameterName != "" && assignment.Name == seedParameterName {
+			placeHolderToValueMap[seedParameterName] = assignment.Value
+			continue
+		}
+		hyperParameters = append(hyperParameters, assignment)
+	}
+	assignments = hyperParameters
+
 	// Convert parameter assignment to map key = parameter name, value = parameter value
 	assignmentsMap := make(map[string]string)
 	for _, assignment := range assignments {
 		assignmentsMap[assignment.Name] = assignment.Value
 	}
 
-	placeHolderToValueMap := make(map[string]string)
 	var metaRefKey, metaRefIndex string
 	nonMetaParamCount := 0
 	for _, param := range experiment.Spec.TrialTemplate.TrialParameters {
@@ -160,14 +178,51 @@ func (g *DefaultGenerator) applyParameters(experiment *experimentsv1beta1.Experi
 		return "", fmt.Errorf("Number of TrialAssignment: %v != number of nonMetaTrialParameters in TrialSpec: %v", len(assignments), nonMetaParamCount)
 	}
 
-	// Replacing placeholders with parameter values
+	// Expressions can use parameter values and built-in variables
+	variables := map[string]string{
+		consts.TrialTemplateExprKeyOfExperimentName:      experiment.GetName(),
+		consts.TrialTemplateExprKeyOfExperimentNamespace: experiment.GetNamespace(),
+		consts.TrialTemplateExprKeyOfTrialName:           trialName,
+		consts.TrialTemplateExprKeyOfTrialNamespace:      trialNamespace,
+	}
 	for placeHolder, paramValue := range placeHolderToValueMap {
-		trialTemplate = strings.Replace(trialTemplate, fmt.Sprintf(consts.TrialTemplateParamReplaceFormat, placeHolder), paramValue, -1)
+		variables[placeHolder] = paramValue
+	}
+
+	// Replacing placeholders with parameter values or evaluated expressions
+	var replaceErr error
+	paramParseRegex := regexp.MustCompile(consts.TrialTemplateParamParseFormatRegex)
+	trialTemplate = paramParseRegex.ReplaceAllStringFunc(trialTemplate, func(placeHolder string) string {
+		content := paramParseRegex.FindStringSubmatch(placeHolder)[1]
+		if paramValue, ok := placeHolderToValueMap[content]; ok {
+			return paramValue
+		}
+		expr, err := ParseExpression(content)
+		if err == nil {
+			var value string
+			if value, err = expr.Evaluate(variables); err == nil {
+				return value
+			}
+		}
+		if replaceErr == nil {
+			replaceErr = fmt.Errorf("Unable to make substitution for %v in Trial template: %v", placeHolder, err)
+		}
+		return placeHolder
+	})
+	if replaceErr != nil {
+		return "", replaceErr
 	}
 
 	return trialTemplate, nil
 }
 
+// ParseExpression parses the expression from the Trial template substitution.
+// Trial template is serialized to JSON or YAML string, thus quotes in the expression can be escaped.
+func ParseExpression(content string) (*expression.Expression, error) {
+	content = strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(content)
+	return expression.Parse(content)
+}
+
 // GetTrialTemplate returns string Trial template from experiment
 func (g *DefaultGenerator) GetTrialTemplate(instance *experimentsv1beta1.Experiment) (string, error) {
 	var trialTemplateString string
@@ -16,6 +16,7 @@ import (
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestGetRunSpecWithHP(t *testing.T) {
@@ -317,6 +318,108 @@ spec:
 	}
 }
 
+func TestGetRunSpecWithHPExpressions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	c := katibclientmock.NewMockClient(mockCtrl)
+
+	p := &DefaultGenerator{
+		client: c,
+	}
+
+	newInstanceWithCommand := func(command []string) *experimentsv1beta1.Experiment {
+		i := newFakeInstance()
+		i.Name = "experiment-name"
+		job := &batchv1.Job{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(i.Spec.TrialTemplate.TrialSpec.Object, job); err != nil {
+			t.Fatalf("FromUnstructured failed: %v", err)
+		}
+		job.Spec.Template.Spec.Containers[0].Command = command
+		i.Spec.TrialTemplate.TrialSpec, _ = util.ConvertObjectToUnstructured(job)
+		return i
+	}
+
+	tcs := []struct {
+		Instance        *experimentsv1beta1.Experiment
+		expectedCommand []string
+		Err             bool
+		testDescription string
+	}{
+		{
+			Instance: newInstanceWithCommand([]string{
+				"--lr=${trialParameters.learningRate * 10}",
+				"--workers=${trialParameters.int(numberLayers / 2)}",
+				"--lr-str=${trialParameters.format(\"%.3f\", learningRate)}",
+				"--output=${trialParameters.join(\"/\", experiment.name, trialName)}",
+				"--num-layers=${trialParameters.numberLayers}",
+			}),
+			expectedCommand: []string{
+				"--lr=0.5",
+				"--workers=2",
+				"--lr-str=0.050",
+				"--output=experiment-name/trial-name",
+				"--num-layers=5",
+			},
+			testDescription: "Run with valid expressions",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newInstanceWithCommand([]string{
+					"--lr=${trialParameters.learningRate}",
+					"--num-layers=${trialParameters.numberLayers}",
+					"--seed=${trialParameters.seed}",
+					"--output=${trialParameters.join(\"-\", trialName, seed)}",
+				})
+				i.Spec.Repetitions = &experimentsv1beta1.RepetitionSpec{Count: 3}
+				return i
+			}(),
+			expectedCommand: []string{
+				"--lr=0.05",
+				"--num-layers=5",
+				"--seed=0",
+				"--output=trial-name-0",
+			},
+			testDescription: "Run with the seed of the first repetition",
+		},
+		{
+			Instance: newInstanceWithCommand([]string{
+				"--lr=${trialParameters.learningRate}",
+				"--num-layers=${trialParameters.numberLayers / 0}",
+			}),
+			Err:             true,
+			testDescription: "Expression evaluation error",
+		},
+		{
+			Instance: newInstanceWithCommand([]string{
+				"--lr=${trialParameters.learningRate}",
+				"--num-layers=${trialParameters.unknownParameter}",
+			}),
+			Err:             true,
+			testDescription: "Unknown parameter in Trial template",
+		},
+	}
+
+	for _, tc := range tcs {
+		actualRunSpec, err := p.GetRunSpecWithHyperParameters(tc.Instance, "trial-name", "trial-namespace", newFakeParameterAssignment())
+		if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		} else if !tc.Err {
+			if err != nil {
+				t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+				continue
+			}
+			actualJob := &batchv1.Job{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(actualRunSpec.Object, actualJob); err != nil {
+				t.Fatalf("FromUnstructured failed: %v", err)
+			}
+			if actualCommand := actualJob.Spec.Template.Spec.Containers[0].Command; !reflect.DeepEqual(tc.expectedCommand, actualCommand) {
+				t.Errorf("Case: %v failed. Expected %v\n got %v", tc.testDescription, tc.expectedCommand, actualCommand)
+			}
+		}
+	}
+}
+
 func newFakeInstance() *experimentsv1beta1.Experiment {
 
 	trialTemplateJob := &batchv1.Job{
@@ -0,0 +1,278 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+
+	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+var log = logf.Log.WithName("experiment-notification")
+
+const (
+	// NotificationFailedReason is the reason of the event when the webhook can't be notified.
+	NotificationFailedReason = "NotificationFailed"
+
+	defaultRequestTimeout = 10 * time.Second
+	defaultInitialBackoff = time.Second
+)
+
+// Notifier is the type for the Experiment notifications.
+type Notifier interface {
+	Notify(instance *experimentsv1beta1.Experiment, event experimentsv1beta1.NotificationEventType)
+}
+
+// DefaultNotifier is the default implementation of Notifier.
+// It sends the payload to the webhooks of the Experiment.
+type DefaultNotifier struct {
+	client     client.Client
+	recorder   record.EventRecorder
+	httpClient *http.Client
+	// initialBackoff is the delay before the first retry, it is doubled after each retry.
+	initialBackoff time.Duration
+}
+
+// New creates a new Notifier.
+func New(c client.Client, recorder record.EventRecorder) Notifier {
+	return &DefaultNotifier{
+		client:         c,
+		recorder:       recorder,
+		httpClient:     &http.Client{Timeout: defaultRequestTimeout},
+		initialBackoff: defaultInitialBackoff,
+	}
+}
+
+// Payload is the data of the notification.
+// The payload template is executed with Payload.
+type Payload struct {
+	Event      experimentsv1beta1.NotificationEventType `json:"event"`
+	Name       string                                   `json:"name"`
+	Namespace  string                                   `json:"namespace"`
+	Condition  *experimentsv1beta1.ExperimentCondition  `json:"condition,omitempty"`
+	BestTrial  experimentsv1beta1.OptimalTrial          `json:"bestTrial"`
+	Experiment *experimentsv1beta1.Experiment           `json:"-"`
+}
+
+// GetEvents returns the notification events which happened between the original and the updated Experiment.
+func GetEvents(original, instance *experimentsv1beta1.Experiment) []experimentsv1beta1.NotificationEventType {
+	var events []experimentsv1beta1.NotificationEventType
+	bestTrialName := instance.Status.CurrentOptimalTrial.BestTrialName
+	if bestTrialName != "" && bestTrialName != original.Status.CurrentOptimalTrial.BestTrialName {
+		events = append(events, experimentsv1beta1.NotificationEventNewBestTrial)
+	}
+	if instance.IsSucceeded() && !original.IsSucceeded() {
+		events = append(events, experimentsv1beta1.NotificationEventSucceeded)
+	}
+	if instance.IsFailed() && !original.IsFailed() {
+		events = append(events, experimentsv1beta1.NotificationEventFailed)
+	}
+	return events
+}
+
+// Notify sends the event to the webhooks of the Experiment which are subscribed to it.
+// Requests are sent in the background, failures are recorded as the Experiment events.
+func (n *DefaultNotifier) Notify(instance *experimentsv1beta1.Experiment, event experimentsv1beta1.NotificationEventType) {
+	instance = instance.DeepCopy()
+	for _, notification := range instance.Spec.Notifications {
+		if !hasEvent(notification, event) {
+			continue
+		}
+		go func(notification experimentsv1beta1.NotificationSpec) {
+			if err := n.notify(instance, notification, event); err != nil {
+				log.Error(err, "Notification failed", "Experiment", types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace},
+					"URL", notification.URL, "Event", event)
+				n.recorder.Eventf(instance, corev1.EventTypeWarning, NotificationFailedReason,
+					"Failed to send %v notification to %v: %v", event, notification.URL, err)
+			}
+		}(notification)
+	}
+}
+
+func (n *DefaultNotifier) notify(instance *experimentsv1beta1.Experiment, notification experimentsv1beta1.NotificationSpec, event experimentsv1beta1.NotificationEventType) error {
+	payload, err := RenderPayload(notification.PayloadTemplate, NewPayload(instance, event))
+	if err != nil {
+		return err
+	}
+	auth := ""
+	if notification.AuthSecretRef != nil {
+		if auth, err = n.getAuthHeader(instance.Namespace, notification.AuthSecretRef); err != nil {
+			return err
+		}
+	}
+
+	maxRetries := int32(experimentsv1beta1.DefaultNotificationMaxRetries)
+	if notification.MaxRetries != nil {
+		maxRetries = *notification.MaxRetries
+	}
+	backoff := n.initialBackoff
+	for retry := int32(0); ; retry++ {
+		retryable, err := n.send(notification.URL, auth, payload)
+		if err == nil || !retryable || retry >= maxRetries {
+			return err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// send posts the payload to the URL. It returns whether the failed request can be retried.
+func (n *DefaultNotifier) send(url, auth string, payload []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("webhook responded with status %v", resp.Status)
+}
+
+func (n *DefaultNotifier) getAuthHeader(namespace string, ref *corev1.SecretKeySelector) (string, error) {
+	secret := &corev1.Secret{}
+	if err := n.client.Get(context.TODO(), types.NamespacedName{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key %v not found in Secret %v", ref.Key, ref.Name)
+	}
+	return string(value), nil
+}
+
+// NewPayload creates the notification payload for the Experiment event.
+func NewPayload(instance *experimentsv1beta1.Experiment, event experimentsv1beta1.NotificationEventType) Payload {
+	payload := Payload{
+		Event:      event,
+		Name:       instance.Name,
+		Namespace:  instance.Namespace,
+		BestTrial:  instance.Status.CurrentOptimalTrial,
+		Experiment: instance,
+	}
+	for i := range instance.Status.Conditions {
+		if string(instance.Status.Conditions[i].Type) == string(event) {
+			payload.Condition = &instance.Status.Conditions[i]
+		}
+	}
+	return payload
+}
+
+// RenderPayload executes the payload template and checks that the result is valid JSON.
+// Payload is marshalled to JSON if the template is empty.
+func RenderPayload(payloadTemplate string, payload Payload) ([]byte, error) {
+	if payloadTemplate == "" {
+		return json.Marshal(payload)
+	}
+	tmpl, err := ParsePayloadTemplate(payloadTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, payload); err != nil {
+		return nil, fmt.Errorf("failed to execute payload template: %v", err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("payload template result is not valid JSON: %v", buf.String())
+	}
+	return buf.Bytes(), nil
+}
+
+// ParsePayloadTemplate parses the payload template.
+// Template can use json function to marshal the values, e.g. {{json .BestTrial}}.
+func ParsePayloadTemplate(payloadTemplate string) (*template.Template, error) {
+	tmpl, err := template.New("payload").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Option("missingkey=error").Parse(payloadTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payload template: %v", err)
+	}
+	return tmpl, nil
+}
+
+// NewSamplePayload creates the notification payload for the Experiment event with the sample condition
+// and best trial, so that the payload template can be validated before the Experiment runs.
+func NewSamplePayload(instance *experimentsv1beta1.Experiment, event experimentsv1beta1.NotificationEventType) Payload {
+	sample := instance.DeepCopy()
+	bestTrial := experimentsv1beta1.OptimalTrial{BestTrialName: sample.Name + "-sample"}
+	for _, p := range sample.Spec.Parameters {
+		value := p.FeasibleSpace.Min
+		if len(p.FeasibleSpace.List) > 0 {
+			value = p.FeasibleSpace.List[0]
+		}
+		bestTrial.ParameterAssignments = append(bestTrial.ParameterAssignments, common.ParameterAssignment{Name: p.Name, Value: value})
+	}
+	if sample.Spec.Objective != nil {
+		metricNames := append([]string{sample.Spec.Objective.ObjectiveMetricName}, sample.Spec.Objective.AdditionalMetricNames...)
+		for _, name := range metricNames {
+			bestTrial.Observation.Metrics = append(bestTrial.Observation.Metrics,
+				common.Metric{Name: name, Min: "0", Max: "0", Latest: "0", Value: "0"})
+		}
+	}
+	sample.Status.CurrentOptimalTrial = bestTrial
+	if event == experimentsv1beta1.NotificationEventSucceeded || event == experimentsv1beta1.NotificationEventFailed {
+		sample.Status.Conditions = append(sample.Status.Conditions, experimentsv1beta1.ExperimentCondition{
+			Type:    experimentsv1beta1.ExperimentConditionType(event),
+			Status:  corev1.ConditionTrue,
+			Reason:  "Sample",
+			Message: "Sample condition",
+		})
+	}
+	return NewPayload(sample, event)
+}
+
+// GetNotificationEvents returns the events the notification is subscribed to.
+// Notification is sent when the Experiment is completed if no events are set.
+func GetNotificationEvents(notification experimentsv1beta1.NotificationSpec) []experimentsv1beta1.NotificationEventType {
+	if len(notification.Events) == 0 {
+		return []experimentsv1beta1.NotificationEventType{experimentsv1beta1.NotificationEventSucceeded, experimentsv1beta1.NotificationEventFailed}
+	}
+	return notification.Events
+}
+
+func hasEvent(notification experimentsv1beta1.NotificationSpec, event experimentsv1beta1.NotificationEventType) bool {
+	for _, e := range GetNotificationEvents(notification) {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
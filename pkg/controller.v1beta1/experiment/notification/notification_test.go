@@ -0,0 +1,220 @@
+package notification
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+func newFakeExperiment() *experimentsv1beta1.Experiment {
+	return &experimentsv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "random-experiment",
+			Namespace: "kubeflow",
+		},
+		Status: experimentsv1beta1.ExperimentStatus{
+			CurrentOptimalTrial: experimentsv1beta1.OptimalTrial{
+				BestTrialName: "random-experiment-1",
+				ParameterAssignments: []commonv1beta1.ParameterAssignment{
+					{Name: "lr", Value: "0.01"},
+				},
+			},
+		},
+	}
+}
+
+func TestGetEvents(t *testing.T) {
+	running := newFakeExperiment()
+	running.MarkExperimentStatusRunning("ExperimentRunning", "Experiment is running")
+	succeeded := running.DeepCopy()
+	succeeded.MarkExperimentStatusSucceeded("ExperimentMaxTrialsReached", "Experiment has succeeded")
+	failed := running.DeepCopy()
+	failed.MarkExperimentStatusFailed("ExperimentFailed", "Experiment has failed")
+	newBestTrial := running.DeepCopy()
+	newBestTrial.Status.CurrentOptimalTrial.BestTrialName = "random-experiment-2"
+
+	tcs := []struct {
+		original        *experimentsv1beta1.Experiment
+		instance        *experimentsv1beta1.Experiment
+		expectedEvents  []experimentsv1beta1.NotificationEventType
+		testDescription string
+	}{
+		{
+			original:        running,
+			instance:        running,
+			expectedEvents:  nil,
+			testDescription: "Experiment status is not changed",
+		},
+		{
+			original:        running,
+			instance:        succeeded,
+			expectedEvents:  []experimentsv1beta1.NotificationEventType{experimentsv1beta1.NotificationEventSucceeded},
+			testDescription: "Experiment is succeeded",
+		},
+		{
+			original:        running,
+			instance:        failed,
+			expectedEvents:  []experimentsv1beta1.NotificationEventType{experimentsv1beta1.NotificationEventFailed},
+			testDescription: "Experiment is failed",
+		},
+		{
+			original:        running,
+			instance:        newBestTrial,
+			expectedEvents:  []experimentsv1beta1.NotificationEventType{experimentsv1beta1.NotificationEventNewBestTrial},
+			testDescription: "Experiment has new best trial",
+		},
+	}
+
+	for _, tc := range tcs {
+		events := GetEvents(tc.original, tc.instance)
+		if len(events) != len(tc.expectedEvents) {
+			t.Errorf("Case: %v failed. Expected events %v, got %v", tc.testDescription, tc.expectedEvents, events)
+			continue
+		}
+		for i := range events {
+			if events[i] != tc.expectedEvents[i] {
+				t.Errorf("Case: %v failed. Expected events %v, got %v", tc.testDescription, tc.expectedEvents, events)
+			}
+		}
+	}
+}
+
+func TestRenderPayload(t *testing.T) {
+	instance := newFakeExperiment()
+	instance.MarkExperimentStatusSucceeded("ExperimentMaxTrialsReached", "Experiment has succeeded")
+	payload := NewPayload(instance, experimentsv1beta1.NotificationEventSucceeded)
+
+	tcs := []struct {
+		payloadTemplate string
+		expected        map[string]interface{}
+		testDescription string
+	}{
+		{
+			payloadTemplate: "",
+			expected: map[string]interface{}{
+				"event":     "Succeeded",
+				"name":      "random-experiment",
+				"namespace": "kubeflow",
+			},
+			testDescription: "Default payload",
+		},
+		{
+			payloadTemplate: `{"text": "{{.Experiment.Name}} {{.Event}}: {{.Condition.Reason}}", "lr": {{json (index .BestTrial.ParameterAssignments 0).Value}}}`,
+			expected: map[string]interface{}{
+				"text": "random-experiment Succeeded: ExperimentMaxTrialsReached",
+				"lr":   "0.01",
+			},
+			testDescription: "Templated payload",
+		},
+	}
+
+	for _, tc := range tcs {
+		b, err := RenderPayload(tc.payloadTemplate, payload)
+		if err != nil {
+			t.Errorf("Case: %v failed. RenderPayload() returns error: %v", tc.testDescription, err)
+			continue
+		}
+		actual := map[string]interface{}{}
+		if err := json.Unmarshal(b, &actual); err != nil {
+			t.Errorf("Case: %v failed. Payload is not valid JSON: %v", tc.testDescription, err)
+		}
+		for key, value := range tc.expected {
+			if actual[key] != value {
+				t.Errorf("Case: %v failed. Expected %v = %v, got %v", tc.testDescription, key, value, actual[key])
+			}
+		}
+	}
+}
+
+func TestNotify(t *testing.T) {
+	tcs := []struct {
+		statusCodes      []int
+		maxRetries       int32
+		expectedRequests int
+		err              bool
+		testDescription  string
+	}{
+		{
+			statusCodes:      []int{http.StatusOK},
+			maxRetries:       3,
+			expectedRequests: 1,
+			err:              false,
+			testDescription:  "Webhook succeeds",
+		},
+		{
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:       3,
+			expectedRequests: 3,
+			err:              false,
+			testDescription:  "Webhook succeeds after retries",
+		},
+		{
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:       1,
+			expectedRequests: 2,
+			err:              true,
+			testDescription:  "Max retries is reached",
+		},
+		{
+			statusCodes:      []int{http.StatusBadRequest, http.StatusOK},
+			maxRetries:       3,
+			expectedRequests: 1,
+			err:              true,
+			testDescription:  "Client error is not retried",
+		},
+	}
+
+	for _, tc := range tcs {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			if r.Method != http.MethodPost || !json.Valid(body) {
+				t.Errorf("Case: %v failed. Expected POST with JSON payload, got %v %s", tc.testDescription, r.Method, body)
+			}
+			w.WriteHeader(tc.statusCodes[requests])
+			requests++
+		}))
+
+		n := &DefaultNotifier{
+			httpClient:     server.Client(),
+			initialBackoff: time.Millisecond,
+		}
+		maxRetries := tc.maxRetries
+		err := n.notify(newFakeExperiment(), experimentsv1beta1.NotificationSpec{
+			URL:        server.URL,
+			MaxRetries: &maxRetries,
+		}, experimentsv1beta1.NotificationEventSucceeded)
+		server.Close()
+
+		if requests != tc.expectedRequests {
+			t.Errorf("Case: %v failed. Expected %v requests, got %v", tc.testDescription, tc.expectedRequests, requests)
+		}
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
+func TestHasEvent(t *testing.T) {
+	notification := experimentsv1beta1.NotificationSpec{}
+	if !hasEvent(notification, experimentsv1beta1.NotificationEventFailed) {
+		t.Errorf("Expected Failed event by default")
+	}
+	if hasEvent(notification, experimentsv1beta1.NotificationEventNewBestTrial) {
+		t.Errorf("Expected no NewBestTrial event by default")
+	}
+	notification.Events = []experimentsv1beta1.NotificationEventType{experimentsv1beta1.NotificationEventNewBestTrial}
+	if !hasEvent(notification, experimentsv1beta1.NotificationEventNewBestTrial) {
+		t.Errorf("Expected NewBestTrial event")
+	}
+}
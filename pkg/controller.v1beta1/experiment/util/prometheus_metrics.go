@@ -18,8 +18,12 @@ package util
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 )
@@ -31,6 +35,10 @@ type ExperimentsCollector struct {
 	expSucceedCount *prometheus.CounterVec
 	expFailCount    *prometheus.CounterVec
 	expCurrent      *prometheus.GaugeVec
+	// Per-experiment metrics
+	expBestObjective            *prometheus.GaugeVec
+	expTrials                   *prometheus.GaugeVec
+	expSinceLastTrialCompletion *prometheus.GaugeVec
 }
 
 func NewExpsCollector(store cache.Cache, registerer prometheus.Registerer) *ExperimentsCollector {
@@ -60,6 +68,21 @@ func NewExpsCollector(store cache.Cache, registerer prometheus.Registerer) *Expe
 			Name: "katib_experiments_current",
 			Help: "The number of current katib experiments in the cluster",
 		}, []string{"namespace", "status"}),
+
+		expBestObjective: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "katib_experiment_best_objective_value",
+			Help: "The objective metric value of the current optimal trial of the experiment",
+		}, []string{"namespace", "experiment", "metric"}),
+
+		expTrials: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "katib_experiment_trials",
+			Help: "The number of trials of the experiment by status",
+		}, []string{"namespace", "experiment", "status"}),
+
+		expSinceLastTrialCompletion: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "katib_experiment_seconds_since_last_trial_completion",
+			Help: "The time since the last trial of the running experiment was completed, or since the experiment was started if no trial is completed",
+		}, []string{"namespace", "experiment"}),
 	}
 	registerer.MustRegister(c)
 	return c
@@ -72,6 +95,9 @@ func (m *ExperimentsCollector) Describe(ch chan<- *prometheus.Desc) {
 	m.expFailCount.Describe(ch)
 	m.expCreateCount.Describe(ch)
 	m.expCurrent.Describe(ch)
+	m.expBestObjective.Describe(ch)
+	m.expTrials.Describe(ch)
+	m.expSinceLastTrialCompletion.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface.
@@ -82,6 +108,9 @@ func (m *ExperimentsCollector) Collect(ch chan<- prometheus.Metric) {
 	m.expFailCount.Collect(ch)
 	m.expCreateCount.Collect(ch)
 	m.expCurrent.Collect(ch)
+	m.expBestObjective.Collect(ch)
+	m.expTrials.Collect(ch)
+	m.expSinceLastTrialCompletion.Collect(ch)
 }
 
 func (c *ExperimentsCollector) IncreaseExperimentsDeletedCount(ns string) {
@@ -133,4 +162,62 @@ func (c *ExperimentsCollector) collect() {
 			c.expCurrent.WithLabelValues(ns, status).Set(float64(count))
 		}
 	}
+
+	c.collectExperimentMetrics(expLists)
+}
+
+// collectExperimentMetrics sets per-experiment metrics from the experiments and trials in cache.
+func (c *ExperimentsCollector) collectExperimentMetrics(expLists *v1beta1.ExperimentList) {
+	trialLists := &trialsv1beta1.TrialList{}
+	if err := c.store.List(context.TODO(), nil, trialLists); err != nil {
+		return
+	}
+	// Map from namespace/experiment to the last completion time of its trials
+	lastCompletion := map[string]time.Time{}
+	for _, trial := range trialLists.Items {
+		if trial.Status.CompletionTime == nil {
+			continue
+		}
+		key := trial.Namespace + "/" + trial.Labels[consts.LabelExperimentName]
+		if completionTime := trial.Status.CompletionTime.Time; completionTime.After(lastCompletion[key]) {
+			lastCompletion[key] = completionTime
+		}
+	}
+
+	c.expBestObjective.Reset()
+	c.expTrials.Reset()
+	c.expSinceLastTrialCompletion.Reset()
+	now := time.Now()
+	for _, exp := range expLists.Items {
+		sts := exp.Status
+		trialsByStatus := map[string]int32{
+			"Pending":   sts.TrialsPending,
+			"Running":   sts.TrialsRunning,
+			"Succeeded": sts.TrialsSucceeded,
+			"Failed":    sts.TrialsFailed,
+			"Killed":    sts.TrialsKilled,
+		}
+		for status, count := range trialsByStatus {
+			c.expTrials.WithLabelValues(exp.Namespace, exp.Name, status).Set(float64(count))
+		}
+
+		if sts.CurrentOptimalTrial.BestTrialName != "" && exp.Spec.Objective != nil {
+			valueStr := GetObjectiveMetricValue(exp.Spec.Objective, &sts.CurrentOptimalTrial.Observation)
+			if value, err := strconv.ParseFloat(valueStr, 64); err == nil {
+				c.expBestObjective.WithLabelValues(exp.Namespace, exp.Name, exp.Spec.Objective.ObjectiveMetricName).Set(value)
+			}
+		}
+
+		if exp.IsCompleted() {
+			continue
+		}
+		last, ok := lastCompletion[exp.Namespace+"/"+exp.Name]
+		if !ok {
+			if sts.StartTime == nil {
+				continue
+			}
+			last = sts.StartTime.Time
+		}
+		c.expSinceLastTrialCompletion.WithLabelValues(exp.Namespace, exp.Name).Set(now.Sub(last).Seconds())
+	}
 }
@@ -25,6 +25,7 @@ import (
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -66,7 +67,7 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 	}
 	objectiveType := instance.Spec.Objective.Type
 
-	for index, trial := range trials.Items {
+	for _, trial := range trials.Items {
 		sts.Trials++
 		if trial.IsKilled() {
 			sts.KilledTrialList = append(sts.KilledTrialList, trial.Name)
@@ -79,7 +80,24 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 		} else {
 			sts.PendingTrialList = append(sts.PendingTrialList, trial.Name)
 		}
+	}
 
+	// Repeated trials are compared by the aggregated observation when all repetitions are completed
+	candidates := trials.Items
+	var groups [][]trialsv1beta1.Trial
+	if util.GetRepetitionCount(instance) > 1 {
+		candidates = nil
+		aggregation := util.GetRepetitionAggregation(instance)
+		for _, group := range util.GroupRepeatedTrials(trials.Items) {
+			trial := util.AggregateTrialGroup(group, aggregation)
+			if trial.IsCompleted() {
+				candidates = append(candidates, *trial)
+				groups = append(groups, group)
+			}
+		}
+	}
+
+	for index, trial := range candidates {
 		objectiveMetricValueStr := getObjectiveMetricValue(trial)
 		if objectiveMetricValueStr == consts.UnavailableMetricValue {
 			continue
@@ -125,7 +143,7 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 
 	// if best trial is set
 	if bestTrialIndex != -1 {
-		bestTrial := trials.Items[bestTrialIndex]
+		bestTrial := candidates[bestTrialIndex]
 
 		sts.CurrentOptimalTrial.BestTrialName = bestTrial.Name
 		sts.CurrentOptimalTrial.ParameterAssignments = []commonv1beta1.ParameterAssignment{}
@@ -137,23 +155,41 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 		for _, metric := range bestTrial.Status.Observation.Metrics {
 			sts.CurrentOptimalTrial.Observation.Metrics = append(sts.CurrentOptimalTrial.Observation.Metrics, metric)
 		}
+
+		sts.CurrentOptimalTrial.Artifacts = nil
+		for _, artifact := range bestTrial.Status.Artifacts {
+			sts.CurrentOptimalTrial.Artifacts = append(sts.CurrentOptimalTrial.Artifacts, *artifact.DeepCopy())
+		}
+
+		sts.CurrentOptimalTrial.RepetitionTrialNames = nil
+		if groups != nil {
+			for _, trial := range groups[bestTrialIndex] {
+				sts.CurrentOptimalTrial.RepetitionTrialNames = append(sts.CurrentOptimalTrial.RepetitionTrialNames, trial.Name)
+			}
+		}
 	}
 	return isObjectiveGoalReached
 }
 
 func getObjectiveMetricValue(trial trialsv1beta1.Trial) string {
-	if trial.Status.Observation == nil {
+	return GetObjectiveMetricValue(trial.Spec.Objective, trial.Status.Observation)
+}
+
+// GetObjectiveMetricValue returns the objective metric value from the observation
+// according to the objective metric strategy.
+func GetObjectiveMetricValue(objective *commonv1beta1.ObjectiveSpec, observation *commonv1beta1.Observation) string {
+	if objective == nil || observation == nil {
 		return consts.UnavailableMetricValue
 	}
 	var objectiveStrategy commonv1beta1.MetricStrategyType
-	objectiveMetricName := trial.Spec.Objective.ObjectiveMetricName
-	for _, strategy := range trial.Spec.Objective.MetricStrategies {
+	objectiveMetricName := objective.ObjectiveMetricName
+	for _, strategy := range objective.MetricStrategies {
 		if strategy.Name == objectiveMetricName {
 			objectiveStrategy = strategy.Value
 			break
 		}
 	}
-	for _, metric := range trial.Status.Observation.Metrics {
+	for _, metric := range observation.Metrics {
 		if objectiveMetricName == metric.Name {
 			switch objectiveStrategy {
 			case commonv1beta1.ExtractByMin:
@@ -168,6 +204,11 @@ func getObjectiveMetricValue(trial trialsv1beta1.Trial) string {
 				return metric.Max
 			case commonv1beta1.ExtractByLatest:
 				return metric.Latest
+			case commonv1beta1.ExtractByAvg, commonv1beta1.ExtractByLastNAvg, commonv1beta1.ExtractByMedian, commonv1beta1.ExtractByPercentile:
+				if metric.Value == "" || metric.Value == consts.UnavailableMetricValue {
+					return metric.Latest
+				}
+				return metric.Value
 			}
 		}
 	}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
@@ -61,6 +62,11 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 		return nil, err
 	}
 
+	grpcTLSConfigData, err := katibconfig.GetGRPCTLSConfigData(g.Client)
+	if err != nil {
+		return nil, err
+	}
+
 	d := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        util.GetAlgorithmDeploymentName(s),
@@ -79,7 +85,7 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 				},
 				Spec: corev1.PodSpec{
 					Containers: []corev1.Container{
-						*g.desiredContainer(s, suggestionConfigData),
+						*g.desiredContainer(s, suggestionConfigData, grpcTLSConfigData),
 					},
 				},
 			},
@@ -105,6 +111,9 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 		}
 	}
 
+	// Mount gRPC TLS Secret to the suggestion container if TLS is configured
+	util.AppendGRPCTLSServerVolume(&d.Spec.Template.Spec, &d.Spec.Template.Spec.Containers[0], grpcTLSConfigData)
+
 	if err := controllerutil.SetControllerReference(s, d, g.scheme); err != nil {
 		return nil, err
 	}
@@ -141,7 +150,8 @@ func (g *General) DesiredService(s *suggestionsv1beta1.Suggestion) (*corev1.Serv
 	return service, nil
 }
 
-func (g *General) desiredContainer(s *suggestionsv1beta1.Suggestion, suggestionConfigData katibconfig.SuggestionConfig) *corev1.Container {
+func (g *General) desiredContainer(s *suggestionsv1beta1.Suggestion, suggestionConfigData katibconfig.SuggestionConfig,
+	grpcTLSConfigData katibconfig.GRPCTLSConfig) *corev1.Container {
 
 	c := &corev1.Container{
 		Name:            consts.ContainerSuggestion,
@@ -157,14 +167,11 @@ func (g *General) desiredContainer(s *suggestionsv1beta1.Suggestion, suggestionC
 	}
 
 	if viper.GetBool(consts.ConfigEnableGRPCProbeInSuggestion) {
+		probeCommand := desiredProbeCommand(grpcTLSConfigData)
 		c.ReadinessProbe = &corev1.Probe{
 			Handler: corev1.Handler{
 				Exec: &corev1.ExecAction{
-					Command: []string{
-						defaultGRPCHealthCheckProbe,
-						fmt.Sprintf("-addr=:%d", consts.DefaultSuggestionPort),
-						fmt.Sprintf("-service=%s", consts.DefaultGRPCService),
-					},
+					Command: probeCommand,
 				},
 			},
 			InitialDelaySeconds: defaultInitialDelaySeconds,
@@ -173,11 +180,7 @@ func (g *General) desiredContainer(s *suggestionsv1beta1.Suggestion, suggestionC
 		c.LivenessProbe = &corev1.Probe{
 			Handler: corev1.Handler{
 				Exec: &corev1.ExecAction{
-					Command: []string{
-						defaultGRPCHealthCheckProbe,
-						fmt.Sprintf("-addr=:%d", consts.DefaultSuggestionPort),
-						fmt.Sprintf("-service=%s", consts.DefaultGRPCService),
-					},
+					Command: probeCommand,
 				},
 			},
 			// Ref https://srcco.de/posts/kubernetes-liveness-probes-are-dangerous.html
@@ -199,6 +202,27 @@ func (g *General) desiredContainer(s *suggestionsv1beta1.Suggestion, suggestionC
 	return c
 }
 
+// desiredProbeCommand returns the gRPC health probe command for the suggestion container.
+// Probe connects to the local port, so the server certificate is not verified.
+func desiredProbeCommand(grpcTLSConfigData katibconfig.GRPCTLSConfig) []string {
+	command := []string{
+		defaultGRPCHealthCheckProbe,
+		fmt.Sprintf("-addr=:%d", consts.DefaultSuggestionPort),
+		fmt.Sprintf("-service=%s", consts.DefaultGRPCService),
+	}
+	if grpcTLSConfigData.SuggestionSecretName != "" {
+		command = append(command, "-tls", "-tls-no-verify")
+		if grpcTLSConfigData.ClientAuth {
+			certConfig := grpctls.ConfigFromDir(consts.DefaultGRPCTLSClientMountPath, true)
+			command = append(command,
+				fmt.Sprintf("-tls-client-cert=%s", certConfig.CertFile),
+				fmt.Sprintf("-tls-client-key=%s", certConfig.KeyFile),
+			)
+		}
+	}
+	return command
+}
+
 // DesiredVolume returns desired PVC and PV for suggestion.
 // If StorageClassName != DefaultSuggestionStorageClassName returns only PVC.
 func (g *General) DesiredVolume(s *suggestionsv1beta1.Suggestion) (*corev1.PersistentVolumeClaim, *corev1.PersistentVolume, error) {
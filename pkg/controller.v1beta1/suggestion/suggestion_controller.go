@@ -19,6 +19,7 @@ package suggestion
 import (
 	"context"
 	"fmt"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -31,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -41,7 +43,10 @@ import (
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/suggestion/composer"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/suggestion/suggestionclient"
+	suggestionutil "github.com/kubeflow/katib/pkg/controller.v1beta1/suggestion/util"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/suggestion/v1beta1/inprocess"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
 const (
@@ -64,6 +69,7 @@ func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 		scheme:           mgr.GetScheme(),
 		Composer:         composer.New(mgr),
 		recorder:         mgr.GetRecorder(ControllerName),
+		collector:        suggestionutil.NewSuggestionsCollector(mgr.GetCache(), metrics.Registry),
 	}
 }
 
@@ -118,6 +124,8 @@ type ReconcileSuggestion struct {
 
 	scheme   *runtime.Scheme
 	recorder record.EventRecorder
+	// collector is a wrapper for suggestion metrics.
+	collector *suggestionutil.SuggestionsCollector
 }
 
 // Reconcile reads that state of the cluster for a Suggestion object and makes changes based on the state read
@@ -137,6 +145,7 @@ func (r *ReconcileSuggestion) Reconcile(request reconcile.Request) (reconcile.Re
 	if err != nil {
 		if errors.IsNotFound(err) {
 			// For additional cleanup logic use finalizers.
+			inprocess.DeleteExperiment(request.Namespace, request.Name)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -145,6 +154,13 @@ func (r *ReconcileSuggestion) Reconcile(request reconcile.Request) (reconcile.Re
 	instance := oldS.DeepCopy()
 	// Suggestion will be succeeded if ResumePolicy = Never or ResumePolicy = FromVolume
 	if instance.IsSucceeded() {
+		if instance.Status.Endpoint == consts.SuggestionInProcessEndpoint {
+			inprocess.DeleteExperiment(instance.Namespace, instance.Name)
+		}
+		// Shared algorithm service is not deleted with the Suggestion.
+		if util.IsSharedAlgorithmService(instance) {
+			return reconcile.Result{}, nil
+		}
 		err = r.deleteDeployment(instance, request.NamespacedName)
 		if err != nil {
 			return reconcile.Result{}, err
@@ -191,6 +207,35 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 	suggestionNsName := types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}
 	logger := log.WithValues("Suggestion", suggestionNsName)
 
+	suggestionConfigData, err := katibconfig.GetSuggestionConfigData(instance.Spec.AlgorithmName, r.Client)
+	if err != nil {
+		return err
+	}
+	if suggestionConfigData.InProcess {
+		// Algorithm runs inside katib-controller, Deployment and Service are not created.
+		if !inprocess.IsRegistered(instance.Spec.AlgorithmName) {
+			return fmt.Errorf("Algorithm %v can't run in-process", instance.Spec.AlgorithmName)
+		}
+		instance.Status.Endpoint = consts.SuggestionInProcessEndpoint
+		if !instance.IsDeploymentReady() {
+			logger.Info("Using in-process algorithm service")
+			msg := "In-process algorithm service is used"
+			instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionTrue, SuggestionInProcessReason, msg)
+		}
+		return r.syncSuggestion(instance)
+	}
+	if suggestionConfigData.Endpoint != "" {
+		// Shared algorithm service serves many Experiments, Deployment and Service are not created.
+		instance.Status.Endpoint = util.GetSharedAlgorithmEndpoint(suggestionConfigData.Endpoint, instance)
+		if !instance.IsDeploymentReady() {
+			logger.Info("Using shared algorithm service", "endpoint", instance.Status.Endpoint)
+			msg := "Shared algorithm service is used"
+			instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionTrue, SuggestionSharedServiceReason, msg)
+		}
+		return r.syncSuggestion(instance)
+	}
+	instance.Status.Endpoint = ""
+
 	// If ResumePolicy = FromVolume volume is reconciled for suggestion
 	if instance.Spec.ResumePolicy == experimentsv1beta1.FromVolume {
 		pvc, pv, err := r.DesiredVolume(instance)
@@ -233,6 +278,12 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 		}
 
 	}
+	return r.syncSuggestion(instance)
+}
+
+// syncSuggestion validates algorithm settings and syncs assignments from the algorithm service.
+func (r *ReconcileSuggestion) syncSuggestion(instance *suggestionsv1beta1.Suggestion) error {
+	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	experiment := &experimentsv1beta1.Experiment{}
 	trials := &trialsv1beta1.TrialList{}
 
@@ -250,7 +301,7 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 	// TODO (andreyvelich): Do we want to run ValidateAlgorithmSettings when Experiment is restarting?
 	// Currently it is running.
 	if !instance.IsRunning() {
-		if err = r.ValidateAlgorithmSettings(instance, experiment); err != nil {
+		if err := r.ValidateAlgorithmSettings(instance, experiment); err != nil {
 			logger.Error(err, "Marking suggestion failed as algorithm settings validation failed")
 			msg := fmt.Sprintf("Validation failed: %v", err)
 			instance.MarkSuggestionStatusFailed(SuggestionFailedReason, msg)
@@ -262,9 +313,14 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 	}
 	logger.Info("Sync assignments", "Suggestion Requests", instance.Spec.Requests,
 		"Suggestion Count", instance.Status.SuggestionCount)
-	if err = r.SyncAssignments(instance, experiment, trials.Items); err != nil {
+	requestSuggestions := instance.Spec.Requests > instance.Status.SuggestionCount
+	startTime := time.Now()
+	if err := r.SyncAssignments(instance, experiment, trials.Items); err != nil {
 		return err
 	}
+	if requestSuggestions {
+		r.collector.ObserveSuggestionDuration(instance, time.Since(startTime))
+	}
 
 	return nil
 }
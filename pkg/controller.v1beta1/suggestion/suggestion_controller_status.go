@@ -8,13 +8,15 @@ import (
 )
 
 const (
-	SuggestionCreatedReason      = "SuggestionCreated"
-	SuggestionDeploymentReady    = "DeploymentReady"
-	SuggestionDeploymentNotReady = "DeploymentNotReady"
-	SuggestionRunningReason      = "SuggestionRunning"
-	SuggestionSucceededReason    = "SuggestionSucceeded"
-	SuggestionFailedReason       = "SuggestionFailed"
-	SuggestionKilledReason       = "SuggestionKilled"
+	SuggestionCreatedReason       = "SuggestionCreated"
+	SuggestionDeploymentReady     = "DeploymentReady"
+	SuggestionDeploymentNotReady  = "DeploymentNotReady"
+	SuggestionSharedServiceReason = "SharedServiceUsed"
+	SuggestionInProcessReason     = "InProcessServiceUsed"
+	SuggestionRunningReason       = "SuggestionRunning"
+	SuggestionSucceededReason     = "SuggestionSucceeded"
+	SuggestionFailedReason        = "SuggestionFailed"
+	SuggestionKilledReason        = "SuggestionKilled"
 )
 
 func (r *ReconcileSuggestion) updateStatus(s *suggestionsv1beta1.Suggestion, oldS *suggestionsv1beta1.Suggestion) error {
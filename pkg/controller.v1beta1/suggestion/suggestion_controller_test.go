@@ -24,6 +24,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/net/context"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -38,6 +39,7 @@ import (
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/suggestion/composer"
+	suggestionutil "github.com/kubeflow/katib/pkg/controller.v1beta1/suggestion/util"
 	suggestionclientmock "github.com/kubeflow/katib/pkg/mock/v1beta1/suggestion/suggestionclient"
 )
 
@@ -81,6 +83,7 @@ func TestReconcile(t *testing.T) {
 		SuggestionClient: mockSuggestionClient,
 		Composer:         composer.New(mgr),
 		recorder:         mgr.GetRecorder(ControllerName),
+		collector:        suggestionutil.NewSuggestionsCollector(mgr.GetCache(), prometheus.NewRegistry()),
 	}
 
 	recFn := SetupTestReconcile(r)
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpcpool"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -119,6 +121,8 @@ func (r *ReconcileSuggestion) deleteService(instance *v1beta1.Suggestion, sugges
 	if err != nil {
 		return err
 	}
+	// Close the cached connection to the deleted Service
+	grpcpool.Remove(util.GetAlgorithmEndpoint(instance))
 
 	return nil
 }
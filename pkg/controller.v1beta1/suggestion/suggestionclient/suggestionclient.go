@@ -21,6 +21,8 @@ import (
 	suggestionapi "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/suggestion/v1beta1/inprocess"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpcpool"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -62,13 +64,12 @@ func (g *General) SyncAssignments(
 	}
 
 	endpoint := util.GetAlgorithmEndpoint(instance)
-	conn, err := grpc.Dial(endpoint, grpc.WithInsecure())
+	rpcClient, release, err := newRPCClient(instance)
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
+	defer release()
 
-	rpcClient := getRPCClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -77,9 +78,10 @@ func (g *General) SyncAssignments(
 	appendAlgorithmSettingsFromSuggestion(filledE,
 		instance.Status.AlgorithmSettings)
 
+	// Repeated trials are reported as one trial with the aggregated observation
 	request := &suggestionapi.GetSuggestionsRequest{
 		Experiment:    g.ConvertExperiment(filledE),
-		Trials:        g.ConvertTrials(ts),
+		Trials:        g.ConvertTrials(util.AggregateRepeatedTrials(e, ts)),
 		RequestNumber: int32(requestNum),
 	}
 
@@ -111,22 +113,13 @@ func (g *General) SyncAssignments(
 // ValidateAlgorithmSettings validates if the algorithm specific configurations are valid.
 func (g *General) ValidateAlgorithmSettings(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment) error {
 	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
-	endpoint := util.GetAlgorithmEndpoint(instance)
 
-	callOpts := []grpc_retry.CallOption{
-		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(consts.DefaultGRPCRetryPeriod)),
-		grpc_retry.WithMax(consts.DefaultGRPCRetryAttempts),
-	}
-	conn, err := grpc.Dial(endpoint, grpc.WithInsecure(),
-		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(callOpts...)),
-		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(callOpts...)),
-	)
+	rpcClient, release, err := newRPCClient(instance)
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
+	defer release()
 
-	rpcClient := getRPCClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -136,7 +129,10 @@ func (g *General) ValidateAlgorithmSettings(instance *suggestionsv1beta1.Suggest
 
 	// See https://github.com/grpc/grpc-go/issues/2636
 	// See https://github.com/grpc/grpc-go/pull/2503
-	_, err = rpcClient.ValidateAlgorithmSettings(ctx, request, grpc.WaitForReady(true))
+	_, err = rpcClient.ValidateAlgorithmSettings(ctx, request, grpc.WaitForReady(true),
+		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(consts.DefaultGRPCRetryPeriod)),
+		grpc_retry.WithMax(consts.DefaultGRPCRetryAttempts),
+	)
 	statusCode, _ := status.FromError(err)
 
 	// validation error
@@ -160,10 +156,29 @@ func (g *General) ValidateAlgorithmSettings(instance *suggestionsv1beta1.Suggest
 	return nil
 }
 
+// newRPCClient returns the client of the Suggestion algorithm service and the function to release it.
+// Connection to the algorithm service is reused from the connection pool.
+// In-process algorithm service is called directly without connection.
+func newRPCClient(instance *suggestionsv1beta1.Suggestion) (suggestionapi.SuggestionClient, func(), error) {
+	endpoint := util.GetAlgorithmEndpoint(instance)
+	if endpoint == consts.SuggestionInProcessEndpoint {
+		client, err := inprocess.NewClient(instance.Spec.AlgorithmName)
+		return client, func() {}, err
+	}
+
+	conn, release, err := grpcpool.Get(endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	return getRPCClient(conn), release, nil
+}
+
 // ConvertExperiment converts CRD to the GRPC definition.
 func (g *General) ConvertExperiment(e *experimentsv1beta1.Experiment) *suggestionapi.Experiment {
 	res := &suggestionapi.Experiment{}
 	res.Name = e.Name
+	res.Namespace = e.Namespace
+	res.Uid = string(e.UID)
 	res.Spec = &suggestionapi.ExperimentSpec{
 		Algorithm: &suggestionapi.AlgorithmSpec{
 			AlgorithmName:     e.Spec.Algorithm.AlgorithmName,
@@ -293,6 +308,12 @@ func convertTrialObservation(strategies []commonapiv1beta1.MetricStrategy, obser
 				}
 			case commonapiv1beta1.ExtractByLatest:
 				value = m.Latest
+			case commonapiv1beta1.ExtractByAvg, commonapiv1beta1.ExtractByLastNAvg, commonapiv1beta1.ExtractByMedian, commonapiv1beta1.ExtractByPercentile:
+				if m.Value == "" || m.Value == consts.UnavailableMetricValue {
+					value = m.Latest
+				} else {
+					value = m.Value
+				}
 			}
 			resObservation.Metrics = append(resObservation.Metrics, &suggestionapi.Metric{
 				Name:  m.Name,
@@ -392,6 +413,21 @@ func convertFeasibleSpace(fs experimentsv1beta1.FeasibleSpace) *suggestionapi.Fe
 		Min:  fs.Min,
 		List: fs.List,
 		Step: fs.Step,
+
+		Distribution: convertDistribution(fs.Distribution),
 	}
 	return res
 }
+
+func convertDistribution(d experimentsv1beta1.Distribution) suggestionapi.Distribution {
+	switch d {
+	case experimentsv1beta1.DistributionLogUniform:
+		return suggestionapi.Distribution_LOG_UNIFORM
+	case experimentsv1beta1.DistributionQLogUniform:
+		return suggestionapi.Distribution_QLOG_UNIFORM
+	case experimentsv1beta1.DistributionNormal:
+		return suggestionapi.Distribution_NORMAL
+	default:
+		return suggestionapi.Distribution_UNIFORM
+	}
+}
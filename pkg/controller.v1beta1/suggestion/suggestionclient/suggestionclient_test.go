@@ -520,6 +520,7 @@ func newFakeExperiment() *experimentsv1beta1.Experiment {
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "experiment-name",
 			Namespace: "namespace",
+			UID:       "experiment-uid",
 		},
 		Spec: experimentsv1beta1.ExperimentSpec{
 			ParallelTrialCount: &testInt,
@@ -682,7 +683,9 @@ func newFakeRequest() *suggestionapi.GetSuggestionsRequest {
 
 	return &suggestionapi.GetSuggestionsRequest{
 		Experiment: &suggestionapi.Experiment{
-			Name: "experiment-name",
+			Name:      "experiment-name",
+			Namespace: "namespace",
+			Uid:       "experiment-uid",
 			Spec: &suggestionapi.ExperimentSpec{
 				Algorithm: &suggestionapi.AlgorithmSpec{
 					AlgorithmName: "algorithm-name",
@@ -0,0 +1,59 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"time"
+
+	"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+// SuggestionsCollector collects metrics of the Suggestions.
+type SuggestionsCollector struct {
+	suggestionDuration *util.ExperimentHistogramVec
+}
+
+// NewSuggestionsCollector creates a new SuggestionsCollector and registers it.
+func NewSuggestionsCollector(store cache.Cache, registerer prometheus.Registerer) *SuggestionsCollector {
+	c := &SuggestionsCollector{
+		suggestionDuration: util.NewExperimentHistogramVec(store, prometheus.HistogramOpts{
+			Name:    "katib_suggestion_duration_seconds",
+			Help:    "The time to get new suggestions from the algorithm service of the experiment",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 15),
+		}, "algorithm"),
+	}
+	registerer.MustRegister(c)
+	return c
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *SuggestionsCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.suggestionDuration.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *SuggestionsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.suggestionDuration.Collect(ch)
+}
+
+// ObserveSuggestionDuration observes the time to get suggestions for the Suggestion.
+// Suggestion name is the same as the Experiment name.
+func (c *SuggestionsCollector) ObserveSuggestionDuration(s *v1beta1.Suggestion, duration time.Duration) {
+	c.suggestionDuration.Observe(s.Namespace, s.Name, duration.Seconds(), s.Spec.AlgorithmName)
+}
@@ -12,6 +12,10 @@ type ManagerClient interface {
 		instance *trialsv1beta1.Trial) (*api_pb.GetObservationLogReply, error)
 	DeleteTrialObservationLog(
 		instance *trialsv1beta1.Trial) (*api_pb.DeleteObservationLogReply, error)
+	ReportTrialLogs(
+		instance *trialsv1beta1.Trial, logs string) (*api_pb.ReportTrialLogsReply, error)
+	GetTrialArtifacts(
+		instance *trialsv1beta1.Trial) (*api_pb.GetArtifactsReply, error)
 }
 
 // DefaultClient implements the Client interface.
@@ -29,6 +33,8 @@ func (d *DefaultClient) GetTrialObservationLog(
 	objectiveMetricName := instance.Spec.Objective.ObjectiveMetricName
 	request := &api_pb.GetObservationLogRequest{
 		TrialName:  instance.Name,
+		Namespace:  instance.Namespace,
+		TrialUid:   string(instance.UID),
 		MetricName: objectiveMetricName,
 	}
 	reply, err := common.GetObservationLog(request)
@@ -45,7 +51,10 @@ func (d *DefaultClient) GetTrialObservationLog(
 	metricLogs := reply.ObservationLog.MetricLogs
 	for _, metricName := range instance.Spec.Objective.AdditionalMetricNames {
 		request := &api_pb.GetObservationLogRequest{
-			TrialName: instance.Name, MetricName: metricName,
+			TrialName:  instance.Name,
+			Namespace:  instance.Namespace,
+			TrialUid:   string(instance.UID),
+			MetricName: metricName,
 		}
 		reply, err := common.GetObservationLog(request)
 		if err != nil {
@@ -67,6 +76,8 @@ func (d *DefaultClient) DeleteTrialObservationLog(
 	instance *trialsv1beta1.Trial) (*api_pb.DeleteObservationLogReply, error) {
 	request := &api_pb.DeleteObservationLogRequest{
 		TrialName: instance.Name,
+		Namespace: instance.Namespace,
+		TrialUid:  string(instance.UID),
 	}
 	reply, err := common.DeleteObservationLog(request)
 	if err != nil {
@@ -74,3 +85,32 @@ func (d *DefaultClient) DeleteTrialObservationLog(
 	}
 	return reply, nil
 }
+
+func (d *DefaultClient) ReportTrialLogs(
+	instance *trialsv1beta1.Trial, logs string) (*api_pb.ReportTrialLogsReply, error) {
+	request := &api_pb.ReportTrialLogsRequest{
+		TrialName: instance.Name,
+		Namespace: instance.Namespace,
+		TrialUid:  string(instance.UID),
+		Logs:      logs,
+	}
+	reply, err := common.ReportTrialLogs(request)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (d *DefaultClient) GetTrialArtifacts(
+	instance *trialsv1beta1.Trial) (*api_pb.GetArtifactsReply, error) {
+	request := &api_pb.GetArtifactsRequest{
+		TrialName: instance.Name,
+		Namespace: instance.Namespace,
+		TrialUid:  string(instance.UID),
+	}
+	reply, err := common.GetArtifacts(request)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -77,6 +78,12 @@ func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 		recorder:      mgr.GetRecorder(ControllerName),
 		collector:     trialutil.NewTrialsCollector(mgr.GetCache(), metrics.Registry),
 	}
+	kubeClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		log.Error(err, "Create Kubernetes client error, Trial logs are not reported")
+	} else {
+		r.kubeClient = kubeClient
+	}
 	r.updateStatusHandler = r.updateStatus
 	return r
 }
@@ -175,6 +182,8 @@ type ReconcileTrial struct {
 	updateStatusHandler updateStatusFunc
 	// collector is a wrapper for experiment metrics.
 	collector *trialutil.TrialsCollector
+	// kubeClient is used to get the logs of the failed Trial pods.
+	kubeClient kubernetes.Interface
 }
 
 // Reconcile reads that state of the cluster for a Trial object and makes changes based on the state read
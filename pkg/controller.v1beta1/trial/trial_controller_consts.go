@@ -1,5 +1,7 @@
 package trial
 
+import "time"
+
 const (
 	DefaultJobKind = "Job"
 
@@ -19,4 +21,7 @@ const (
 	JobFailedReason             = "JobFailed"
 	JobRunningReason            = "JobRunning"
 	ReconcileFailedReason       = "ReconcileFailed"
+
+	// trialLogsTimeout is the timeout to read the Trial logs which are stored in DB
+	trialLogsTimeout = 10 * time.Second
 )
@@ -124,6 +124,7 @@ func TestReconcileTFJob(t *testing.T) {
 
 	mockManagerClient.EXPECT().GetTrialObservationLog(gomock.Any()).Return(observationLog, nil).AnyTimes()
 	mockManagerClient.EXPECT().DeleteTrialObservationLog(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockManagerClient.EXPECT().GetTrialArtifacts(gomock.Any()).Return(&api_pb.GetArtifactsReply{}, nil).AnyTimes()
 
 	// Test - Regural Trial run with TFJob
 	trial := newFakeTrial(newFakeTFJob())
@@ -248,6 +249,7 @@ func TestReconcileBatchJob(t *testing.T) {
 
 	mockManagerClient.EXPECT().GetTrialObservationLog(gomock.Any()).Return(observationLog, nil).AnyTimes()
 	mockManagerClient.EXPECT().DeleteTrialObservationLog(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockManagerClient.EXPECT().GetTrialArtifacts(gomock.Any()).Return(&api_pb.GetArtifactsReply{}, nil).AnyTimes()
 
 	// Test 1 - Regural Trial run with BatchJob
 	trial := newFakeTrial(newFakeBatchJob())
@@ -387,6 +389,25 @@ func TestGetObjectiveMetricValue(t *testing.T) {
 	g.Expect(accMetric.Max).To(gomega.Equal("0.72"))
 	g.Expect(accMetric.Min).To(gomega.Equal("0.6"))
 
+	percentile := 100.0
+	metricStrategies = []commonv1beta1.MetricStrategy{
+		{Name: "error", Value: commonv1beta1.ExtractByMedian},
+		{Name: "accuracy", Value: commonv1beta1.ExtractByPercentile, Percentile: &percentile},
+	}
+	errMetric, accMetric, err = getMetricsFromLogs(metricStrategies)
+	g.Expect(err).ShouldNot(gomega.HaveOccurred())
+	g.Expect(errMetric.Value).To(gomega.Equal("0.05"))
+	g.Expect(accMetric.Value).To(gomega.Equal("0.72"))
+
+	metricStrategies = []commonv1beta1.MetricStrategy{
+		{Name: "error", Value: commonv1beta1.ExtractByLastNAvg, LastN: 1},
+		{Name: "accuracy", Value: commonv1beta1.ExtractByLatest},
+	}
+	errMetric, accMetric, err = getMetricsFromLogs(metricStrategies)
+	g.Expect(err).ShouldNot(gomega.HaveOccurred())
+	g.Expect(errMetric.Value).To(gomega.Equal("0.07"))
+	g.Expect(accMetric.Value).To(gomega.BeEmpty())
+
 	invalidLogs := []*api_pb.MetricLog{
 		// Add one other metric to test correct parsing
 		{TimeStamp: "2020-08-10T14:47:42+08:00", Metric: &api_pb.Metric{Name: "not-accuracy", Value: "1.15"}},
@@ -397,6 +418,46 @@ func TestGetObjectiveMetricValue(t *testing.T) {
 	g.Expect(err).To(gomega.HaveOccurred())
 }
 
+func TestTailBuffer(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	buf := &tailBuffer{limit: 8}
+	for _, line := range []string{"epoch 1\n", "epoch 2\n", "error\n"} {
+		n, err := buf.Write([]byte(line))
+		g.Expect(err).ShouldNot(gomega.HaveOccurred())
+		g.Expect(n).To(gomega.Equal(len(line)))
+	}
+	g.Expect(buf.String()).To(gomega.Equal(" 2\nerror\n"))
+
+	// Partial UTF-8 character at the beginning is skipped
+	buf = &tailBuffer{limit: 4}
+	buf.Write([]byte("ошибка"))
+	g.Expect(buf.String()).To(gomega.Equal("ка"))
+}
+
+func TestGetTrialLogsPod(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Minute))
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "failed-pod", CreationTimestamp: now},
+			Status:     corev1.PodStatus{Phase: corev1.PodFailed},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "latest-pod", CreationTimestamp: later},
+			Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+		},
+	}
+	g.Expect(getTrialLogsPod(pods).Name).To(gomega.Equal("failed-pod"))
+
+	pods[0].Status.Phase = corev1.PodSucceeded
+	g.Expect(getTrialLogsPod(pods).Name).To(gomega.Equal("latest-pod"))
+
+	g.Expect(getTrialLogsPod(nil)).To(gomega.BeNil())
+}
+
 func newFakeTFJob() *tfv1.TFJob {
 	return &tfv1.TFJob{
 		TypeMeta: metav1.TypeMeta{
@@ -18,8 +18,11 @@ package trial
 import (
 	"context"
 	"fmt"
+	"io"
+	"sort"
 	"strconv"
 	"time"
+	"unicode/utf8"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,11 +31,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	trialutil "github.com/kubeflow/katib/pkg/controller.v1beta1/trial/util"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	jobv1beta1 "github.com/kubeflow/katib/pkg/job/v1beta1"
 	commonv1 "github.com/kubeflow/tf-operator/pkg/apis/common/v1"
+	"github.com/spf13/viper"
 )
 
 const (
@@ -65,6 +72,7 @@ func (r *ReconcileTrial) UpdateTrialStatusCondition(instance *trialsv1beta1.Tria
 			eventMsg := fmt.Sprintf("Job %v has succeeded", deployedJobName)
 			r.recorder.Eventf(instance, corev1.EventTypeNormal, JobSucceededReason, eventMsg)
 			r.collector.IncreaseTrialsSucceededCount(instance.Namespace)
+			r.collector.ObserveTrialDuration(instance, string(trialsv1beta1.TrialSucceeded))
 		} else if !instance.IsMetricsUnavailable() {
 			// TODO (andreyvelich): Is it correct to mark succeeded status false when metrics are unavailable?
 			// Ref issue to add new condition: https://github.com/kubeflow/katib/issues/1343
@@ -99,6 +107,10 @@ func (r *ReconcileTrial) UpdateTrialStatusCondition(instance *trialsv1beta1.Tria
 
 		instance.MarkTrialStatusFailed(reason, msg)
 		instance.Status.CompletionTime = &timeNow
+		// Trial job can be deleted after this reconcile, logs are reported before it.
+		if instance.Spec.RunSpec != nil {
+			r.reportTrialLogs(instance, instance.Spec.RunSpec.GetKind())
+		}
 
 		eventMsg := fmt.Sprintf("Job %v has failed", deployedJobName)
 		if jobStatus.Message != "" || jobStatus.Reason != "" {
@@ -107,6 +119,7 @@ func (r *ReconcileTrial) UpdateTrialStatusCondition(instance *trialsv1beta1.Tria
 
 		r.recorder.Eventf(instance, corev1.EventTypeNormal, JobFailedReason, eventMsg)
 		r.collector.IncreaseTrialsFailedCount(instance.Namespace)
+		r.collector.ObserveTrialDuration(instance, string(trialsv1beta1.TrialFailed))
 		logger.Info("Trial status changed to Failed")
 	} else if jobStatus.Condition == trialutil.JobRunning && !instance.IsRunning() {
 		msg := "Trial is running"
@@ -137,6 +150,7 @@ func (r *ReconcileTrial) UpdateTrialStatusConditionDeprecated(instance *trialsv1
 			eventMsg := fmt.Sprintf("Job %s has succeeded", deployedJob.GetName())
 			r.recorder.Eventf(instance, corev1.EventTypeNormal, JobSucceededReason, eventMsg)
 			r.collector.IncreaseTrialsSucceededCount(instance.Namespace)
+			r.collector.ObserveTrialDuration(instance, string(trialsv1beta1.TrialSucceeded))
 		} else {
 			// TODO (andreyvelich): Is is correct to mark succeeded status false when metrics are unavailable?
 			msg := "Metrics are not available"
@@ -146,6 +160,9 @@ func (r *ReconcileTrial) UpdateTrialStatusConditionDeprecated(instance *trialsv1
 			r.recorder.Eventf(instance, corev1.EventTypeWarning, JobMetricsUnavailableReason, eventMsg)
 		}
 	} else if jobConditionType == commonv1.JobFailed {
+		if !instance.IsFailed() {
+			r.reportTrialLogs(instance, deployedJob.GetKind())
+		}
 		msg := "Trial has failed"
 		instance.MarkTrialStatusFailed(TrialFailedReason, msg)
 		instance.Status.CompletionTime = &now
@@ -154,6 +171,7 @@ func (r *ReconcileTrial) UpdateTrialStatusConditionDeprecated(instance *trialsv1
 		eventMsg := fmt.Sprintf("Job %s has failed: %s", deployedJob.GetName(), jobConditionMessage)
 		r.recorder.Eventf(instance, corev1.EventTypeNormal, JobFailedReason, eventMsg)
 		r.collector.IncreaseTrialsFailedCount(instance.Namespace)
+		r.collector.ObserveTrialDuration(instance, string(trialsv1beta1.TrialFailed))
 	} else if jobConditionType == commonv1.JobRunning {
 		msg := "Trial is running"
 		instance.MarkTrialStatusRunning(TrialRunningReason, msg)
@@ -183,9 +201,149 @@ func (r *ReconcileTrial) UpdateTrialStatusObservation(instance *trialsv1beta1.Tr
 		}
 		instance.Status.Observation = observation
 	}
+	r.updateTrialStatusArtifacts(instance)
 	return nil
 }
 
+// updateTrialStatusArtifacts sets the artifacts reported by the Trial metrics collector.
+// Errors are only logged, since artifacts are optional and must not block the Trial completion.
+func (r *ReconcileTrial) updateTrialStatusArtifacts(instance *trialsv1beta1.Trial) {
+	reply, err := r.GetTrialArtifacts(instance)
+	if err != nil {
+		log.Error(err, "Get trial artifacts error")
+		return
+	}
+	if len(reply.Artifacts) == 0 {
+		return
+	}
+	artifacts := make([]commonv1beta1.Artifact, 0, len(reply.Artifacts))
+	for _, a := range reply.Artifacts {
+		artifact := commonv1beta1.Artifact{
+			Name: a.Name,
+			URI:  a.Uri,
+		}
+		if len(a.Metadata) != 0 {
+			artifact.Metadata = make(map[string]string, len(a.Metadata))
+			for _, m := range a.Metadata {
+				artifact.Metadata[m.Name] = m.Value
+			}
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	instance.Status.Artifacts = artifacts
+}
+
+// reportTrialLogs stores the last part of the Trial primary container logs in DB.
+// Logs are taken from the failed Trial pod or from the latest pod if none of them is failed.
+// Errors are only logged, since missing logs must not block the Trial status update.
+// Logs are read with trialLogsTimeout, so the reconcile is not blocked by a slow API server.
+func (r *ReconcileTrial) reportTrialLogs(instance *trialsv1beta1.Trial, jobKind string) {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	limitBytes := viper.GetInt(consts.ConfigTrialLogsLimitBytes)
+	if r.kubeClient == nil || limitBytes <= 0 {
+		return
+	}
+
+	podList, err := r.kubeClient.CoreV1().Pods(instance.Namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", consts.LabelTrialName, instance.Name),
+	})
+	if err != nil {
+		logger.Error(err, "List Trial pods error")
+		return
+	}
+	pod := getTrialLogsPod(podList.Items)
+	if pod == nil {
+		logger.Info("Trial pods are not found, logs are not reported")
+		return
+	}
+	containerName, err := getPrimaryContainerName(pod, jobKind, instance)
+	if err != nil {
+		logger.Error(err, "Get Trial primary container error", "Pod", pod.Name)
+		return
+	}
+
+	// Every line has at least one byte, so the tail lines contain the last limitBytes of the logs
+	tailLines := int64(limitBytes)
+	ctx, cancel := context.WithTimeout(context.Background(), trialLogsTimeout)
+	defer cancel()
+	stream, err := r.kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: containerName,
+		TailLines: &tailLines,
+	}).Context(ctx).Stream()
+	if err != nil {
+		logger.Error(err, "Get Trial logs error", "Pod", pod.Name)
+		return
+	}
+	defer stream.Close()
+
+	buf := &tailBuffer{limit: limitBytes}
+	if _, err = io.Copy(buf, stream); err != nil {
+		logger.Error(err, "Read Trial logs error", "Pod", pod.Name)
+		return
+	}
+	if _, err = r.ReportTrialLogs(instance, buf.String()); err != nil {
+		logger.Error(err, "Report Trial logs error")
+	}
+}
+
+// getTrialLogsPod returns the failed pod or the latest created pod if none of them is failed.
+func getTrialLogsPod(pods []corev1.Pod) *corev1.Pod {
+	var latest *corev1.Pod
+	for i := range pods {
+		if pods[i].Status.Phase == corev1.PodFailed {
+			return &pods[i]
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&pods[i].CreationTimestamp) {
+			latest = &pods[i]
+		}
+	}
+	return latest
+}
+
+// getPrimaryContainerName returns the name of the Trial primary container in the pod.
+func getPrimaryContainerName(pod *corev1.Pod, jobKind string, instance *trialsv1beta1.Trial) (string, error) {
+	for i, c := range pod.Spec.Containers {
+		if instance.Spec.PrimaryContainerName != "" && c.Name == instance.Spec.PrimaryContainerName {
+			return c.Name, nil
+			// TODO (andreyvelich): This can be deleted after switch to custom CRD
+		} else if instance.Spec.PrimaryContainerName == "" {
+			jobProvider, err := jobv1beta1.New(jobKind)
+			if err != nil {
+				return "", err
+			}
+			if jobProvider.IsTrainingContainer(i, c, jobv1beta1.MainContainerName(instance.Spec.RunSpec)) {
+				return c.Name, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("Unable to find primary container %v in pod %v", instance.Spec.PrimaryContainerName, pod.Name)
+}
+
+// tailBuffer is a writer which keeps only the last limit bytes.
+type tailBuffer struct {
+	limit int
+	buf   []byte
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.limit {
+		n := copy(b.buf, b.buf[len(b.buf)-b.limit:])
+		b.buf = b.buf[:n]
+	}
+	return len(p), nil
+}
+
+// String returns the kept bytes, skipping the partial UTF-8 character at the beginning.
+func (b *tailBuffer) String() string {
+	start := 0
+	for start < len(b.buf) && start < utf8.UTFMax && !utf8.RuneStart(b.buf[start]) {
+		start++
+	}
+	return string(b.buf[start:])
+}
+
 func (r *ReconcileTrial) updateFinalizers(instance *trialsv1beta1.Trial, finalizers []string) (reconcile.Result, error) {
 	isDelete := true
 	if !instance.ObjectMeta.DeletionTimestamp.IsZero() {
@@ -236,6 +394,7 @@ func isJobSucceeded(jobCondition *commonv1.JobCondition) bool {
 func getMetrics(metricLogs []*api_pb.MetricLog, strategies []commonv1beta1.MetricStrategy) (*commonv1beta1.Observation, error) {
 	metrics := make(map[string]*commonv1beta1.Metric)
 	timestamps := make(map[string]*time.Time)
+	values := make(map[string][]timedMetricValue)
 	for _, strategy := range strategies {
 		timestamps[strategy.Name] = nil
 		metrics[strategy.Name] = &commonv1beta1.Metric{
@@ -277,6 +436,15 @@ func getMetrics(metricLogs []*api_pb.MetricLog, strategies []commonv1beta1.Metri
 			timestamps[metricLog.Metric.Name] = &currentTime
 			metric.Latest = strValue
 		}
+		if err == nil {
+			values[metricLog.Metric.Name] = append(values[metricLog.Metric.Name], timedMetricValue{time: currentTime, value: floatValue})
+		}
+	}
+
+	for _, strategy := range strategies {
+		if strategy.Value.IsComputedFromLogs() {
+			metrics[strategy.Name].Value = getMetricStrategyValue(values[strategy.Name], strategy)
+		}
 	}
 
 	observation := &commonv1beta1.Observation{}
@@ -287,6 +455,46 @@ func getMetrics(metricLogs []*api_pb.MetricLog, strategies []commonv1beta1.Metri
 	return observation, nil
 }
 
+type timedMetricValue struct {
+	time  time.Time
+	value float64
+}
+
+// getMetricStrategyValue computes the value of the strategy, which needs all numeric values of the metric.
+// It returns unavailable value if the metric has no numeric values.
+func getMetricStrategyValue(metricValues []timedMetricValue, strategy commonv1beta1.MetricStrategy) string {
+	if len(metricValues) == 0 {
+		return consts.UnavailableMetricValue
+	}
+	sort.SliceStable(metricValues, func(i, j int) bool {
+		return metricValues[i].time.Before(metricValues[j].time)
+	})
+	values := make([]float64, 0, len(metricValues))
+	for _, v := range metricValues {
+		values = append(values, v.value)
+	}
+
+	var value float64
+	switch strategy.Value {
+	case commonv1beta1.ExtractByLastNAvg:
+		if n := int(strategy.LastN); n > 0 && n < len(values) {
+			values = values[len(values)-n:]
+		}
+		value = util.AggregateValues(values, experimentsv1beta1.AggregationMean)
+	case commonv1beta1.ExtractByMedian:
+		value = util.AggregateValues(values, experimentsv1beta1.AggregationMedian)
+	case commonv1beta1.ExtractByPercentile:
+		percentile := 50.0
+		if strategy.Percentile != nil {
+			percentile = *strategy.Percentile
+		}
+		value = util.PercentileValue(values, percentile)
+	default:
+		value = util.AggregateValues(values, experimentsv1beta1.AggregationMean)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
 func needUpdateFinalizers(trial *trialsv1beta1.Trial) (bool, []string) {
 	deleted := !trial.ObjectMeta.DeletionTimestamp.IsZero()
 	pendingFinalizers := trial.GetFinalizers()
@@ -20,6 +20,8 @@ import (
 	"context"
 
 	"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 )
@@ -31,6 +33,7 @@ type TrialsCollector struct {
 	trialSucceedCount *prometheus.CounterVec
 	trialFailCount    *prometheus.CounterVec
 	trialCurrent      *prometheus.GaugeVec
+	trialDuration     *util.ExperimentHistogramVec
 }
 
 func NewTrialsCollector(store cache.Cache, registerer prometheus.Registerer) *TrialsCollector {
@@ -60,6 +63,12 @@ func NewTrialsCollector(store cache.Cache, registerer prometheus.Registerer) *Tr
 			Name: "katib_trials_current",
 			Help: "The number of current katib trials in the cluster",
 		}, []string{"namespace", "status"}),
+
+		trialDuration: util.NewExperimentHistogramVec(store, prometheus.HistogramOpts{
+			Name:    "katib_trial_duration_seconds",
+			Help:    "The duration of completed trials of the experiment",
+			Buckets: prometheus.ExponentialBuckets(60, 2, 10),
+		}, "status"),
 	}
 	registerer.MustRegister(c)
 	return c
@@ -72,6 +81,7 @@ func (m *TrialsCollector) Describe(ch chan<- *prometheus.Desc) {
 	m.trialFailCount.Describe(ch)
 	m.trialCreateCount.Describe(ch)
 	m.trialCurrent.Describe(ch)
+	m.trialDuration.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface.
@@ -82,6 +92,7 @@ func (m *TrialsCollector) Collect(ch chan<- prometheus.Metric) {
 	m.trialFailCount.Collect(ch)
 	m.trialCreateCount.Collect(ch)
 	m.trialCurrent.Collect(ch)
+	m.trialDuration.Collect(ch)
 }
 
 func (c *TrialsCollector) IncreaseTrialsDeletedCount(ns string) {
@@ -100,6 +111,15 @@ func (c *TrialsCollector) IncreaseTrialsFailedCount(ns string) {
 	c.trialFailCount.WithLabelValues(ns).Inc()
 }
 
+// ObserveTrialDuration observes the duration of the completed trial with the given status.
+func (c *TrialsCollector) ObserveTrialDuration(trial *v1beta1.Trial, status string) {
+	if trial.Status.StartTime == nil || trial.Status.CompletionTime == nil {
+		return
+	}
+	duration := trial.Status.CompletionTime.Sub(trial.Status.StartTime.Time)
+	c.trialDuration.Observe(trial.Namespace, trial.Labels[consts.LabelExperimentName], duration.Seconds(), status)
+}
+
 // collect gets the current experiments from cache.
 func (c *TrialsCollector) collect() {
 	var (
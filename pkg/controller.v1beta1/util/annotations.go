@@ -16,6 +16,8 @@ limitations under the License.
 package util
 
 import (
+	"fmt"
+
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -98,11 +100,51 @@ func TrainingJobAnnotations(desiredJob *unstructured.Unstructured) error {
 			return err
 		}
 		return nil
+	case consts.JobKindMPI:
+		return appendReplicaTemplatesAnnotation(desiredJob, "mpiReplicaSpecs")
+	case consts.JobKindXGBoost:
+		return appendReplicaTemplatesAnnotation(desiredJob, "xgbReplicaSpecs")
 	default:
 		return nil
 	}
 }
 
+// appendReplicaTemplatesAnnotation adds the Istio sidecar annotation to the pod templates of all replicas
+// in spec.<replicaSpecsField>. The unstructured job is updated in place, so fields which are unknown
+// to Katib are kept.
+func appendReplicaTemplatesAnnotation(desiredJob *unstructured.Unstructured, replicaSpecsField string) error {
+	replicaSpecs, found, err := unstructured.NestedMap(desiredJob.Object, "spec", replicaSpecsField)
+	if err != nil {
+		log.Error(err, "Get replica specs error", "Field", replicaSpecsField)
+		return err
+	}
+	if !found {
+		return nil
+	}
+	for replicaType, replicaSpec := range replicaSpecs {
+		spec, ok := replicaSpec.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("spec.%v.%v must be an object", replicaSpecsField, replicaType)
+		}
+		annotations, _, err := unstructured.NestedStringMap(spec, "template", "metadata", "annotations")
+		if err != nil {
+			log.Error(err, "Get replica template annotations error", "ReplicaType", replicaType)
+			return err
+		}
+		annotations = appendAnnotation(
+			annotations,
+			consts.AnnotationIstioSidecarInjectName,
+			consts.AnnotationIstioSidecarInjectValue)
+		err = unstructured.SetNestedStringMap(spec, annotations, "template", "metadata", "annotations")
+		if err != nil {
+			log.Error(err, "Set replica template annotations error", "ReplicaType", replicaType)
+			return err
+		}
+		replicaSpecs[replicaType] = spec
+	}
+	return unstructured.SetNestedField(desiredJob.Object, replicaSpecs, "spec", replicaSpecsField)
+}
+
 func appendAnnotation(annotations map[string]string, newAnnotationName string, newAnnotationValue string) map[string]string {
 	res := make(map[string]string)
 	for k, v := range annotations {
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func TestTrainingJobAnnotations(t *testing.T) {
+	mpiJob := `apiVersion: kubeflow.org/v1
+kind: MPIJob
+metadata:
+  name: mpi-job
+spec:
+  slotsPerWorker: 1
+  sshAuthMountPath: /home/mpiuser/.ssh
+  mpiReplicaSpecs:
+    Launcher:
+      replicas: 1
+      template:
+        metadata:
+          annotations:
+            example.com/launcher: "true"
+        spec:
+          containers:
+            - name: launcher
+              image: mpi
+    Worker:
+      replicas: 2
+      template:
+        spec:
+          containers:
+            - name: worker
+              image: mpi
+`
+	desiredJob, err := ConvertStringToUnstructured(mpiJob)
+	if err != nil {
+		t.Fatalf("ConvertStringToUnstructured() returns error: %v", err)
+	}
+	if err := TrainingJobAnnotations(desiredJob); err != nil {
+		t.Fatalf("TrainingJobAnnotations() returns error: %v", err)
+	}
+
+	// Fields which are unknown to Katib must be kept.
+	if path, _, _ := unstructured.NestedString(desiredJob.Object, "spec", "sshAuthMountPath"); path != "/home/mpiuser/.ssh" {
+		t.Errorf("TrainingJobAnnotations() should keep spec.sshAuthMountPath, but got %v", desiredJob.Object["spec"])
+	}
+	for _, replicaType := range []string{"Launcher", "Worker"} {
+		annotations, _, _ := unstructured.NestedStringMap(desiredJob.Object,
+			"spec", "mpiReplicaSpecs", replicaType, "template", "metadata", "annotations")
+		if annotations[consts.AnnotationIstioSidecarInjectName] != consts.AnnotationIstioSidecarInjectValue {
+			t.Errorf("TrainingJobAnnotations() should add Istio annotation to %v, but got %v", replicaType, annotations)
+		}
+	}
+	annotations, _, _ := unstructured.NestedStringMap(desiredJob.Object,
+		"spec", "mpiReplicaSpecs", "Launcher", "template", "metadata", "annotations")
+	if annotations["example.com/launcher"] != "true" {
+		t.Errorf("TrainingJobAnnotations() should keep existing annotations, but got %v", annotations)
+	}
+}
@@ -0,0 +1,75 @@
+package util
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+// ExperimentHistogramVec is the Prometheus histogram with namespace and experiment labels.
+// Series of deleted Experiments are removed when the histogram is collected,
+// so per-experiment series don't grow without bound.
+type ExperimentHistogramVec struct {
+	histogram *prometheus.HistogramVec
+	reader    client.Reader
+
+	mu sync.Mutex
+	// observed is the map from the series key to the label values of the series.
+	observed map[string][]string
+}
+
+// NewExperimentHistogramVec creates a new ExperimentHistogramVec.
+// The histogram has namespace and experiment labels followed by the given label names.
+func NewExperimentHistogramVec(reader client.Reader, opts prometheus.HistogramOpts, labelNames ...string) *ExperimentHistogramVec {
+	return &ExperimentHistogramVec{
+		histogram: prometheus.NewHistogramVec(opts, append([]string{"namespace", "experiment"}, labelNames...)),
+		reader:    reader,
+		observed:  make(map[string][]string),
+	}
+}
+
+// Observe adds the observation to the series of the Experiment.
+func (h *ExperimentHistogramVec) Observe(namespace, experiment string, value float64, labelValues ...string) {
+	lvs := append([]string{namespace, experiment}, labelValues...)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.observed[strings.Join(lvs, "/")] = lvs
+	h.histogram.WithLabelValues(lvs...).Observe(value)
+}
+
+// Describe implements the prometheus.Collector interface.
+func (h *ExperimentHistogramVec) Describe(ch chan<- *prometheus.Desc) {
+	h.histogram.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface.
+func (h *ExperimentHistogramVec) Collect(ch chan<- prometheus.Metric) {
+	h.prune()
+	h.histogram.Collect(ch)
+}
+
+// prune deletes series of the Experiments which don't exist anymore.
+func (h *ExperimentHistogramVec) prune() {
+	expList := &experimentsv1beta1.ExperimentList{}
+	if err := h.reader.List(context.TODO(), nil, expList); err != nil {
+		return
+	}
+	exps := make(map[string]bool, len(expList.Items))
+	for _, exp := range expList.Items {
+		exps[exp.Namespace+"/"+exp.Name] = true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key, lvs := range h.observed {
+		if !exps[lvs[0]+"/"+lvs[1]] {
+			h.histogram.DeleteLabelValues(lvs...)
+			delete(h.observed, key)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+type fakeExperimentReader struct {
+	experiments []experimentsv1beta1.Experiment
+}
+
+func (r *fakeExperimentReader) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	return nil
+}
+
+func (r *fakeExperimentReader) List(ctx context.Context, opts *client.ListOptions, list runtime.Object) error {
+	list.(*experimentsv1beta1.ExperimentList).Items = r.experiments
+	return nil
+}
+
+func TestExperimentHistogramVecPrune(t *testing.T) {
+	reader := &fakeExperimentReader{
+		experiments: []experimentsv1beta1.Experiment{
+			{ObjectMeta: metav1.ObjectMeta{Name: "exp-1", Namespace: "kubeflow"}},
+		},
+	}
+	h := NewExperimentHistogramVec(reader, prometheus.HistogramOpts{
+		Name: "katib_test_duration_seconds",
+		Help: "Test histogram",
+	}, "status")
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(h)
+
+	h.Observe("kubeflow", "exp-1", 1, "Succeeded")
+	h.Observe("kubeflow", "exp-1", 2, "Failed")
+	h.Observe("kubeflow", "exp-2", 3, "Succeeded")
+
+	tcs := []struct {
+		experiments     []experimentsv1beta1.Experiment
+		expectedSeries  int
+		testDescription string
+	}{
+		{
+			experiments:     reader.experiments,
+			expectedSeries:  2,
+			testDescription: "Series of deleted Experiment are removed",
+		},
+		{
+			experiments:     nil,
+			expectedSeries:  0,
+			testDescription: "All Experiments are deleted",
+		},
+	}
+
+	for _, tc := range tcs {
+		reader.experiments = tc.experiments
+		families, err := registry.Gather()
+		if err != nil {
+			t.Fatalf("Case: %v failed. Gather() returns error: %v", tc.testDescription, err)
+		}
+		series := 0
+		for _, family := range families {
+			series += len(family.GetMetric())
+		}
+		if series != tc.expectedSeries {
+			t.Errorf("Case: %v failed. Expected %v series, got %v", tc.testDescription, tc.expectedSeries, series)
+		}
+	}
+}
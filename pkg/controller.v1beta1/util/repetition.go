@@ -0,0 +1,231 @@
+package util
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// StdMetricSuffix is appended to the metric name of the standard deviation of the repeated trials.
+const StdMetricSuffix = "-std"
+
+// GetRepetitionCount returns how many trials are run for each parameter assignment of the experiment.
+func GetRepetitionCount(instance *experimentsv1beta1.Experiment) int32 {
+	if instance.Spec.Repetitions == nil || instance.Spec.Repetitions.Count < 1 {
+		return 1
+	}
+	return instance.Spec.Repetitions.Count
+}
+
+// GetRepetitionAggregation returns how observations of the repeated trials are aggregated.
+func GetRepetitionAggregation(instance *experimentsv1beta1.Experiment) experimentsv1beta1.AggregationType {
+	if instance.Spec.Repetitions == nil || instance.Spec.Repetitions.Aggregation == "" {
+		return experimentsv1beta1.DefaultRepetitionAggregation
+	}
+	return instance.Spec.Repetitions.Aggregation
+}
+
+// GetRepetitionSeedParameterName returns the name of the trial parameter with the seed.
+// It returns empty string if the experiment doesn't repeat trials.
+func GetRepetitionSeedParameterName(instance *experimentsv1beta1.Experiment) string {
+	if GetRepetitionCount(instance) <= 1 {
+		return ""
+	}
+	if instance.Spec.Repetitions.SeedParameterName == "" {
+		return experimentsv1beta1.DefaultRepetitionSeedParameterName
+	}
+	return instance.Spec.Repetitions.SeedParameterName
+}
+
+// RepetitionTrialName returns the name of the repeated trial for the suggested parameter assignment.
+func RepetitionTrialName(assignmentName string, seed int32) string {
+	return fmt.Sprintf("%s-%d", assignmentName, seed)
+}
+
+// RepetitionGroupName returns the name of the parameter assignment which the trial repeats.
+// Trials without repetitions form their own group.
+func RepetitionGroupName(trial *trialsv1beta1.Trial) string {
+	if name := trial.Labels[consts.LabelRepetitionGroupName]; name != "" {
+		return name
+	}
+	return trial.Name
+}
+
+// GroupRepeatedTrials groups trials by the repeated parameter assignment.
+// Groups are ordered by the first trial of the group.
+func GroupRepeatedTrials(trials []trialsv1beta1.Trial) [][]trialsv1beta1.Trial {
+	var groups [][]trialsv1beta1.Trial
+	groupIndex := make(map[string]int)
+	for _, trial := range trials {
+		name := RepetitionGroupName(&trial)
+		if i, ok := groupIndex[name]; ok {
+			groups[i] = append(groups[i], trial)
+			continue
+		}
+		groupIndex[name] = len(groups)
+		groups = append(groups, []trialsv1beta1.Trial{trial})
+	}
+	return groups
+}
+
+// AggregateRepeatedTrials returns one trial for each repeated parameter assignment, named after the assignment.
+// Trials are returned as is if the experiment doesn't repeat trials.
+func AggregateRepeatedTrials(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial) []trialsv1beta1.Trial {
+	if GetRepetitionCount(instance) <= 1 {
+		return trials
+	}
+	aggregation := GetRepetitionAggregation(instance)
+	var res []trialsv1beta1.Trial
+	for _, group := range GroupRepeatedTrials(trials) {
+		trial := AggregateTrialGroup(group, aggregation)
+		trial.Name = RepetitionGroupName(trial)
+		res = append(res, *trial)
+	}
+	return res
+}
+
+// AggregateTrialGroup merges the repeated trials into one trial.
+// The status is taken from the first trial which isn't completed, so the group is active until
+// all repetitions are completed. Otherwise it is taken from the first succeeded trial.
+// Observation is aggregated from the succeeded trials with available metrics.
+func AggregateTrialGroup(group []trialsv1beta1.Trial, aggregation experimentsv1beta1.AggregationType) *trialsv1beta1.Trial {
+	representative := -1
+	for i := range group {
+		if !group[i].IsCompleted() {
+			representative = i
+			break
+		}
+	}
+	var observations []*commonv1beta1.Observation
+	for i := range group {
+		if group[i].IsSucceeded() && !group[i].IsMetricsUnavailable() && group[i].Status.Observation != nil {
+			observations = append(observations, group[i].Status.Observation)
+			if representative == -1 {
+				representative = i
+			}
+		}
+	}
+	if representative == -1 {
+		representative = 0
+	}
+
+	res := group[representative].DeepCopy()
+	if len(observations) > 0 {
+		res.Status.Observation = AggregateObservations(observations, aggregation)
+	}
+	for i := range group {
+		if start := group[i].Status.StartTime; start != nil && (res.Status.StartTime == nil || start.Before(res.Status.StartTime)) {
+			res.Status.StartTime = start.DeepCopy()
+		}
+		if end := group[i].Status.CompletionTime; end != nil && res.Status.CompletionTime != nil && res.Status.CompletionTime.Before(end) {
+			res.Status.CompletionTime = end.DeepCopy()
+		}
+	}
+	return res
+}
+
+// AggregateObservations aggregates the metrics of the observations. Min, max, latest and strategy values
+// are aggregated separately, unavailable and non-numeric values are skipped.
+// With std aggregation metrics are the mean values and the standard deviation is reported
+// alongside as the metric with StdMetricSuffix.
+func AggregateObservations(observations []*commonv1beta1.Observation, aggregation experimentsv1beta1.AggregationType) *commonv1beta1.Observation {
+	var names []string
+	values := make(map[string]*[4][]float64)
+	for _, observation := range observations {
+		for _, metric := range observation.Metrics {
+			v, ok := values[metric.Name]
+			if !ok {
+				v = &[4][]float64{}
+				values[metric.Name] = v
+				names = append(names, metric.Name)
+			}
+			for i, value := range []string{metric.Min, metric.Max, metric.Latest, metric.Value} {
+				if f, err := strconv.ParseFloat(value, 64); err == nil {
+					v[i] = append(v[i], f)
+				}
+			}
+		}
+	}
+
+	res := &commonv1beta1.Observation{Metrics: []commonv1beta1.Metric{}}
+	for _, name := range names {
+		if aggregation == experimentsv1beta1.AggregationStd {
+			res.Metrics = append(res.Metrics,
+				aggregateMetric(name, values[name], experimentsv1beta1.AggregationMean),
+				aggregateMetric(name+StdMetricSuffix, values[name], experimentsv1beta1.AggregationStd))
+			continue
+		}
+		res.Metrics = append(res.Metrics, aggregateMetric(name, values[name], aggregation))
+	}
+	return res
+}
+
+func aggregateMetric(name string, values *[4][]float64, aggregation experimentsv1beta1.AggregationType) commonv1beta1.Metric {
+	var aggregated [4]string
+	for i, v := range values {
+		// Value is left empty if the strategy doesn't compute it from the observation log.
+		if i < 3 {
+			aggregated[i] = consts.UnavailableMetricValue
+		}
+		if len(v) > 0 {
+			aggregated[i] = strconv.FormatFloat(AggregateValues(v, aggregation), 'f', -1, 64)
+		}
+	}
+	return commonv1beta1.Metric{
+		Name:   name,
+		Min:    aggregated[0],
+		Max:    aggregated[1],
+		Latest: aggregated[2],
+		Value:  aggregated[3],
+	}
+}
+
+// AggregateValues returns the mean, median or population standard deviation of the values.
+func AggregateValues(values []float64, aggregation experimentsv1beta1.AggregationType) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	mean := 0.0
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+
+	switch aggregation {
+	case experimentsv1beta1.AggregationMedian:
+		sorted := append([]float64(nil), values...)
+		sort.Float64s(sorted)
+		middle := len(sorted) / 2
+		if len(sorted)%2 == 0 {
+			return (sorted[middle-1] + sorted[middle]) / 2
+		}
+		return sorted[middle]
+	case experimentsv1beta1.AggregationStd:
+		variance := 0.0
+		for _, v := range values {
+			variance += (v - mean) * (v - mean)
+		}
+		return math.Sqrt(variance / float64(len(values)))
+	default:
+		return mean
+	}
+}
+
+// PercentileValue returns the p-th percentile of the values, linearly interpolated between the closest ranks.
+func PercentileValue(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (rank-float64(lower))*(sorted[upper]-sorted[lower])
+}
@@ -0,0 +1,212 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func newRepeatedTrial(group string, seed int32, value string, succeeded bool) trialsv1beta1.Trial {
+	trial := trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   RepetitionTrialName(group, seed),
+			Labels: map[string]string{consts.LabelRepetitionGroupName: group},
+		},
+	}
+	if succeeded {
+		trial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "TrialSucceeded", "Trial has succeeded")
+		trial.Status.Observation = &commonv1beta1.Observation{
+			Metrics: []commonv1beta1.Metric{
+				{Name: "loss", Min: value, Max: value, Latest: value},
+			},
+		}
+	} else {
+		trial.MarkTrialStatusRunning("TrialRunning", "Trial is running")
+	}
+	return trial
+}
+
+func TestAggregateValues(t *testing.T) {
+	values := []float64{4, 1, 2, 5}
+
+	tcs := []struct {
+		aggregation     experimentsv1beta1.AggregationType
+		expected        float64
+		testDescription string
+	}{
+		{
+			aggregation:     experimentsv1beta1.AggregationMean,
+			expected:        3,
+			testDescription: "Mean of values",
+		},
+		{
+			aggregation:     experimentsv1beta1.AggregationMedian,
+			expected:        3,
+			testDescription: "Median of even number of values",
+		},
+		{
+			aggregation:     experimentsv1beta1.AggregationStd,
+			expected:        1.5811388300841898,
+			testDescription: "Standard deviation of values",
+		},
+	}
+
+	for _, tc := range tcs {
+		actual := AggregateValues(values, tc.aggregation)
+		if actual != tc.expected {
+			t.Errorf("Case: %v failed. Expected %v, got %v", tc.testDescription, tc.expected, actual)
+		}
+	}
+	if median := AggregateValues([]float64{3, 1, 2}, experimentsv1beta1.AggregationMedian); median != 2 {
+		t.Errorf("Expected median 2 of odd number of values, got %v", median)
+	}
+}
+
+func TestPercentileValue(t *testing.T) {
+	values := []float64{4, 1, 2, 5, 3}
+
+	tcs := []struct {
+		percentile      float64
+		expected        float64
+		testDescription string
+	}{
+		{
+			percentile:      0,
+			expected:        1,
+			testDescription: "Minimum value",
+		},
+		{
+			percentile:      50,
+			expected:        3,
+			testDescription: "Median value",
+		},
+		{
+			percentile:      62.5,
+			expected:        3.5,
+			testDescription: "Value interpolated between ranks",
+		},
+		{
+			percentile:      100,
+			expected:        5,
+			testDescription: "Maximum value",
+		},
+	}
+
+	for _, tc := range tcs {
+		actual := PercentileValue(values, tc.percentile)
+		if actual != tc.expected {
+			t.Errorf("Case: %v failed. Expected %v, got %v", tc.testDescription, tc.expected, actual)
+		}
+	}
+}
+
+func TestAggregateObservations(t *testing.T) {
+	observations := []*commonv1beta1.Observation{
+		{
+			Metrics: []commonv1beta1.Metric{
+				{Name: "loss", Min: "1", Max: "5", Latest: "2", Value: "1.5"},
+				{Name: "accuracy", Min: consts.UnavailableMetricValue, Max: consts.UnavailableMetricValue, Latest: "0.5"},
+			},
+		},
+		{
+			Metrics: []commonv1beta1.Metric{
+				{Name: "loss", Min: "3", Max: "7", Latest: "4", Value: "2.5"},
+				{Name: "accuracy", Min: consts.UnavailableMetricValue, Max: consts.UnavailableMetricValue, Latest: "1"},
+			},
+		},
+	}
+	expected := &commonv1beta1.Observation{
+		Metrics: []commonv1beta1.Metric{
+			{Name: "loss", Min: "2", Max: "6", Latest: "3", Value: "2"},
+			{Name: "accuracy", Min: consts.UnavailableMetricValue, Max: consts.UnavailableMetricValue, Latest: "0.75"},
+		},
+	}
+
+	actual := AggregateObservations(observations, experimentsv1beta1.AggregationMean)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected observation %v, got %v", expected, actual)
+	}
+
+	// Standard deviation is reported alongside the mean
+	expected = &commonv1beta1.Observation{
+		Metrics: []commonv1beta1.Metric{
+			{Name: "loss", Min: "2", Max: "6", Latest: "3", Value: "2"},
+			{Name: "loss-std", Min: "1", Max: "1", Latest: "1", Value: "0.5"},
+			{Name: "accuracy", Min: consts.UnavailableMetricValue, Max: consts.UnavailableMetricValue, Latest: "0.75"},
+			{Name: "accuracy-std", Min: consts.UnavailableMetricValue, Max: consts.UnavailableMetricValue, Latest: "0.25"},
+		},
+	}
+	actual = AggregateObservations(observations, experimentsv1beta1.AggregationStd)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected observation with standard deviation %v, got %v", expected, actual)
+	}
+}
+
+func TestAggregateRepeatedTrials(t *testing.T) {
+	instance := &experimentsv1beta1.Experiment{
+		Spec: experimentsv1beta1.ExperimentSpec{
+			Repetitions: &experimentsv1beta1.RepetitionSpec{
+				Count:       2,
+				Aggregation: experimentsv1beta1.AggregationMedian,
+			},
+		},
+	}
+	trials := []trialsv1beta1.Trial{
+		newRepeatedTrial("exp-a", 0, "1", true),
+		newRepeatedTrial("exp-b", 0, "5", true),
+		newRepeatedTrial("exp-a", 1, "3", true),
+		newRepeatedTrial("exp-b", 1, "", false),
+	}
+
+	aggregated := AggregateRepeatedTrials(instance, trials)
+	if len(aggregated) != 2 {
+		t.Fatalf("Expected 2 aggregated trials, got %v", len(aggregated))
+	}
+
+	tcs := []struct {
+		trial           trialsv1beta1.Trial
+		expectedName    string
+		expectedLatest  string
+		completed       bool
+		testDescription string
+	}{
+		{
+			trial:           aggregated[0],
+			expectedName:    "exp-a",
+			expectedLatest:  "2",
+			completed:       true,
+			testDescription: "All repetitions are succeeded",
+		},
+		{
+			trial:           aggregated[1],
+			expectedName:    "exp-b",
+			expectedLatest:  "5",
+			completed:       false,
+			testDescription: "Repetition is running",
+		},
+	}
+
+	for _, tc := range tcs {
+		if tc.trial.Name != tc.expectedName {
+			t.Errorf("Case: %v failed. Expected name %v, got %v", tc.testDescription, tc.expectedName, tc.trial.Name)
+		}
+		if tc.trial.IsCompleted() != tc.completed {
+			t.Errorf("Case: %v failed. Expected completed %v, got %v", tc.testDescription, tc.completed, tc.trial.IsCompleted())
+		}
+		if latest := tc.trial.Status.Observation.Metrics[0].Latest; latest != tc.expectedLatest {
+			t.Errorf("Case: %v failed. Expected latest value %v, got %v", tc.testDescription, tc.expectedLatest, latest)
+		}
+	}
+
+	instance.Spec.Repetitions = nil
+	if res := AggregateRepeatedTrials(instance, trials); len(res) != len(trials) {
+		t.Errorf("Expected trials without repetitions to be returned as is, got %v", res)
+	}
+}
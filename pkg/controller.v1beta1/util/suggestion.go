@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"strings"
 
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
@@ -28,10 +29,25 @@ func GetAlgorithmPersistentVolumeClaimName(s *suggestionsv1beta1.Suggestion) str
 }
 
 // GetAlgorithmEndpoint returns the endpoint of the algorithm service.
+// Shared algorithm service endpoint is used if it is set in the Suggestion status.
 func GetAlgorithmEndpoint(s *suggestionsv1beta1.Suggestion) string {
+	if s.Status.Endpoint != "" {
+		return s.Status.Endpoint
+	}
 	serviceName := GetAlgorithmServiceName(s)
 	return fmt.Sprintf("%s.%s:%d",
 		serviceName,
 		s.Namespace,
 		consts.DefaultSuggestionPort)
 }
+
+// GetSharedAlgorithmEndpoint returns the endpoint of the shared algorithm service for the suggestion's namespace.
+func GetSharedAlgorithmEndpoint(endpoint string, s *suggestionsv1beta1.Suggestion) string {
+	return strings.Replace(strings.TrimSpace(endpoint), consts.SuggestionEndpointNamespacePlaceholder, s.Namespace, -1)
+}
+
+// IsSharedAlgorithmService returns true if the Suggestion uses the shared or in-process algorithm service
+// instead of its own Deployment and Service.
+func IsSharedAlgorithmService(s *suggestionsv1beta1.Suggestion) bool {
+	return s.Status.Endpoint != ""
+}
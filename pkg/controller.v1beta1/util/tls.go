@@ -0,0 +1,69 @@
+package util
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
+)
+
+// AppendGRPCTLSServerVolume mounts the Suggestion server TLS Secret to the Suggestion container and
+// sets environment variables with the certificate paths. The client TLS Secret is also mounted
+// for the gRPC health probe if client auth is enabled.
+// Nothing is changed if gRPC TLS is not configured for Suggestions.
+func AppendGRPCTLSServerVolume(podSpec *corev1.PodSpec, c *corev1.Container, tlsConfig katibconfig.GRPCTLSConfig) {
+	if tlsConfig.SuggestionSecretName == "" {
+		return
+	}
+
+	appendSecretVolume(podSpec, c, consts.GRPCTLSVolumeName, tlsConfig.SuggestionSecretName, consts.DefaultGRPCTLSMountPath)
+	certConfig := grpctls.ConfigFromDir(consts.DefaultGRPCTLSMountPath, tlsConfig.ClientAuth)
+	c.Env = append(c.Env,
+		corev1.EnvVar{Name: grpctls.CertFileEnvName, Value: certConfig.CertFile},
+		corev1.EnvVar{Name: grpctls.KeyFileEnvName, Value: certConfig.KeyFile},
+		corev1.EnvVar{Name: grpctls.CAFileEnvName, Value: certConfig.CAFile},
+		corev1.EnvVar{Name: grpctls.ClientAuthEnvName, Value: strconv.FormatBool(certConfig.ClientAuth)},
+	)
+	if tlsConfig.ClientAuth {
+		appendSecretVolume(podSpec, c, consts.GRPCTLSClientVolumeName, tlsConfig.ClientSecretName, consts.DefaultGRPCTLSClientMountPath)
+	}
+}
+
+// AppendGRPCTLSClientVolume mounts the client TLS Secret to the container and
+// sets environment variables with the CA certificate path and, if client auth is enabled,
+// the client certificate paths. The container never gets the server private key.
+// Nothing is changed if the client TLS Secret is not configured.
+func AppendGRPCTLSClientVolume(podSpec *corev1.PodSpec, c *corev1.Container, tlsConfig katibconfig.GRPCTLSConfig) {
+	if tlsConfig.ClientSecretName == "" {
+		return
+	}
+
+	appendSecretVolume(podSpec, c, consts.GRPCTLSClientVolumeName, tlsConfig.ClientSecretName, consts.DefaultGRPCTLSClientMountPath)
+	certConfig := grpctls.ConfigFromDir(consts.DefaultGRPCTLSClientMountPath, false)
+	c.Env = append(c.Env, corev1.EnvVar{Name: grpctls.CAFileEnvName, Value: certConfig.CAFile})
+	if tlsConfig.ClientAuth {
+		c.Env = append(c.Env,
+			corev1.EnvVar{Name: grpctls.CertFileEnvName, Value: certConfig.CertFile},
+			corev1.EnvVar{Name: grpctls.KeyFileEnvName, Value: certConfig.KeyFile},
+		)
+	}
+}
+
+func appendSecretVolume(podSpec *corev1.PodSpec, c *corev1.Container, volumeName, secretName, mountPath string) {
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	})
+	c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: mountPath,
+		ReadOnly:  true,
+	})
+}
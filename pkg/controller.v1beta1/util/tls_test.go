@@ -0,0 +1,101 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
+)
+
+func TestAppendGRPCTLSVolume(t *testing.T) {
+	tlsConfig := katibconfig.GRPCTLSConfig{
+		SuggestionSecretName: "katib-grpc-tls",
+		ClientSecretName:     "katib-grpc-tls-client",
+		ClientAuth:           true,
+	}
+	tcs := []struct {
+		appendVolume    func(*corev1.PodSpec, *corev1.Container, katibconfig.GRPCTLSConfig)
+		tlsConfig       katibconfig.GRPCTLSConfig
+		expectedSecrets []string
+		expectedEnv     map[string]string
+		testDescription string
+	}{
+		{
+			appendVolume:    AppendGRPCTLSServerVolume,
+			tlsConfig:       katibconfig.GRPCTLSConfig{},
+			expectedEnv:     map[string]string{},
+			testDescription: "gRPC TLS is not configured for Suggestion",
+		},
+		{
+			appendVolume:    AppendGRPCTLSServerVolume,
+			tlsConfig:       tlsConfig,
+			expectedSecrets: []string{"katib-grpc-tls", "katib-grpc-tls-client"},
+			expectedEnv: map[string]string{
+				grpctls.CertFileEnvName:   consts.DefaultGRPCTLSMountPath + "/tls.crt",
+				grpctls.KeyFileEnvName:    consts.DefaultGRPCTLSMountPath + "/tls.key",
+				grpctls.CAFileEnvName:     consts.DefaultGRPCTLSMountPath + "/ca.crt",
+				grpctls.ClientAuthEnvName: "true",
+			},
+			testDescription: "Suggestion gRPC TLS with client auth",
+		},
+		{
+			appendVolume:    AppendGRPCTLSClientVolume,
+			tlsConfig:       katibconfig.GRPCTLSConfig{SuggestionSecretName: "katib-grpc-tls"},
+			expectedEnv:     map[string]string{},
+			testDescription: "Server TLS Secret is not mounted to clients",
+		},
+		{
+			appendVolume: AppendGRPCTLSClientVolume,
+			tlsConfig: katibconfig.GRPCTLSConfig{
+				ClientSecretName: "katib-grpc-tls-client",
+			},
+			expectedSecrets: []string{"katib-grpc-tls-client"},
+			expectedEnv: map[string]string{
+				grpctls.CAFileEnvName: consts.DefaultGRPCTLSClientMountPath + "/ca.crt",
+			},
+			testDescription: "Client gRPC TLS without client auth",
+		},
+		{
+			appendVolume:    AppendGRPCTLSClientVolume,
+			tlsConfig:       tlsConfig,
+			expectedSecrets: []string{"katib-grpc-tls-client"},
+			expectedEnv: map[string]string{
+				grpctls.CertFileEnvName: consts.DefaultGRPCTLSClientMountPath + "/tls.crt",
+				grpctls.KeyFileEnvName:  consts.DefaultGRPCTLSClientMountPath + "/tls.key",
+				grpctls.CAFileEnvName:   consts.DefaultGRPCTLSClientMountPath + "/ca.crt",
+			},
+			testDescription: "Client gRPC TLS with client auth",
+		},
+	}
+
+	for _, tc := range tcs {
+		podSpec := &corev1.PodSpec{}
+		c := &corev1.Container{}
+		tc.appendVolume(podSpec, c, tc.tlsConfig)
+
+		var secrets []string
+		for _, v := range podSpec.Volumes {
+			secrets = append(secrets, v.Secret.SecretName)
+		}
+		if !reflect.DeepEqual(secrets, tc.expectedSecrets) || len(c.VolumeMounts) != len(tc.expectedSecrets) {
+			t.Errorf("Case: %v failed. Expected Secrets %v, got %v and %v volume mounts",
+				tc.testDescription, tc.expectedSecrets, secrets, len(c.VolumeMounts))
+		}
+		env := map[string]string{}
+		for _, e := range c.Env {
+			env[e.Name] = e.Value
+		}
+		if len(env) != len(tc.expectedEnv) {
+			t.Errorf("Case: %v failed. Expected env %v, got %v", tc.testDescription, tc.expectedEnv, env)
+		}
+		for name, value := range tc.expectedEnv {
+			if env[name] != value {
+				t.Errorf("Case: %v failed. Expected env %v = %v, got %v", tc.testDescription, name, value, env[name])
+			}
+		}
+	}
+}
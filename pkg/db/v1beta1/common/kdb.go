@@ -4,11 +4,28 @@ import (
 	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 )
 
+// TrialKey identifies the Trial of the observation log.
+// Namespace and UID are empty for old clients which send only the Trial name,
+// such logs are shared by all Trials with the name.
+type TrialKey struct {
+	Name      string
+	Namespace string
+	UID       string
+}
+
 type KatibDBInterface interface {
 	DBInit()
 	SelectOne() error
 
-	RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error
-	GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error)
-	DeleteObservationLog(trialName string) error
+	RegisterObservationLog(trial TrialKey, observationLog *v1beta1.ObservationLog) error
+	GetObservationLog(trial TrialKey, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error)
+	DeleteObservationLog(trial TrialKey) error
+
+	RegisterTrialLogs(trial TrialKey, logs string) error
+	GetTrialLogs(trial TrialKey) (string, error)
+	DeleteTrialLogs(trial TrialKey) error
+
+	RegisterArtifacts(trial TrialKey, artifacts []*v1beta1.Artifact) error
+	GetArtifacts(trial TrialKey) ([]*v1beta1.Artifact, error)
+	DeleteArtifacts(trial TrialKey) error
 }
@@ -15,10 +15,75 @@ func (d *dbConn) DBInit() {
 		id INT AUTO_INCREMENT PRIMARY KEY,
 		time DATETIME(6),
 		metric_name VARCHAR(255) NOT NULL,
-		value TEXT NOT NULL)`)
+		value TEXT NOT NULL,
+		namespace VARCHAR(255) NOT NULL DEFAULT '',
+		trial_uid VARCHAR(255) NOT NULL DEFAULT '')`)
 	if err != nil {
 		klog.Fatalf("Error creating observation_logs table: %v", err)
 	}
+	if err = d.addObservationLogsColumns(); err != nil {
+		klog.Fatalf("Error migrating observation_logs table: %v", err)
+	}
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS trial_logs
+		(trial_name VARCHAR(255) NOT NULL,
+		namespace VARCHAR(255) NOT NULL DEFAULT '',
+		trial_uid VARCHAR(255) NOT NULL DEFAULT '',
+		time DATETIME(6),
+		logs MEDIUMTEXT NOT NULL,
+		PRIMARY KEY (trial_name, namespace, trial_uid))`)
+	if err != nil {
+		klog.Fatalf("Error creating trial_logs table: %v", err)
+	}
+
+	// Namespace and UID columns are limited to the Kubernetes lengths,
+	// so the unique key fits into the 3072 bytes InnoDB limit with utf8mb4.
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS trial_artifacts
+		(id INT AUTO_INCREMENT PRIMARY KEY,
+		trial_name VARCHAR(255) NOT NULL,
+		namespace VARCHAR(63) NOT NULL DEFAULT '',
+		trial_uid VARCHAR(36) NOT NULL DEFAULT '',
+		name VARCHAR(255) NOT NULL,
+		time DATETIME(6),
+		uri TEXT NOT NULL,
+		metadata TEXT NOT NULL,
+		UNIQUE KEY (trial_name, namespace, trial_uid, name))`)
+	if err != nil {
+		klog.Fatalf("Error creating trial_artifacts table: %v", err)
+	}
+}
+
+// addObservationLogsColumns adds namespace and trial_uid columns to the table created by old versions.
+// Existing logs get empty namespace and trial_uid.
+func (d *dbConn) addObservationLogsColumns() error {
+	rows, err := d.db.Query(`SELECT column_name FROM information_schema.columns
+		WHERE table_schema = DATABASE() AND table_name = 'observation_logs'`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return err
+		}
+		columns[column] = true
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, column := range []string{"namespace", "trial_uid"} {
+		if columns[column] {
+			continue
+		}
+		klog.Infof("Adding %s column to observation_logs table", column)
+		if _, err := d.db.Exec(fmt.Sprintf("ALTER TABLE observation_logs ADD COLUMN %s VARCHAR(255) NOT NULL DEFAULT ''", column)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (d *dbConn) SelectOne() error {
@@ -3,6 +3,7 @@ package mysql
 import (
 	crand "crypto/rand"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -91,8 +92,24 @@ func NewDBInterface() (common.KatibDBInterface, error) {
 	return NewWithSQLConn(db)
 }
 
-func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error {
-	sqlQuery := "INSERT INTO observation_logs (trial_name, time, metric_name, value) VALUES "
+// trialCondition returns the WHERE condition for the logs of the Trial.
+// Logs reported by old clients without namespace and UID match any namespace and UID.
+func trialCondition(trial common.TrialKey) (string, []interface{}) {
+	condition := "trial_name = ?"
+	args := []interface{}{trial.Name}
+	if trial.Namespace != "" {
+		condition += " AND namespace IN (?, '')"
+		args = append(args, trial.Namespace)
+	}
+	if trial.UID != "" {
+		condition += " AND trial_uid IN (?, '')"
+		args = append(args, trial.UID)
+	}
+	return condition, args
+}
+
+func (d *dbConn) RegisterObservationLog(trial common.TrialKey, observationLog *v1beta1.ObservationLog) error {
+	sqlQuery := "INSERT INTO observation_logs (trial_name, namespace, trial_uid, time, metric_name, value) VALUES "
 	values := []interface{}{}
 
 	for _, mlog := range observationLog.MetricLogs {
@@ -105,8 +122,8 @@ func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta
 		}
 		sqlTimeStr := t.UTC().Format(mysqlTimeFmt)
 
-		sqlQuery += "(?, ?, ?, ?),"
-		values = append(values, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value)
+		sqlQuery += "(?, ?, ?, ?, ?, ?),"
+		values = append(values, trial.Name, trial.Namespace, trial.UID, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value)
 	}
 	sqlQuery = sqlQuery[0 : len(sqlQuery)-1]
 
@@ -125,14 +142,102 @@ func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta
 	return nil
 }
 
-func (d *dbConn) DeleteObservationLog(trialName string) error {
-	_, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = ?", trialName)
+func (d *dbConn) DeleteObservationLog(trial common.TrialKey) error {
+	condition, args := trialCondition(trial)
+	_, err := d.db.Exec("DELETE FROM observation_logs WHERE "+condition, args...)
+	return err
+}
+
+// RegisterTrialLogs stores the container logs of the Trial, logs reported before for the Trial are replaced.
+func (d *dbConn) RegisterTrialLogs(trial common.TrialKey, logs string) error {
+	sqlTimeStr := time.Now().UTC().Format(mysqlTimeFmt)
+	_, err := d.db.Exec("REPLACE INTO trial_logs (trial_name, namespace, trial_uid, time, logs) VALUES (?, ?, ?, ?, ?)",
+		trial.Name, trial.Namespace, trial.UID, sqlTimeStr, logs)
+	if err != nil {
+		return fmt.Errorf("Execute SQL REPLACE failed: %v", err)
+	}
+	return nil
+}
+
+// GetTrialLogs returns the last reported container logs of the Trial, or empty string if logs are not reported.
+func (d *dbConn) GetTrialLogs(trial common.TrialKey) (string, error) {
+	condition, args := trialCondition(trial)
+	var logs string
+	err := d.db.QueryRow("SELECT logs FROM trial_logs WHERE "+condition+" ORDER BY time DESC LIMIT 1", args...).Scan(&logs)
+	if err == sql.ErrNoRows {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("Failed to get Trial logs %v", err)
+	}
+	return logs, nil
+}
+
+func (d *dbConn) DeleteTrialLogs(trial common.TrialKey) error {
+	condition, args := trialCondition(trial)
+	_, err := d.db.Exec("DELETE FROM trial_logs WHERE "+condition, args...)
+	return err
+}
+
+// RegisterArtifacts stores the artifacts of the Trial, artifacts reported before for the Trial with the same names are replaced.
+func (d *dbConn) RegisterArtifacts(trial common.TrialKey, artifacts []*v1beta1.Artifact) error {
+	if len(artifacts) == 0 {
+		return nil
+	}
+	sqlTimeStr := time.Now().UTC().Format(mysqlTimeFmt)
+	sqlQuery := "REPLACE INTO trial_artifacts (trial_name, namespace, trial_uid, name, time, uri, metadata) VALUES "
+	values := []interface{}{}
+	for _, artifact := range artifacts {
+		metadata, err := json.Marshal(artifact.Metadata)
+		if err != nil {
+			return fmt.Errorf("Error marshaling metadata of artifact %s: %v", artifact.Name, err)
+		}
+		sqlQuery += "(?, ?, ?, ?, ?, ?, ?),"
+		values = append(values, trial.Name, trial.Namespace, trial.UID, artifact.Name, sqlTimeStr, artifact.Uri, string(metadata))
+	}
+	sqlQuery = sqlQuery[0 : len(sqlQuery)-1]
+
+	_, err := d.db.Exec(sqlQuery, values...)
+	if err != nil {
+		return fmt.Errorf("Execute SQL REPLACE failed: %v", err)
+	}
+	return nil
+}
+
+// GetArtifacts returns the reported artifacts of the Trial ordered by name.
+func (d *dbConn) GetArtifacts(trial common.TrialKey) ([]*v1beta1.Artifact, error) {
+	condition, args := trialCondition(trial)
+	rows, err := d.db.Query("SELECT name, uri, metadata FROM trial_artifacts WHERE "+condition+" ORDER BY name", args...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get artifacts %v", err)
+	}
+	defer rows.Close()
+
+	artifacts := []*v1beta1.Artifact{}
+	for rows.Next() {
+		artifact := &v1beta1.Artifact{}
+		var metadata string
+		if err := rows.Scan(&artifact.Name, &artifact.Uri, &metadata); err != nil {
+			return nil, fmt.Errorf("Failed to scan artifact: %v", err)
+		}
+		if err := json.Unmarshal([]byte(metadata), &artifact.Metadata); err != nil {
+			return nil, fmt.Errorf("Failed to parse metadata of artifact %s: %v", artifact.Name, err)
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Failed to get artifacts %v", err)
+	}
+	return artifacts, nil
+}
+
+func (d *dbConn) DeleteArtifacts(trial common.TrialKey) error {
+	condition, args := trialCondition(trial)
+	_, err := d.db.Exec("DELETE FROM trial_artifacts WHERE "+condition, args...)
 	return err
 }
 
-func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error) {
-	qfield := []interface{}{trialName}
-	qstr := ""
+func (d *dbConn) GetObservationLog(trial common.TrialKey, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error) {
+	qstr, qfield := trialCondition(trial)
 	if metricName != "" {
 		qstr += " AND metric_name = ?"
 		qfield = append(qfield, metricName)
@@ -155,7 +260,7 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 		qstr += " AND time <= ?"
 		qfield = append(qfield, formattedEndTime)
 	}
-	rows, err := d.db.Query("SELECT time, metric_name, value FROM observation_logs WHERE trial_name = ?"+qstr+" ORDER BY time",
+	rows, err := d.db.Query("SELECT time, metric_name, value FROM observation_logs WHERE "+qstr+" ORDER BY time",
 		qfield...)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to get ObservationLogs %v", err)
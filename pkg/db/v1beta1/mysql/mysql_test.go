@@ -35,6 +35,16 @@ func TestMain(m *testing.M) {
 		fmt.Printf("error NewWithSQLConn: %v\n", err)
 	}
 	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_logs").WithArgs().WillReturnResult(sqlmock.NewResult(1, 1))
+	// Table is created by the old version without namespace and trial_uid columns.
+	columnRows := sqlmock.NewRows([]string{"column_name"})
+	for _, column := range observationLogsColumns {
+		columnRows.AddRow(column)
+	}
+	mock.ExpectQuery("SELECT column_name FROM information_schema.columns").WillReturnRows(columnRows)
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN namespace").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN trial_uid").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS trial_logs").WithArgs().WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS trial_artifacts").WithArgs().WillReturnResult(sqlmock.NewResult(1, 1))
 	dbInterface.DBInit()
 	err = dbInterface.SelectOne()
 	if err != nil {
@@ -67,16 +77,21 @@ func TestRegisterObservationLog(t *testing.T) {
 		"INSERT",
 	).WithArgs(
 		"test1_trial1",
+		"test-namespace",
+		"test-uid",
 		"2016-12-31 20:02:05.123456",
 		"f1_score",
 		"88.95",
 		"test1_trial1",
+		"test-namespace",
+		"test-uid",
 		"2016-12-31 20:02:05.123456",
 		"loss",
 		"0.5",
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err := dbInterface.RegisterObservationLog("test1_trial1", obsLog)
+	trial := common.TrialKey{Name: "test1_trial1", Namespace: "test-namespace", UID: "test-uid"}
+	err := dbInterface.RegisterObservationLog(trial, obsLog)
 	if err != nil {
 		t.Errorf("RegisterExperiment failed: %v", err)
 	}
@@ -84,7 +99,16 @@ func TestRegisterObservationLog(t *testing.T) {
 }
 
 func TestGetObservationLog(t *testing.T) {
-	mock.ExpectQuery("SELECT").WillReturnRows(
+	mock.ExpectQuery(
+		"SELECT time, metric_name, value FROM observation_logs WHERE trial_name = \\? AND namespace IN \\(\\?, ''\\) AND trial_uid IN \\(\\?, ''\\) AND metric_name = \\?",
+	).WithArgs(
+		"test1_trial1",
+		"test-namespace",
+		"test-uid",
+		"loss",
+		"2016-12-31 21:01:05.123456",
+		"2016-12-31 22:10:20.123456",
+	).WillReturnRows(
 		sqlmock.NewRows([]string{"time", "metric_name", "value"}).AddRow(
 			"2016-12-31 21:02:05.123456",
 			"loss",
@@ -96,7 +120,7 @@ func TestGetObservationLog(t *testing.T) {
 		),
 	)
 	obsLog, err := dbInterface.GetObservationLog(
-		"test1_trial1",
+		common.TrialKey{Name: "test1_trial1", Namespace: "test-namespace", UID: "test-uid"},
 		"loss",
 		"2016-12-31T21:01:05.123456Z",
 		"2016-12-31T22:10:20.123456Z",
@@ -112,16 +136,134 @@ func TestGetObservationLog(t *testing.T) {
 func TestDeleteObservationLog(t *testing.T) {
 	trialName := "test1_trial1"
 
+	// Old clients delete logs only by the Trial name.
 	mock.ExpectExec(
-		"DELETE FROM observation_logs",
+		"DELETE FROM observation_logs WHERE trial_name = \\?$",
 	).WithArgs(trialName).WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err := dbInterface.DeleteObservationLog(trialName)
+	err := dbInterface.DeleteObservationLog(common.TrialKey{Name: trialName})
 	if err != nil {
 		t.Errorf("DeleteObservationLog failed: %v", err)
 	}
 }
 
+func TestRegisterTrialLogs(t *testing.T) {
+	mock.ExpectExec(
+		"REPLACE INTO trial_logs \\(trial_name, namespace, trial_uid, time, logs\\)",
+	).WithArgs(
+		"test1_trial1",
+		"test-namespace",
+		"test-uid",
+		sqlmock.AnyArg(),
+		"Traceback (most recent call last):",
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	trial := common.TrialKey{Name: "test1_trial1", Namespace: "test-namespace", UID: "test-uid"}
+	err := dbInterface.RegisterTrialLogs(trial, "Traceback (most recent call last):")
+	if err != nil {
+		t.Errorf("RegisterTrialLogs failed: %v", err)
+	}
+}
+
+func TestGetTrialLogs(t *testing.T) {
+	mock.ExpectQuery(
+		"SELECT logs FROM trial_logs WHERE trial_name = \\? AND namespace IN \\(\\?, ''\\) AND trial_uid IN \\(\\?, ''\\) ORDER BY time DESC LIMIT 1",
+	).WithArgs(
+		"test1_trial1",
+		"test-namespace",
+		"test-uid",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"logs"}).AddRow("Traceback (most recent call last):"),
+	)
+	logs, err := dbInterface.GetTrialLogs(common.TrialKey{Name: "test1_trial1", Namespace: "test-namespace", UID: "test-uid"})
+	if err != nil {
+		t.Errorf("GetTrialLogs failed %v", err)
+	} else if logs != "Traceback (most recent call last):" {
+		t.Errorf("GetTrialLogs incorrect return %v", logs)
+	}
+
+	// Logs are not reported for the Trial.
+	mock.ExpectQuery("SELECT logs FROM trial_logs").WithArgs("test1_trial2").WillReturnRows(sqlmock.NewRows([]string{"logs"}))
+	logs, err = dbInterface.GetTrialLogs(common.TrialKey{Name: "test1_trial2"})
+	if err != nil {
+		t.Errorf("GetTrialLogs failed %v", err)
+	} else if logs != "" {
+		t.Errorf("GetTrialLogs expected empty logs, got %v", logs)
+	}
+}
+
+func TestDeleteTrialLogs(t *testing.T) {
+	mock.ExpectExec(
+		"DELETE FROM trial_logs WHERE trial_name = \\? AND namespace IN \\(\\?, ''\\)",
+	).WithArgs("test1_trial1", "test-namespace").WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := dbInterface.DeleteTrialLogs(common.TrialKey{Name: "test1_trial1", Namespace: "test-namespace"})
+	if err != nil {
+		t.Errorf("DeleteTrialLogs failed: %v", err)
+	}
+}
+
+func TestRegisterArtifacts(t *testing.T) {
+	mock.ExpectExec(
+		"REPLACE INTO trial_artifacts \\(trial_name, namespace, trial_uid, name, time, uri, metadata\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?, \\?\\),\\(",
+	).WithArgs(
+		"test1_trial1", "test-namespace", "test-uid", "model", sqlmock.AnyArg(), "s3://bucket/model",
+		`[{"name":"framework","value":"tensorflow"}]`,
+		"test1_trial1", "test-namespace", "test-uid", "checkpoint", sqlmock.AnyArg(), "/mnt/checkpoints/1", "null",
+	).WillReturnResult(sqlmock.NewResult(1, 2))
+
+	trial := common.TrialKey{Name: "test1_trial1", Namespace: "test-namespace", UID: "test-uid"}
+	err := dbInterface.RegisterArtifacts(trial, []*api_pb.Artifact{
+		{
+			Name: "model",
+			Uri:  "s3://bucket/model",
+			Metadata: []*api_pb.ArtifactMetadata{
+				{Name: "framework", Value: "tensorflow"},
+			},
+		},
+		{
+			Name: "checkpoint",
+			Uri:  "/mnt/checkpoints/1",
+		},
+	})
+	if err != nil {
+		t.Errorf("RegisterArtifacts failed: %v", err)
+	}
+}
+
+func TestGetArtifacts(t *testing.T) {
+	mock.ExpectQuery(
+		"SELECT name, uri, metadata FROM trial_artifacts WHERE trial_name = \\? AND namespace IN \\(\\?, ''\\) AND trial_uid IN \\(\\?, ''\\) ORDER BY name",
+	).WithArgs(
+		"test1_trial1",
+		"test-namespace",
+		"test-uid",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"name", "uri", "metadata"}).
+			AddRow("checkpoint", "/mnt/checkpoints/1", "null").
+			AddRow("model", "s3://bucket/model", `[{"name":"framework","value":"tensorflow"}]`),
+	)
+	artifacts, err := dbInterface.GetArtifacts(common.TrialKey{Name: "test1_trial1", Namespace: "test-namespace", UID: "test-uid"})
+	if err != nil {
+		t.Errorf("GetArtifacts failed %v", err)
+	} else if len(artifacts) != 2 {
+		t.Errorf("GetArtifacts incorrect return %v", artifacts)
+	} else if artifacts[1].Uri != "s3://bucket/model" || len(artifacts[1].Metadata) != 1 || artifacts[1].Metadata[0].Value != "tensorflow" {
+		t.Errorf("GetArtifacts incorrect artifact %v", artifacts[1])
+	}
+}
+
+func TestDeleteArtifacts(t *testing.T) {
+	mock.ExpectExec(
+		"DELETE FROM trial_artifacts WHERE trial_name = \\?$",
+	).WithArgs("test1_trial1").WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := dbInterface.DeleteArtifacts(common.TrialKey{Name: "test1_trial1"})
+	if err != nil {
+		t.Errorf("DeleteArtifacts failed: %v", err)
+	}
+}
+
 func TestGetDbName(t *testing.T) {
 	dbName := "root:@tcp(katib-mysql:3306)/katib?timeout=5s"
 
@@ -61,7 +61,7 @@ func (j Job) GetDeployedJobStatus(
 }
 
 // IsTrainingContainer returns if the c is the actual training container.
-func (j Job) IsTrainingContainer(index int, c corev1.Container) bool {
+func (j Job) IsTrainingContainer(index int, c corev1.Container, mainContainer string) bool {
 	if index == 0 {
 		// for Job worker, the first container will be taken as worker container,
 		// katib document should note it
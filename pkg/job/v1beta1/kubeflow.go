@@ -60,8 +60,18 @@ func (k Kubeflow) GetDeployedJobStatus(
 	return &jobCondition, nil
 }
 
+// MainContainerName returns the name of the main container which is set in spec.mainContainer of MPIJob.
+// It returns empty string if the main container is not set.
+func MainContainerName(runSpec *unstructured.Unstructured) string {
+	if runSpec == nil || runSpec.GetKind() != consts.JobKindMPI {
+		return ""
+	}
+	mainContainer, _, _ := unstructured.NestedString(runSpec.Object, "spec", "mainContainer")
+	return mainContainer
+}
+
 // IsTrainingContainer returns if the c is the actual training container.
-func (k Kubeflow) IsTrainingContainer(index int, c corev1.Container) bool {
+func (k Kubeflow) IsTrainingContainer(index int, c corev1.Container, mainContainer string) bool {
 	switch k.Kind {
 	case consts.JobKindTF:
 		if c.Name == tfv1.DefaultContainerName {
@@ -71,6 +81,19 @@ func (k Kubeflow) IsTrainingContainer(index int, c corev1.Container) bool {
 		if c.Name == pytorchv1.DefaultContainerName {
 			return true
 		}
+	case consts.JobKindMPI:
+		// for MPIJob launcher, the main container is taken as worker container.
+		// If it is not set, the first container is taken, as MPI Operator does by default.
+		if mainContainer != "" {
+			return c.Name == mainContainer
+		}
+		if index == 0 {
+			return true
+		}
+	case consts.JobKindXGBoost:
+		if c.Name == XGBoostDefaultContainerName {
+			return true
+		}
 	default:
 		kfLogger.Info("Invalid Katib worker kind", "JobKind", k.Kind)
 		return false
@@ -101,4 +124,19 @@ func init() {
 		Kind:    consts.JobKindPyTorch,
 	}
 	JobRoleMap[consts.JobKindPyTorch] = []string{consts.JobRole, consts.JobRolePyTorch}
+	ProviderRegistry[consts.JobKindMPI] = &Kubeflow{}
+	SupportedJobList[consts.JobKindMPI] = schema.GroupVersionKind{
+		Group:   "kubeflow.org",
+		Version: "v1",
+		Kind:    consts.JobKindMPI,
+	}
+	JobRoleMap[consts.JobKindMPI] = []string{consts.JobRoleMPI}
+	JobMasterRoleMap[consts.JobKindMPI] = consts.JobRoleMPILauncher
+	ProviderRegistry[consts.JobKindXGBoost] = &Kubeflow{}
+	SupportedJobList[consts.JobKindXGBoost] = schema.GroupVersionKind{
+		Group:   "xgboostjob.kubeflow.org",
+		Version: "v1",
+		Kind:    consts.JobKindXGBoost,
+	}
+	JobRoleMap[consts.JobKindXGBoost] = []string{consts.JobRole}
 }
@@ -16,6 +16,9 @@ var (
 	// JobRoleMap is the map which is used to determin if the replica is master.
 	// Katib will inject metrics collector into master replica.
 	JobRoleMap = make(map[string][]string)
+	// JobMasterRoleMap is the map which is used to get the value of the role labels for master replica.
+	// If the kind is not in the map, master replica is labeled with "master" value.
+	JobMasterRoleMap = make(map[string]string)
 	// SupportedJobList returns the list of the supported jobs' GVK.
 	SupportedJobList = make(map[string]schema.GroupVersionKind)
 )
@@ -26,7 +29,8 @@ type Provider interface {
 	GetDeployedJobStatus(
 		deployedJob *unstructured.Unstructured) (*commonv1.JobCondition, error)
 	// IsTrainingContainer returns if the c is the actual training container.
-	IsTrainingContainer(index int, c corev1.Container) bool
+	// mainContainer is the name of the main container from the job spec, see MainContainerName.
+	IsTrainingContainer(index int, c corev1.Container, mainContainer string) bool
 	// Mutate jobSpec before creation if necessary
 	MutateJob(*v1beta1.Trial, *unstructured.Unstructured) error
 	// Recreate Provider from kind
@@ -0,0 +1,95 @@
+package v1beta1
+
+import (
+	commonv1 "github.com/kubeflow/tf-operator/pkg/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MPIJob and XGBoostJob below mirror the v1 APIs of the MPI Operator and the XGBoost Operator.
+// Katib only needs the job spec to validate and mutate the Trial template,
+// thus it does not depend on the operators' packages.
+
+const (
+	// MPIReplicaTypeLauncher is the type for launcher replica of MPIJob.
+	MPIReplicaTypeLauncher commonv1.ReplicaType = "Launcher"
+	// MPIReplicaTypeWorker is the type for worker replicas of MPIJob.
+	MPIReplicaTypeWorker commonv1.ReplicaType = "Worker"
+
+	// XGBoostReplicaTypeMaster is the type for master replica of XGBoostJob.
+	XGBoostReplicaTypeMaster commonv1.ReplicaType = "Master"
+	// XGBoostReplicaTypeWorker is the type for worker replicas of XGBoostJob.
+	XGBoostReplicaTypeWorker commonv1.ReplicaType = "Worker"
+	// XGBoostDefaultContainerName is the name of the XGBoostJob container.
+	XGBoostDefaultContainerName = "xgboostjob"
+)
+
+// MPIJob represents the configuration of MPI Operator job.
+type MPIJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MPIJobSpec `json:"spec,omitempty"`
+}
+
+// MPIJobSpec is the spec of MPIJob.
+type MPIJobSpec struct {
+	// Specifies the number of slots per worker used in hostfile.
+	SlotsPerWorker *int32 `json:"slotsPerWorker,omitempty"`
+
+	// CleanPodPolicy defines the policy that whether to kill pods after the job completes.
+	CleanPodPolicy *commonv1.CleanPodPolicy `json:"cleanPodPolicy,omitempty"`
+
+	// MPIReplicaSpecs contains maps from `MPIReplicaType` to `ReplicaSpec` that
+	// specify the MPI replicas to run.
+	MPIReplicaSpecs map[commonv1.ReplicaType]*commonv1.ReplicaSpec `json:"mpiReplicaSpecs"`
+
+	// MainContainer specifies name of the main container which
+	// executes the MPI code.
+	MainContainer string `json:"mainContainer,omitempty"`
+
+	// RunPolicy encapsulates various runtime policies of the job.
+	RunPolicy *RunPolicy `json:"runPolicy,omitempty"`
+}
+
+// XGBoostJob represents the configuration of XGBoost Operator job.
+type XGBoostJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              XGBoostJobSpec `json:"spec,omitempty"`
+}
+
+// XGBoostJobSpec is the spec of XGBoostJob.
+type XGBoostJobSpec struct {
+	// RunPolicy encapsulates various runtime policies of the job.
+	RunPolicy `json:",inline"`
+
+	// XGBReplicaSpecs contains maps from `ReplicaType` to `ReplicaSpec` that
+	// specify the XGBoost replicas to run.
+	XGBReplicaSpecs map[commonv1.ReplicaType]*commonv1.ReplicaSpec `json:"xgbReplicaSpecs"`
+}
+
+// RunPolicy encapsulates various runtime policies of the distributed training
+// job, for example how to clean up resources and how long the job can stay
+// active.
+type RunPolicy struct {
+	// CleanPodPolicy defines the policy to kill pods after the job completes.
+	CleanPodPolicy *commonv1.CleanPodPolicy `json:"cleanPodPolicy,omitempty"`
+
+	// TTLSecondsAfterFinished is the TTL to clean up jobs.
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Specifies the duration in seconds relative to the startTime that the job may be active
+	// before the system tries to terminate it; value must be positive integer.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Optional number of retries before marking this job failed.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// SchedulingPolicy defines the policy related to scheduling, e.g. gang-scheduling.
+	SchedulingPolicy *SchedulingPolicy `json:"schedulingPolicy,omitempty"`
+}
+
+// SchedulingPolicy encapsulates various scheduling policies of the distributed training
+// job, for example `minAvailable` for gang-scheduling.
+type SchedulingPolicy struct {
+	MinAvailable *int32 `json:"minAvailable,omitempty"`
+}
@@ -0,0 +1,111 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pytorchv1 "github.com/kubeflow/pytorch-operator/pkg/apis/pytorch/v1"
+	tfv1 "github.com/kubeflow/tf-operator/pkg/apis/tensorflow/v1"
+	jsonPatch "github.com/mattbaird/jsonpatch"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// ValidateSupportedJob checks that the job of the supported kind can be converted to its type
+// without losing any fields. Jobs of other kinds are not validated.
+func ValidateSupportedJob(runSpec *unstructured.Unstructured) error {
+	gvk := runSpec.GroupVersionKind()
+	supportedJobs := SupportedJobList
+	for _, sJob := range supportedJobs {
+		if gvk == sJob {
+			switch gvk.Kind {
+			case consts.JobKindJob:
+				batchJob := batchv1.Job{}
+
+				// Validate that RunSpec can be converted to Batch Job
+				err := runtime.DefaultUnstructuredConverter.FromUnstructured(runSpec.Object, &batchJob)
+				if err != nil {
+					return fmt.Errorf("Unable to convert the job to %v: %v", gvk.Kind, err)
+				}
+
+				err = validatePatchJob(runSpec, batchJob, gvk.Kind)
+				if err != nil {
+					return err
+				}
+			case consts.JobKindTF:
+				tfJob := &tfv1.TFJob{}
+				err := runtime.DefaultUnstructuredConverter.FromUnstructured(runSpec.Object, &tfJob)
+				if err != nil {
+					return fmt.Errorf("Unable to convert the job to %v: %v", gvk.Kind, err)
+				}
+				err = validatePatchJob(runSpec, tfJob, gvk.Kind)
+				if err != nil {
+					return err
+				}
+			case consts.JobKindPyTorch:
+				pytorchJob := &pytorchv1.PyTorchJob{}
+				err := runtime.DefaultUnstructuredConverter.FromUnstructured(runSpec.Object, &pytorchJob)
+				if err != nil {
+					return fmt.Errorf("Unable to convert the job to %v: %v", gvk.Kind, err)
+				}
+				err = validatePatchJob(runSpec, pytorchJob, gvk.Kind)
+				if err != nil {
+					return err
+				}
+			case consts.JobKindMPI:
+				mpiJob := &MPIJob{}
+				err := runtime.DefaultUnstructuredConverter.FromUnstructured(runSpec.Object, &mpiJob)
+				if err != nil {
+					return fmt.Errorf("Unable to convert the job to %v: %v", gvk.Kind, err)
+				}
+				err = validatePatchJob(runSpec, mpiJob, gvk.Kind)
+				if err != nil {
+					return err
+				}
+			case consts.JobKindXGBoost:
+				xgboostJob := &XGBoostJob{}
+				err := runtime.DefaultUnstructuredConverter.FromUnstructured(runSpec.Object, &xgboostJob)
+				if err != nil {
+					return fmt.Errorf("Unable to convert the job to %v: %v", gvk.Kind, err)
+				}
+				err = validatePatchJob(runSpec, xgboostJob, gvk.Kind)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// validatePatchJob checks that the converted job has all fields of the unstructured job.
+func validatePatchJob(runSpec *unstructured.Unstructured, job interface{}, jobType string) error {
+
+	// Not necessary to check error runSpec.Object must be valid JSON
+	runSpecBefore, _ := json.Marshal(runSpec.Object)
+
+	// Not necessary to check error job must be valid JSON
+	runSpecAfter, _ := json.Marshal(job)
+
+	// Create Patch on tranformed Job (e.g: Job, TFJob) using unstructured JSON
+	runSpecPatchOperations, err := jsonPatch.CreatePatch(runSpecAfter, runSpecBefore)
+	if err != nil {
+		return fmt.Errorf("Create patch error: %v", err)
+	}
+
+	for _, operation := range runSpecPatchOperations {
+		// If operation != "remove" some values from trialTemplate were not converted
+		// We can't validate /resources/limits/ because CRDs can have custom k8s resources using defice plugin
+		// ref https://kubernetes.io/docs/concepts/extend-kubernetes/compute-storage-net/device-plugins/
+		if operation.Operation != "remove" && !strings.Contains(operation.Path, "/resources/limits/") && !strings.Contains(operation.Path, "/resources/requests/") {
+			return fmt.Errorf("Unable to convert: %v - %v to %v, converted template: %v", operation.Path, operation.Value, jobType, string(runSpecAfter))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package launcher starts the training process inside the Trial primary container.
+// The Katib pod webhook copies the launcher binary to a shared volume with an init container
+// and replaces the primary container command with the launcher followed by the original command.
+package launcher
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	crv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+)
+
+var (
+	// forwardedSignals are sent by the launcher to the training process.
+	forwardedSignals = []os.Signal{
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGQUIT,
+		syscall.SIGTERM,
+		syscall.SIGUSR1,
+		syscall.SIGUSR2,
+	}
+)
+
+// Options is the launcher configuration.
+type Options struct {
+	// Command is the original command and arguments of the training container.
+	Command []string
+	// Image is the training container image. If it is set, ENTRYPOINT of the image is prepended to the Command.
+	// CMD of the image is used if the Command is empty.
+	Image string
+	// MetricsFile is the file where stdout and stderr of the training process are copied.
+	MetricsFile string
+	// CompletedMarkedDirPath is the directory where the completed marker is written
+	// after the training process succeeds.
+	CompletedMarkedDirPath string
+}
+
+// MergeDockerConfigs merges registry credentials of config.json in the directories to config.json
+// in a new temporary directory and returns this directory. Credentials from the first directory are used
+// for the registry, like kubelet tries the image pull Secrets in order. Missing config files are skipped.
+func MergeDockerConfigs(dirs []string) (string, error) {
+	auths := map[string]json.RawMessage{}
+	for _, dir := range dirs {
+		file := filepath.Join(dir, "config.json")
+		content, err := ioutil.ReadFile(file)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		cfg := struct {
+			Auths map[string]json.RawMessage `json:"auths"`
+		}{}
+		if err := json.Unmarshal(content, &cfg); err != nil {
+			return "", fmt.Errorf("Failed to parse %v: %v", file, err)
+		}
+		for registry, auth := range cfg.Auths {
+			if _, ok := auths[registry]; !ok {
+				auths[registry] = auth
+			}
+		}
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"auths": auths})
+	if err != nil {
+		return "", err
+	}
+	mergedDir, err := ioutil.TempDir("", "katib-docker-config")
+	if err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(filepath.Join(mergedDir, "config.json"), data, 0600); err != nil {
+		return "", err
+	}
+	return mergedDir, nil
+}
+
+// ImageConfigGetter returns the config of the container image.
+type ImageConfigGetter func(image string) (*crv1.Config, error)
+
+// GetRemoteImageConfig fetches the config of the container image from the registry.
+// Registry credentials are loaded from $DOCKER_CONFIG/config.json.
+func GetRemoteImageConfig(image string) (*crv1.Config, error) {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse image %q: %v", image, err)
+	}
+	img, err := remote.Image(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get container image %q info from registry: %v", image, err)
+	}
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get config for image %q: %v", image, err)
+	}
+	return &cfg.Config, nil
+}
+
+// ResolveCommand returns the command of the training process.
+// https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#notes
+func ResolveCommand(opts Options, getImageConfig ImageConfigGetter) ([]string, error) {
+	command := []string{}
+	if opts.Image == "" {
+		command = append(command, opts.Command...)
+	} else {
+		cfg, err := getImageConfig(opts.Image)
+		if err != nil {
+			return nil, err
+		}
+		command = append(command, cfg.Entrypoint...)
+		if len(opts.Command) != 0 {
+			command = append(command, opts.Command...)
+		} else {
+			command = append(command, cfg.Cmd...)
+		}
+	}
+	if len(command) == 0 {
+		return nil, errors.New("Training container command is empty")
+	}
+	return command, nil
+}
+
+// CopyBinary copies the running launcher binary to dst.
+func CopyBinary(dst string) error {
+	src, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("Unable to get launcher executable: %v", err)
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("Unable to open %v: %v", src, err)
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("Unable to create directory for %v: %v", dst, err)
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return fmt.Errorf("Unable to create %v: %v", dst, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return fmt.Errorf("Unable to copy %v to %v: %v", src, dst, err)
+	}
+	return out.Close()
+}
+
+// Run starts the training process and waits until it is finished.
+// Signals received by the launcher are forwarded to the training process.
+// It returns the exit code of the training process.
+func Run(command []string, opts Options) (int, error) {
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if opts.MetricsFile != "" {
+		if err := os.MkdirAll(filepath.Dir(opts.MetricsFile), 0755); err != nil {
+			return 0, fmt.Errorf("Unable to create directory for metrics file %v: %v", opts.MetricsFile, err)
+		}
+		f, err := os.Create(opts.MetricsFile)
+		if err != nil {
+			return 0, fmt.Errorf("Unable to create metrics file %v: %v", opts.MetricsFile, err)
+		}
+		defer f.Close()
+		cmd.Stdout = io.MultiWriter(os.Stdout, f)
+		cmd.Stderr = io.MultiWriter(os.Stderr, f)
+	}
+
+	sigs := make(chan os.Signal, len(forwardedSignals))
+	signal.Notify(sigs, forwardedSignals...)
+	defer signal.Stop(sigs)
+
+	if err := cmd.Start(); err != nil {
+		return 0, fmt.Errorf("Unable to start training process %v: %v", command, err)
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case sig := <-sigs:
+				cmd.Process.Signal(sig)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	exitCode, err := getExitCode(cmd.Wait())
+	if err != nil {
+		return 0, err
+	}
+	if exitCode == 0 && opts.CompletedMarkedDirPath != "" {
+		if err := markCompleted(opts.CompletedMarkedDirPath); err != nil {
+			return 0, err
+		}
+	}
+	return exitCode, nil
+}
+
+func getExitCode(err error) (int, error) {
+	if err == nil {
+		return 0, nil
+	}
+	exitErr, ok := err.(*exec.ExitError)
+	if !ok {
+		return 0, fmt.Errorf("Failed to wait for training process: %v", err)
+	}
+	// Follow the shell convention for the processes terminated by signal
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return 128 + int(status.Signal()), nil
+	}
+	return exitErr.ExitCode(), nil
+}
+
+// markCompleted writes the completed marker to <pid>.pid file, where pid is the launcher process id.
+// Metrics collector waits for this file after the main process of the training container is finished.
+func markCompleted(dir string) error {
+	markFile := filepath.Join(dir, fmt.Sprintf("%d.pid", os.Getpid()))
+	if err := ioutil.WriteFile(markFile, []byte(mccommon.TrainingCompleted), 0644); err != nil {
+		return fmt.Errorf("Unable to write completed marker to %v: %v", markFile, err)
+	}
+	return nil
+}
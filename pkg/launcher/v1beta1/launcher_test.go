@@ -0,0 +1,181 @@
+package launcher
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	crv1 "github.com/google/go-containerregistry/pkg/v1"
+
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+)
+
+func TestResolveCommand(t *testing.T) {
+	getImageConfig := func(image string) (*crv1.Config, error) {
+		if image != "training-image" {
+			return nil, errors.New("image not found")
+		}
+		return &crv1.Config{
+			Entrypoint: []string{"python", "main.py"},
+			Cmd:        []string{"--lr", "0.1"},
+		}, nil
+	}
+
+	tcs := []struct {
+		opts            Options
+		expectedCommand []string
+		err             bool
+		testDescription string
+	}{
+		{
+			opts: Options{
+				Command: []string{"python", "main.py", "--message", "hello \"katib\" world"},
+			},
+			expectedCommand: []string{"python", "main.py", "--message", "hello \"katib\" world"},
+			testDescription: "Command is set",
+		},
+		{
+			opts: Options{
+				Image: "training-image",
+			},
+			expectedCommand: []string{"python", "main.py", "--lr", "0.1"},
+			testDescription: "Image ENTRYPOINT and CMD are used",
+		},
+		{
+			opts: Options{
+				Command: []string{"--lr", "0.01"},
+				Image:   "training-image",
+			},
+			expectedCommand: []string{"python", "main.py", "--lr", "0.01"},
+			testDescription: "Image ENTRYPOINT is used with container args",
+		},
+		{
+			opts: Options{
+				Image: "unknown-image",
+			},
+			err:             true,
+			testDescription: "Image config is not available",
+		},
+		{
+			opts:            Options{},
+			err:             true,
+			testDescription: "Command is empty",
+		},
+	}
+
+	for _, tc := range tcs {
+		command, err := ResolveCommand(tc.opts, getImageConfig)
+		if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected error, got nil", tc.testDescription)
+		} else if !tc.err {
+			if err != nil {
+				t.Errorf("Case: %v failed. Expected nil, got error: %v", tc.testDescription, err)
+			} else if !reflect.DeepEqual(command, tc.expectedCommand) {
+				t.Errorf("Case: %v failed. Expected command: %v, got: %v", tc.testDescription, tc.expectedCommand, command)
+			}
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "katib-launcher")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	markFile := filepath.Join(dir, fmt.Sprintf("%d.pid", os.Getpid()))
+
+	tcs := []struct {
+		command          []string
+		metricsFile      string
+		expectedExitCode int
+		expectedMetrics  string
+		expectedMarker   bool
+		testDescription  string
+	}{
+		{
+			command:          []string{"sh", "-c", "echo \"loss=0.1\"; echo accuracy=0.9 1>&2"},
+			metricsFile:      filepath.Join(dir, "metrics.log"),
+			expectedExitCode: 0,
+			expectedMetrics:  "loss=0.1\naccuracy=0.9\n",
+			expectedMarker:   true,
+			testDescription:  "Training process succeeds",
+		},
+		{
+			command:          []string{"sh", "-c", "echo loss=0.1; exit 3"},
+			expectedExitCode: 3,
+			expectedMarker:   false,
+			testDescription:  "Exit code of the training process is propagated",
+		},
+		{
+			command:          []string{"sh", "-c", "kill -TERM $$"},
+			expectedExitCode: 143,
+			expectedMarker:   false,
+			testDescription:  "Training process is terminated by signal",
+		},
+	}
+
+	for _, tc := range tcs {
+		os.Remove(markFile)
+		exitCode, err := Run(tc.command, Options{MetricsFile: tc.metricsFile, CompletedMarkedDirPath: dir})
+		if err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got error: %v", tc.testDescription, err)
+			continue
+		}
+		if exitCode != tc.expectedExitCode {
+			t.Errorf("Case: %v failed. Expected exit code: %v, got: %v", tc.testDescription, tc.expectedExitCode, exitCode)
+		}
+		if tc.metricsFile != "" {
+			metrics, _ := ioutil.ReadFile(tc.metricsFile)
+			if string(metrics) != tc.expectedMetrics {
+				t.Errorf("Case: %v failed. Expected metrics: %q, got: %q", tc.testDescription, tc.expectedMetrics, string(metrics))
+			}
+		}
+		marker, err := ioutil.ReadFile(markFile)
+		if tc.expectedMarker && (err != nil || string(marker) != mccommon.TrainingCompleted) {
+			t.Errorf("Case: %v failed. Expected completed marker in %v, got: %q, error: %v", tc.testDescription, markFile, string(marker), err)
+		} else if !tc.expectedMarker && err == nil {
+			t.Errorf("Case: %v failed. Expected no completed marker in %v", tc.testDescription, markFile)
+		}
+	}
+}
+
+func TestMergeDockerConfigs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "docker-configs")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	configs := map[string]string{
+		"0": `{"auths": {"registry.example.com": {"auth": "Zmlyc3Q6c2VjcmV0"}}}`,
+		"1": `{"auths": {"registry.example.com": {"auth": "c2Vjb25kOnNlY3JldA=="}, "gcr.io": {"auth": "Z2NyOnNlY3JldA=="}}}`,
+	}
+	for name, content := range configs {
+		if err := os.MkdirAll(filepath.Join(dir, name), 0755); err != nil {
+			t.Fatalf("Failed to create config dir: %v", err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name, "config.json"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+	}
+
+	// Secret without .dockerconfigjson key is not mounted
+	mergedDir, err := MergeDockerConfigs([]string{filepath.Join(dir, "0"), filepath.Join(dir, "missing"), filepath.Join(dir, "1")})
+	if err != nil {
+		t.Fatalf("Failed to merge docker configs: %v", err)
+	}
+	defer os.RemoveAll(mergedDir)
+	content, err := ioutil.ReadFile(filepath.Join(mergedDir, "config.json"))
+	if err != nil {
+		t.Fatalf("Failed to read merged config: %v", err)
+	}
+	expected := `{"auths":{"gcr.io":{"auth":"Z2NyOnNlY3JldA=="},"registry.example.com":{"auth":"Zmlyc3Q6c2VjcmV0"}}}`
+	if string(content) != expected {
+		t.Errorf("Expected merged config %v, got %v", expected, string(content))
+	}
+}
@@ -28,8 +28,11 @@ const (
 	DefaultTimeout = 0
 	// DefaultWaitAll is the default value whether wait for all other main process of container exiting
 	DefaultWaitAll = true
-	// TrainingCompleted is the job finished marker in $$$$.pid file when main process is completed
+	// TrainingCompleted is the job finished marker in <pid>.pid file when main process is completed
 	TrainingCompleted = "completed"
+	// LauncherCompletedDirFlag is the launcher flag with the directory for the completed marker.
+	// Metrics collector uses it to find the main process in the training container command line
+	LauncherCompletedDirFlag = "-completed-dir"
 
 	// DefaultFilter is the default metrics collector filter to parse the metrics.
 	// Metrics must be printed this way
@@ -37,11 +40,42 @@ const (
 	// accuracy=0.98
 	DefaultFilter = `([\w|-]+)\s*=\s*((-?\d+)(\.\d+)?)`
 
+	// PodNameEnvName is the env name of the Trial pod name in the metrics collector container
+	PodNameEnvName = "KATIB_POD_NAME"
+	// PodNamespaceEnvName is the env name of the Trial pod namespace in the metrics collector container
+	PodNamespaceEnvName = "KATIB_POD_NAMESPACE"
+	// PodUIDEnvName is the env name of the Trial pod UID in the metrics collector container
+	PodUIDEnvName = "KATIB_POD_UID"
+	// DefaultKubeletLogDir is the default directory of the container logs on the node
+	DefaultKubeletLogDir = "/var/log/pods"
+	// KubeletPodLogDir is the directory of the Trial pod logs in the metrics collector container.
+	// Only the pod directory <namespace>_<pod name>_<pod uid> is mounted from DefaultKubeletLogDir.
+	KubeletPodLogDir = "/katib/pod-logs"
+
+	// ArtifactsFileName is the name of the file with the Trial artifacts in the metrics volume
+	ArtifactsFileName = "katib-artifacts.json"
+	// ArtifactsFileEnvName is the env name of the artifacts file path in the training container.
+	// Training code writes checkpoints, models and other outputs to this file in JSON format
+	// [{"name": "model", "uri": "s3://bucket/model", "metadata": {"framework": "tensorflow"}}]
+	ArtifactsFileEnvName = "KATIB_ARTIFACTS_FILE"
+
 	// TODO (andreyvelich): Do we need to maintain 2 names? Should we leave only 1?
 	MetricCollectorContainerName       = "metrics-collector"
 	MetricLoggerCollectorContainerName = "metrics-logger-and-collector"
 )
 
+// StdOutMode is the way how StdOut metrics collector gets the training container output
+type StdOutMode string
+
+const (
+	// StdOutModeLauncher copies the output of the training process to the metrics file by the launcher
+	StdOutModeLauncher StdOutMode = "Launcher"
+	// StdOutModePodLogs streams the training container logs from Kubernetes API server
+	StdOutModePodLogs StdOutMode = "PodLogs"
+	// StdOutModeKubeletLogs reads the training container log files on the node
+	StdOutModeKubeletLogs StdOutMode = "KubeletLogs"
+)
+
 var (
 	AutoInjectMetricsCollecterList = [...]v1beta1common.CollectorKind{
 		v1beta1common.StdOutCollector,
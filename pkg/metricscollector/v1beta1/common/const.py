@@ -6,8 +6,10 @@ DEFAULT_TIMEOUT = 0
 DEFAULT_WAIT_ALL = True
 # Default value for directory where TF event metrics are reported
 DEFAULT_METRICS_FILE_DIR = "/log"
-# Job finished marker in $$$$.pid file when main process is completed
+# Job finished marker in <pid>.pid file when main process is completed
 TRAINING_COMPLETED = "completed"
+# Launcher flag with the directory for the completed marker
+LAUNCHER_COMPLETED_DIR_FLAG = "-completed-dir"
 
 # UnavailableMetricValue is the value in the DB
 # when metrics collector can't parse objective metric from the training logs.
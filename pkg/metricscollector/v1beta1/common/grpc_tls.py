@@ -0,0 +1,35 @@
+import os
+
+import grpc
+from grpc.beta import implementations
+
+# Env names of the TLS certificate paths, the same as in Go grpctls package.
+CERT_FILE_ENV_NAME = "KATIB_GRPC_TLS_CERT_FILE"
+KEY_FILE_ENV_NAME = "KATIB_GRPC_TLS_KEY_FILE"
+CA_FILE_ENV_NAME = "KATIB_GRPC_TLS_CA_FILE"
+
+
+def _read_file(env_name):
+    path = os.environ.get(env_name, "")
+    if path == "":
+        return None
+    with open(path, "rb") as f:
+        return f.read()
+
+
+def create_channel(host, port):
+    """
+    Create the channel to Katib DB Manager.
+    TLS is used if the certificates are set in the environment variables,
+    otherwise the channel is insecure.
+    """
+    root_certificates = _read_file(CA_FILE_ENV_NAME)
+    certificate_chain = _read_file(CERT_FILE_ENV_NAME)
+    if root_certificates is None and certificate_chain is None:
+        return implementations.insecure_channel(host, port)
+
+    credentials = grpc.ssl_channel_credentials(
+        root_certificates=root_certificates,
+        private_key=_read_file(KEY_FILE_ENV_NAME),
+        certificate_chain=certificate_chain)
+    return implementations.secure_channel(host, port, credentials)
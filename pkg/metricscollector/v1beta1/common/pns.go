@@ -88,9 +88,11 @@ func GetMainProcesses(completedMarkedDirPath string) (map[int]bool, int, error)
 
 		// By default mainPid is the first process.
 		// Command line contains completed marker for the main pid
-		// For example: echo completed > /var/log/katib/$$$$.pid
+		// For example: /katib/bin/katib-launcher -completed-dir /var/log/katib -- python train.py
+		// Pods created before the launcher was introduced use: echo completed > /var/log/katib/$$$$.pid
 		// completedMarkedDirPath is the directory for completed marker, e.g. /var/log/katib
 		if mainPid == 0 ||
+			strings.Contains(cmdline, fmt.Sprintf("%s %s", LauncherCompletedDirFlag, completedMarkedDirPath)) ||
 			strings.Contains(cmdline, fmt.Sprintf("echo %s > %s", TrainingCompleted, completedMarkedDirPath)) {
 			mainPid = int(pid)
 		}
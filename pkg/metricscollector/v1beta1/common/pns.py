@@ -42,9 +42,12 @@ def GetMainProcesses(completed_marked_dir):
 
         # By default main_pid is the first process.
         # Command line contains completed marker for the main pid
-        # For example: echo completed > /var/log/katib/$$$$.pid
+        # For example: /katib/bin/katib-launcher -completed-dir /var/log/katib -- python train.py
+        # Pods created before the launcher was introduced use: echo completed > /var/log/katib/$$$$.pid
         # completed_marked_dir is the directory for completed marker, e.g. /var/log/katib
-        if main_pid == 0 or ("echo {} > {}".format(const.TRAINING_COMPLETED, completed_marked_dir) in cmd_lind):
+        if main_pid == 0 or \
+                ("{} {}".format(const.LAUNCHER_COMPLETED_DIR_FLAG, completed_marked_dir) in cmd_lind) or \
+                ("echo {} > {}".format(const.TRAINING_COMPLETED, completed_marked_dir) in cmd_lind):
             main_pid = pid
 
         pids.add(pid)
@@ -0,0 +1,57 @@
+package sidecarmetricscollector
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// fileArtifact is the artifact format in the artifacts file written by the training code.
+type fileArtifact struct {
+	Name     string            `json:"name"`
+	URI      string            `json:"uri"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// CollectArtifacts reads the artifacts written by the training code.
+// It returns nil if the training code doesn't write the artifacts file.
+func CollectArtifacts(fileName string) ([]*v1beta1.Artifact, error) {
+	content, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var fileArtifacts []fileArtifact
+	if err := json.Unmarshal(content, &fileArtifacts); err != nil {
+		return nil, fmt.Errorf("Failed to parse artifacts file %s: %v", fileName, err)
+	}
+
+	artifacts := make([]*v1beta1.Artifact, 0, len(fileArtifacts))
+	for _, a := range fileArtifacts {
+		if a.Name == "" || a.URI == "" {
+			return nil, fmt.Errorf("Artifact name and uri must be specified in artifacts file %s: %v", fileName, a)
+		}
+		artifact := &v1beta1.Artifact{
+			Name: a.Name,
+			Uri:  a.URI,
+		}
+		keys := make([]string, 0, len(a.Metadata))
+		for k := range a.Metadata {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			artifact.Metadata = append(artifact.Metadata, &v1beta1.ArtifactMetadata{
+				Name:  k,
+				Value: a.Metadata[k],
+			})
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	return artifacts, nil
+}
@@ -0,0 +1,79 @@
+package sidecarmetricscollector
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestCollectArtifacts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tcs := []struct {
+		content           string
+		expectedArtifacts []*v1beta1.Artifact
+		err               bool
+		testDescription   string
+	}{
+		{
+			content: `[
+				{"name": "model", "uri": "s3://bucket/model", "metadata": {"format": "savedmodel", "framework": "tensorflow"}},
+				{"name": "checkpoint", "uri": "/mnt/checkpoints/1"}
+			]`,
+			expectedArtifacts: []*v1beta1.Artifact{
+				{
+					Name: "model",
+					Uri:  "s3://bucket/model",
+					Metadata: []*v1beta1.ArtifactMetadata{
+						{Name: "format", Value: "savedmodel"},
+						{Name: "framework", Value: "tensorflow"},
+					},
+				},
+				{
+					Name: "checkpoint",
+					Uri:  "/mnt/checkpoints/1",
+				},
+			},
+			testDescription: "Artifacts with metadata",
+		},
+		{
+			content:         `[{"name": "model"}]`,
+			err:             true,
+			testDescription: "Artifact without uri",
+		},
+		{
+			content:         `{"name": "model"`,
+			err:             true,
+			testDescription: "Invalid JSON",
+		},
+	}
+
+	for i, tc := range tcs {
+		fileName := filepath.Join(dir, fmt.Sprintf("artifacts-%d.json", i))
+		if err := ioutil.WriteFile(fileName, []byte(tc.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		artifacts, err := CollectArtifacts(fileName)
+		if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected error, got nil", tc.testDescription)
+		} else if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got error: %v", tc.testDescription, err)
+		} else if !tc.err && !reflect.DeepEqual(artifacts, tc.expectedArtifacts) {
+			t.Errorf("Case: %v failed. Expected artifacts: %v, got: %v", tc.testDescription, tc.expectedArtifacts, artifacts)
+		}
+	}
+
+	artifacts, err := CollectArtifacts(filepath.Join(dir, "not-found.json"))
+	if err != nil || artifacts != nil {
+		t.Errorf("Expected nil artifacts for missing file, got: %v, error: %v", artifacts, err)
+	}
+}
@@ -26,64 +26,89 @@ func CollectObservationLog(fileName string, metrics []string, filters []string)
 }
 
 func parseLogs(logs []string, metrics []string, filters []string) (*v1beta1.ObservationLog, error) {
-	olog := &v1beta1.ObservationLog{}
-	metricRegList := getFilterRegexpList(filters)
-	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
-
+	parser := NewLogParser(metrics, filters)
 	for _, logline := range logs {
-		// skip line which doesn't contain any metrics keywords, avoiding unnecessary pattern match
-		isMetricLine := false
-		for _, m := range metrics {
-			if strings.Contains(logline, m) {
-				isMetricLine = true
-				break
-			}
-		}
-		if !isMetricLine {
-			continue
+		parser.ParseLine(logline)
+	}
+	return parser.ObservationLog(), nil
+}
+
+// LogParser parses metrics from the training logs line by line,
+// so the logs can be processed while they are streamed.
+type LogParser struct {
+	metrics       []string
+	metricRegList []*regexp.Regexp
+	mlogs         []*v1beta1.MetricLog
+}
+
+// NewLogParser creates the parser for the metrics names and filters.
+// Objective metric name must be the first in the metrics.
+func NewLogParser(metrics []string, filters []string) *LogParser {
+	return &LogParser{
+		metrics:       metrics,
+		metricRegList: getFilterRegexpList(filters),
+	}
+}
+
+// ParseLine parses metrics from the log line. Line can begin with RFC3339 timestamp.
+func (p *LogParser) ParseLine(logline string) {
+	// skip line which doesn't contain any metrics keywords, avoiding unnecessary pattern match
+	isMetricLine := false
+	for _, m := range p.metrics {
+		if strings.Contains(logline, m) {
+			isMetricLine = true
+			break
 		}
+	}
+	if !isMetricLine {
+		return
+	}
 
-		timestamp := time.Time{}.UTC().Format(time.RFC3339)
-		ls := strings.SplitN(logline, " ", 2)
-		if len(ls) != 2 {
-			klog.Warningf("Metrics will not have timestamp since %s doesn't begin with timestamp string", logline)
+	timestamp := time.Time{}.UTC().Format(time.RFC3339)
+	ls := strings.SplitN(logline, " ", 2)
+	if len(ls) != 2 {
+		klog.Warningf("Metrics will not have timestamp since %s doesn't begin with timestamp string", logline)
+	} else {
+		if _, err := time.Parse(time.RFC3339Nano, ls[0]); err != nil {
+			klog.Warningf("Metrics will not have timestamp since error parsing time %s: %v", ls[0], err)
 		} else {
-			if _, err := time.Parse(time.RFC3339Nano, ls[0]); err != nil {
-				klog.Warningf("Metrics will not have timestamp since error parsing time %s: %v", ls[0], err)
-			} else {
-				timestamp = ls[0]
-			}
+			timestamp = ls[0]
 		}
+	}
 
-		for _, metricReg := range metricRegList {
-			matchStrs := metricReg.FindAllStringSubmatch(logline, -1)
-			for _, kevList := range matchStrs {
-				if len(kevList) < 3 {
+	for _, metricReg := range p.metricRegList {
+		matchStrs := metricReg.FindAllStringSubmatch(logline, -1)
+		for _, kevList := range matchStrs {
+			if len(kevList) < 3 {
+				continue
+			}
+			name := strings.TrimSpace(kevList[1])
+			value := strings.TrimSpace(kevList[2])
+			for _, m := range p.metrics {
+				if name != m {
 					continue
 				}
-				name := strings.TrimSpace(kevList[1])
-				value := strings.TrimSpace(kevList[2])
-				for _, m := range metrics {
-					if name != m {
-						continue
-					}
-					mlogs = append(mlogs, &v1beta1.MetricLog{
-						TimeStamp: timestamp,
-						Metric: &v1beta1.Metric{
-							Name:  name,
-							Value: value,
-						},
-					})
-					break
-				}
+				p.mlogs = append(p.mlogs, &v1beta1.MetricLog{
+					TimeStamp: timestamp,
+					Metric: &v1beta1.Metric{
+						Name:  name,
+						Value: value,
+					},
+				})
+				break
 			}
 		}
 	}
+}
+
+// ObservationLog returns the observation log with the parsed metrics.
+func (p *LogParser) ObservationLog() *v1beta1.ObservationLog {
+	olog := &v1beta1.ObservationLog{}
 	// Metrics logs must contain at least one objective metric value
 	// Objective metric is located at first index
 	isObjectiveMetricReported := false
-	for _, mLog := range mlogs {
-		if mLog.Metric.Name == metrics[0] {
+	for _, mLog := range p.mlogs {
+		if mLog.Metric.Name == p.metrics[0] {
 			isObjectiveMetricReported = true
 			break
 		}
@@ -94,17 +119,17 @@ func parseLogs(logs []string, metrics []string, filters []string) (*v1beta1.Obse
 			{
 				TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
 				Metric: &v1beta1.Metric{
-					Name:  metrics[0],
+					Name:  p.metrics[0],
 					Value: consts.UnavailableMetricValue,
 				},
 			},
 		}
-		klog.Infof("Objective metric %v is not found in training logs, %v value is reported", metrics[0], consts.UnavailableMetricValue)
+		klog.Infof("Objective metric %v is not found in training logs, %v value is reported", p.metrics[0], consts.UnavailableMetricValue)
 	} else {
-		olog.MetricLogs = mlogs
+		olog.MetricLogs = p.mlogs
 	}
 
-	return olog, nil
+	return olog
 }
 
 func getFilterRegexpList(filters []string) []*regexp.Regexp {
@@ -0,0 +1,245 @@
+package sidecarmetricscollector
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/klog"
+)
+
+const (
+	// criPartialTag is the tag of CRI log line which is split by the container runtime
+	criPartialTag = "P"
+)
+
+// StreamPodLogs streams the container logs from Kubernetes API server to the parser
+// until the container is terminated.
+// Metrics collector service account must be able to get pods and pods/log in the Trial namespace.
+func StreamPodLogs(pods typedcorev1.PodInterface, podName, containerName string, parser *LogParser, pollInterval time.Duration) error {
+	opts := &corev1.PodLogOptions{
+		Container:  containerName,
+		Follow:     true,
+		Timestamps: true,
+	}
+	lastTime := time.Time{}
+	for {
+		stream, err := pods.GetLogs(podName, opts).Stream()
+		if err != nil {
+			if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) {
+				return fmt.Errorf("Unable to stream logs of container %v in pod %v: %v", containerName, podName, err)
+			}
+			// Container is not started yet
+			klog.V(4).Infof("Waiting for logs of container %v in pod %v: %v", containerName, podName, err)
+			time.Sleep(pollInterval)
+			continue
+		}
+		lastTime, err = parseTimestampedLogs(stream, parser, lastTime)
+		stream.Close()
+		if err != nil {
+			return err
+		}
+
+		// Log stream can be closed by API server before the container is terminated
+		pod, err := pods.Get(podName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("Unable to get pod %v: %v", podName, err)
+		}
+		if getTerminatedState(pod, containerName) != nil ||
+			pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			return nil
+		}
+		// Stream can be closed immediately again, e.g. if the container is restarting
+		time.Sleep(pollInterval)
+		opts.SinceTime = &metav1.Time{Time: lastTime}
+	}
+}
+
+// parseTimestampedLogs sends log lines after the given time to the parser.
+// It returns the time of the last parsed line.
+func parseTimestampedLogs(r io.Reader, parser *LogParser, after time.Time) (time.Time, error) {
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			line = strings.TrimSuffix(line, "\n")
+			ts, tsErr := time.Parse(time.RFC3339Nano, strings.SplitN(line, " ", 2)[0])
+			// Lines before the last parsed line are sent again after the stream is reopened
+			if tsErr != nil || ts.After(after) {
+				parser.ParseLine(line)
+				if tsErr == nil {
+					after = ts
+				}
+			}
+		}
+		if err == io.EOF {
+			return after, nil
+		} else if err != nil {
+			return after, fmt.Errorf("Failed to read logs: %v", err)
+		}
+	}
+}
+
+// WaitContainerTerminated polls the pod until the container is terminated and returns its terminated state.
+// Metrics collector waits for the named container, since processes of the shared process namespace
+// can't tell the training container from other sidecars, e.g. istio-proxy.
+func WaitContainerTerminated(pods typedcorev1.PodInterface, podName, containerName string, pollInterval, timeout time.Duration) (*corev1.ContainerStateTerminated, error) {
+	endTime := time.Now().Add(timeout)
+	for timeout == 0 || time.Now().Before(endTime) {
+		pod, err := pods.Get(podName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("Unable to get pod %v: %v", podName, err)
+		}
+		if state := getTerminatedState(pod, containerName); state != nil {
+			return state, nil
+		}
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			return nil, fmt.Errorf("Pod %v is completed without status of container %v", podName, containerName)
+		}
+		time.Sleep(pollInterval)
+	}
+	return nil, fmt.Errorf("Timed out waiting for container %v in pod %v to terminate", containerName, podName)
+}
+
+// getTerminatedState returns the terminated state of the container or nil if it is not terminated.
+func getTerminatedState(pod *corev1.Pod, containerName string) *corev1.ContainerStateTerminated {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerName {
+			return status.State.Terminated
+		}
+	}
+	return nil
+}
+
+// TailKubeletLogs reads the container log file on the node and sends log lines to the parser.
+// Log file is read until done is closed and the end of the file is reached.
+// Kubelet stores container logs in <log dir>/<namespace>_<pod name>_<pod uid>/<container name>/<restart count>.log,
+// podLogDir is the mounted directory of the pod.
+func TailKubeletLogs(podLogDir, containerName string, parser *LogParser, pollInterval time.Duration, done <-chan struct{}) error {
+	containerLogDir := filepath.Join(podLogDir, containerName)
+	var file *os.File
+	for file == nil {
+		logFile, err := getLatestLogFile(containerLogDir)
+		if err == nil {
+			if file, err = os.Open(logFile); err != nil {
+				return fmt.Errorf("Unable to open log file %v: %v", logFile, err)
+			}
+			continue
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+		select {
+		case <-done:
+			return fmt.Errorf("Log file of container %v is not found in %v", containerName, containerLogDir)
+		case <-time.After(pollInterval):
+		}
+	}
+	defer file.Close()
+
+	decoder := &kubeletLogDecoder{}
+	reader := bufio.NewReader(file)
+	pending := ""
+	finished := false
+	for {
+		chunk, err := reader.ReadString('\n')
+		pending += chunk
+		if err == nil {
+			if line, ok := decoder.decode(strings.TrimSuffix(pending, "\n")); ok {
+				parser.ParseLine(line)
+			}
+			pending = ""
+			continue
+		} else if err != io.EOF {
+			return fmt.Errorf("Failed to read log file %v: %v", file.Name(), err)
+		}
+		// End of the file is reached after the training is finished
+		if finished {
+			return nil
+		}
+		select {
+		case <-done:
+			finished = true
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// getLatestLogFile returns the log file of the last container restart.
+func getLatestLogFile(containerLogDir string) (string, error) {
+	files, err := ioutil.ReadDir(containerLogDir)
+	if err != nil {
+		return "", err
+	}
+	restarts := []int{}
+	for _, f := range files {
+		if restart, err := strconv.Atoi(strings.TrimSuffix(f.Name(), ".log")); err == nil && strings.HasSuffix(f.Name(), ".log") {
+			restarts = append(restarts, restart)
+		}
+	}
+	if len(restarts) == 0 {
+		return "", os.ErrNotExist
+	}
+	sort.Ints(restarts)
+	return filepath.Join(containerLogDir, fmt.Sprintf("%d.log", restarts[len(restarts)-1])), nil
+}
+
+// dockerLogLine is the line of Docker json-file log driver.
+type dockerLogLine struct {
+	Log  string `json:"log"`
+	Time string `json:"time"`
+}
+
+// kubeletLogDecoder converts the container log file lines to "<RFC3339 timestamp> <log>" lines.
+// CRI format: 2016-10-06T00:17:09.669794202Z stdout F log content
+// Docker format: {"log":"log content\n","stream":"stdout","time":"2016-10-06T00:17:09.669794202Z"}
+type kubeletLogDecoder struct {
+	partial string
+}
+
+// decode returns the log line if it is complete.
+// Partial lines are joined with the next lines.
+func (d *kubeletLogDecoder) decode(line string) (string, bool) {
+	timestamp, content, isPartial := "", "", false
+	if strings.HasPrefix(line, "{") {
+		dockerLine := dockerLogLine{}
+		if err := json.Unmarshal([]byte(line), &dockerLine); err != nil {
+			klog.Warningf("Unable to parse log line %s: %v", line, err)
+			return "", false
+		}
+		timestamp = dockerLine.Time
+		isPartial = !strings.HasSuffix(dockerLine.Log, "\n")
+		content = strings.TrimSuffix(dockerLine.Log, "\n")
+	} else {
+		// timestamp, stream, tag and content
+		fields := strings.SplitN(line, " ", 4)
+		if len(fields) < 3 {
+			klog.Warningf("Unable to parse log line %s", line)
+			return "", false
+		}
+		timestamp = fields[0]
+		isPartial = fields[2] == criPartialTag
+		if len(fields) == 4 {
+			content = fields[3]
+		}
+	}
+
+	if isPartial {
+		d.partial += content
+		return "", false
+	}
+	content = d.partial + content
+	d.partial = ""
+	return fmt.Sprintf("%s %s", timestamp, content), true
+}
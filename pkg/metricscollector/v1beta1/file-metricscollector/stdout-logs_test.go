@@ -0,0 +1,187 @@
+package sidecarmetricscollector
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestKubeletLogDecoder(t *testing.T) {
+	tcs := []struct {
+		lines           []string
+		expectedLines   []string
+		testDescription string
+	}{
+		{
+			lines: []string{
+				"2020-10-06T00:17:09.669794202Z stdout F epoch 1 loss=0.5",
+				"2020-10-06T00:17:10.669794202Z stderr F accuracy=0.8",
+				"2020-10-06T00:17:11.669794202Z stdout F ",
+			},
+			expectedLines: []string{
+				"2020-10-06T00:17:09.669794202Z epoch 1 loss=0.5",
+				"2020-10-06T00:17:10.669794202Z accuracy=0.8",
+				"2020-10-06T00:17:11.669794202Z ",
+			},
+			testDescription: "CRI log lines",
+		},
+		{
+			lines: []string{
+				"2020-10-06T00:17:09.669794202Z stdout P epoch 1 lo",
+				"2020-10-06T00:17:09.769794202Z stdout F ss=0.5",
+			},
+			expectedLines: []string{
+				"2020-10-06T00:17:09.769794202Z epoch 1 loss=0.5",
+			},
+			testDescription: "CRI partial log lines",
+		},
+		{
+			lines: []string{
+				`{"log":"epoch 1 lo","stream":"stdout","time":"2020-10-06T00:17:09.669794202Z"}`,
+				`{"log":"ss=0.5\n","stream":"stdout","time":"2020-10-06T00:17:09.769794202Z"}`,
+				`{"log":"accuracy=0.8\n","stream":"stderr","time":"2020-10-06T00:17:10.669794202Z"}`,
+			},
+			expectedLines: []string{
+				"2020-10-06T00:17:09.769794202Z epoch 1 loss=0.5",
+				"2020-10-06T00:17:10.669794202Z accuracy=0.8",
+			},
+			testDescription: "Docker json-file log lines",
+		},
+		{
+			lines: []string{
+				"invalid",
+				`{"log":`,
+			},
+			expectedLines:   []string{},
+			testDescription: "Invalid log lines are skipped",
+		},
+	}
+
+	for _, tc := range tcs {
+		decoder := &kubeletLogDecoder{}
+		lines := []string{}
+		for _, l := range tc.lines {
+			if line, ok := decoder.decode(l); ok {
+				lines = append(lines, line)
+			}
+		}
+		if !reflect.DeepEqual(lines, tc.expectedLines) {
+			t.Errorf("Case: %v failed. Expected lines: %v, got: %v", tc.testDescription, tc.expectedLines, lines)
+		}
+	}
+}
+
+func TestParseTimestampedLogs(t *testing.T) {
+	logs := strings.Join([]string{
+		"2020-10-06T00:17:09.669794202Z loss=0.5",
+		"2020-10-06T00:17:10.669794202Z loss=0.4",
+		"2020-10-06T00:17:11.669794202Z loss=0.3",
+	}, "\n")
+	after, _ := time.Parse(time.RFC3339Nano, "2020-10-06T00:17:09.669794202Z")
+
+	parser := NewLogParser([]string{"loss"}, nil)
+	lastTime, err := parseTimestampedLogs(strings.NewReader(logs), parser, after)
+	if err != nil {
+		t.Fatalf("Failed to parse logs: %v", err)
+	}
+	expectedLastTime, _ := time.Parse(time.RFC3339Nano, "2020-10-06T00:17:11.669794202Z")
+	if !lastTime.Equal(expectedLastTime) {
+		t.Errorf("Expected last time: %v, got: %v", expectedLastTime, lastTime)
+	}
+	values := []string{}
+	for _, mLog := range parser.ObservationLog().MetricLogs {
+		values = append(values, mLog.Metric.Value)
+	}
+	if expectedValues := []string{"0.4", "0.3"}; !reflect.DeepEqual(values, expectedValues) {
+		t.Errorf("Expected values after the last time: %v, got: %v", expectedValues, values)
+	}
+}
+
+func TestGetTerminatedState(t *testing.T) {
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  "istio-proxy",
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				},
+				{
+					Name:  "training",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}},
+				},
+			},
+		},
+	}
+
+	if state := getTerminatedState(pod, "training"); state == nil || state.ExitCode != 1 {
+		t.Errorf("Expected terminated state with exit code 1, got: %v", state)
+	}
+	if state := getTerminatedState(pod, "istio-proxy"); state != nil {
+		t.Errorf("Expected running container not to be terminated, got: %v", state)
+	}
+}
+
+func TestTailKubeletLogs(t *testing.T) {
+	logDir, err := ioutil.TempDir("", "kubelet-logs")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(logDir)
+
+	containerLogDir := filepath.Join(logDir, "training")
+	if err := os.MkdirAll(containerLogDir, 0755); err != nil {
+		t.Fatalf("Failed to create container log dir: %v", err)
+	}
+	restartLogs := map[string]string{
+		"0.log": "2020-10-06T00:17:09.669794202Z stdout F loss=0.9\n",
+		"1.log": "2020-10-06T00:18:09.669794202Z stdout F loss=0.5\n2020-10-06T00:18:10.669794202Z stdout P loss=",
+	}
+	for name, content := range restartLogs {
+		if err := ioutil.WriteFile(filepath.Join(containerLogDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write log file: %v", err)
+		}
+	}
+
+	parser := NewLogParser([]string{"loss"}, nil)
+	done := make(chan struct{})
+	tailErr := make(chan error, 1)
+	go func() {
+		tailErr <- TailKubeletLogs(logDir, "training", parser, 10*time.Millisecond, done)
+	}()
+
+	// Log line is appended while the log file is tailed
+	time.Sleep(50 * time.Millisecond)
+	f, err := os.OpenFile(filepath.Join(containerLogDir, "1.log"), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("Failed to open log file: %v", err)
+	}
+	fmt.Fprint(f, "\n2020-10-06T00:18:10.769794202Z stdout F 0.3\n")
+	f.Close()
+	close(done)
+
+	if err := <-tailErr; err != nil {
+		t.Fatalf("Failed to tail logs: %v", err)
+	}
+	expectedLogs := []*v1beta1.MetricLog{
+		{
+			TimeStamp: "2020-10-06T00:18:09.669794202Z",
+			Metric:    &v1beta1.Metric{Name: "loss", Value: "0.5"},
+		},
+		{
+			TimeStamp: "2020-10-06T00:18:10.769794202Z",
+			Metric:    &v1beta1.Metric{Name: "loss", Value: "0.3"},
+		},
+	}
+	if metricLogs := parser.ObservationLog().MetricLogs; !reflect.DeepEqual(metricLogs, expectedLogs) {
+		t.Errorf("Expected metric logs from the last restart: %v, got: %v", expectedLogs, metricLogs)
+	}
+}
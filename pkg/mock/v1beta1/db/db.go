@@ -7,6 +7,7 @@ package mock
 import (
 	gomock "github.com/golang/mock/gomock"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	reflect "reflect"
 )
 
@@ -45,8 +46,22 @@ func (mr *MockKatibDBInterfaceMockRecorder) DBInit() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DBInit", reflect.TypeOf((*MockKatibDBInterface)(nil).DBInit))
 }
 
+// DeleteArtifacts mocks base method.
+func (m *MockKatibDBInterface) DeleteArtifacts(arg0 common.TrialKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArtifacts", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArtifacts indicates an expected call of DeleteArtifacts.
+func (mr *MockKatibDBInterfaceMockRecorder) DeleteArtifacts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArtifacts", reflect.TypeOf((*MockKatibDBInterface)(nil).DeleteArtifacts), arg0)
+}
+
 // DeleteObservationLog mocks base method.
-func (m *MockKatibDBInterface) DeleteObservationLog(arg0 string) error {
+func (m *MockKatibDBInterface) DeleteObservationLog(arg0 common.TrialKey) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteObservationLog", arg0)
 	ret0, _ := ret[0].(error)
@@ -59,8 +74,37 @@ func (mr *MockKatibDBInterfaceMockRecorder) DeleteObservationLog(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).DeleteObservationLog), arg0)
 }
 
+// DeleteTrialLogs mocks base method.
+func (m *MockKatibDBInterface) DeleteTrialLogs(arg0 common.TrialKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrialLogs", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrialLogs indicates an expected call of DeleteTrialLogs.
+func (mr *MockKatibDBInterfaceMockRecorder) DeleteTrialLogs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrialLogs", reflect.TypeOf((*MockKatibDBInterface)(nil).DeleteTrialLogs), arg0)
+}
+
+// GetArtifacts mocks base method.
+func (m *MockKatibDBInterface) GetArtifacts(arg0 common.TrialKey) ([]*api_v1_beta1.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArtifacts", arg0)
+	ret0, _ := ret[0].([]*api_v1_beta1.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArtifacts indicates an expected call of GetArtifacts.
+func (mr *MockKatibDBInterfaceMockRecorder) GetArtifacts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtifacts", reflect.TypeOf((*MockKatibDBInterface)(nil).GetArtifacts), arg0)
+}
+
 // GetObservationLog mocks base method.
-func (m *MockKatibDBInterface) GetObservationLog(arg0, arg1, arg2, arg3 string) (*api_v1_beta1.ObservationLog, error) {
+func (m *MockKatibDBInterface) GetObservationLog(arg0 common.TrialKey, arg1, arg2, arg3 string) (*api_v1_beta1.ObservationLog, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetObservationLog", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*api_v1_beta1.ObservationLog)
@@ -74,8 +118,37 @@ func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLog(arg0, arg1, arg2,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLog), arg0, arg1, arg2, arg3)
 }
 
+// GetTrialLogs mocks base method.
+func (m *MockKatibDBInterface) GetTrialLogs(arg0 common.TrialKey) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrialLogs", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrialLogs indicates an expected call of GetTrialLogs.
+func (mr *MockKatibDBInterfaceMockRecorder) GetTrialLogs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialLogs", reflect.TypeOf((*MockKatibDBInterface)(nil).GetTrialLogs), arg0)
+}
+
+// RegisterArtifacts mocks base method.
+func (m *MockKatibDBInterface) RegisterArtifacts(arg0 common.TrialKey, arg1 []*api_v1_beta1.Artifact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterArtifacts", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterArtifacts indicates an expected call of RegisterArtifacts.
+func (mr *MockKatibDBInterfaceMockRecorder) RegisterArtifacts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterArtifacts", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterArtifacts), arg0, arg1)
+}
+
 // RegisterObservationLog mocks base method.
-func (m *MockKatibDBInterface) RegisterObservationLog(arg0 string, arg1 *api_v1_beta1.ObservationLog) error {
+func (m *MockKatibDBInterface) RegisterObservationLog(arg0 common.TrialKey, arg1 *api_v1_beta1.ObservationLog) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RegisterObservationLog", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -88,6 +161,20 @@ func (mr *MockKatibDBInterfaceMockRecorder) RegisterObservationLog(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterObservationLog), arg0, arg1)
 }
 
+// RegisterTrialLogs mocks base method.
+func (m *MockKatibDBInterface) RegisterTrialLogs(arg0 common.TrialKey, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTrialLogs", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterTrialLogs indicates an expected call of RegisterTrialLogs.
+func (mr *MockKatibDBInterfaceMockRecorder) RegisterTrialLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTrialLogs", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterTrialLogs), arg0, arg1)
+}
+
 // SelectOne mocks base method.
 func (m *MockKatibDBInterface) SelectOne() error {
 	m.ctrl.T.Helper()
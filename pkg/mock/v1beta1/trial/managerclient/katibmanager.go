@@ -49,6 +49,21 @@ func (mr *MockManagerClientMockRecorder) DeleteTrialObservationLog(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrialObservationLog", reflect.TypeOf((*MockManagerClient)(nil).DeleteTrialObservationLog), arg0)
 }
 
+// GetTrialArtifacts mocks base method.
+func (m *MockManagerClient) GetTrialArtifacts(arg0 *v1beta1.Trial) (*api_v1_beta1.GetArtifactsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrialArtifacts", arg0)
+	ret0, _ := ret[0].(*api_v1_beta1.GetArtifactsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrialArtifacts indicates an expected call of GetTrialArtifacts.
+func (mr *MockManagerClientMockRecorder) GetTrialArtifacts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialArtifacts", reflect.TypeOf((*MockManagerClient)(nil).GetTrialArtifacts), arg0)
+}
+
 // GetTrialObservationLog mocks base method.
 func (m *MockManagerClient) GetTrialObservationLog(arg0 *v1beta1.Trial) (*api_v1_beta1.GetObservationLogReply, error) {
 	m.ctrl.T.Helper()
@@ -63,3 +78,18 @@ func (mr *MockManagerClientMockRecorder) GetTrialObservationLog(arg0 interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialObservationLog", reflect.TypeOf((*MockManagerClient)(nil).GetTrialObservationLog), arg0)
 }
+
+// ReportTrialLogs mocks base method.
+func (m *MockManagerClient) ReportTrialLogs(arg0 *v1beta1.Trial, arg1 string) (*api_v1_beta1.ReportTrialLogsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportTrialLogs", arg0, arg1)
+	ret0, _ := ret[0].(*api_v1_beta1.ReportTrialLogsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportTrialLogs indicates an expected call of ReportTrialLogs.
+func (mr *MockManagerClientMockRecorder) ReportTrialLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportTrialLogs", reflect.TypeOf((*MockManagerClient)(nil).ReportTrialLogs), arg0, arg1)
+}
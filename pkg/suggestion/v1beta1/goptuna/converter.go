@@ -2,6 +2,7 @@ package suggestion_goptuna_v1beta1
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -85,6 +86,11 @@ func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]
 			return nil, errors.New("invalid parameter type")
 		}
 
+		distribution := p.GetFeasibleSpace().GetDistribution()
+		if distribution != api_v1_beta1.Distribution_UNIFORM && distribution != api_v1_beta1.Distribution_LOG_UNIFORM {
+			return nil, fmt.Errorf("%s distribution is not supported", distribution)
+		}
+
 		if p.ParameterType == api_v1_beta1.ParameterType_DOUBLE {
 			high, err := strconv.ParseFloat(p.GetFeasibleSpace().GetMax(), 64)
 			if err != nil {
@@ -96,7 +102,15 @@ func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]
 			}
 
 			stepstr := p.GetFeasibleSpace().GetStep()
-			if stepstr == "" {
+			if distribution == api_v1_beta1.Distribution_LOG_UNIFORM {
+				if stepstr != "" {
+					return nil, errors.New("step is not supported for log uniform distribution")
+				}
+				searchSpace[p.Name] = goptuna.LogUniformDistribution{
+					High: high,
+					Low:  low,
+				}
+			} else if stepstr == "" {
 				searchSpace[p.Name] = goptuna.UniformDistribution{
 					High: high,
 					Low:  low,
@@ -122,7 +136,16 @@ func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]
 				return nil, err
 			}
 			stepstr := p.GetFeasibleSpace().GetStep()
-			if stepstr == "" {
+			if distribution == api_v1_beta1.Distribution_LOG_UNIFORM {
+				if stepstr != "" {
+					return nil, errors.New("step is not supported for log uniform distribution")
+				}
+				// Goptuna doesn't have int log uniform distribution, the sampled value is rounded by sampleNextParam.
+				searchSpace[p.Name] = goptuna.LogUniformDistribution{
+					High: float64(high),
+					Low:  float64(low),
+				}
+			} else if stepstr == "" {
 				searchSpace[p.Name] = goptuna.IntUniformDistribution{
 					High: high,
 					Low:  low,
@@ -158,6 +181,19 @@ func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]
 	return searchSpace, nil
 }
 
+// toIntLogUniformParams returns names of int parameters with log uniform distribution,
+// which are sampled from goptuna.LogUniformDistribution and rounded.
+func toIntLogUniformParams(parameters []*api_v1_beta1.ParameterSpec) map[string]bool {
+	params := make(map[string]bool)
+	for _, p := range parameters {
+		if p.ParameterType == api_v1_beta1.ParameterType_INT &&
+			p.GetFeasibleSpace().GetDistribution() == api_v1_beta1.Distribution_LOG_UNIFORM {
+			params[p.Name] = true
+		}
+	}
+	return params
+}
+
 func toGoptunaState(condition api_v1_beta1.TrialStatus_TrialConditionType) (goptuna.TrialState, error) {
 	if condition == api_v1_beta1.TrialStatus_CREATED {
 		return goptuna.TrialStateRunning, nil
@@ -272,6 +308,13 @@ func toGoptunaParams(
 			}
 			internalParams[name] = p
 			externalParams[name] = d.ToExternalRepr(p)
+		case goptuna.LogUniformDistribution:
+			p, err := strconv.ParseFloat(valueStr, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			internalParams[name] = p
+			externalParams[name] = d.ToExternalRepr(p)
 		case goptuna.DiscreteUniformDistribution:
 			p, err := strconv.ParseFloat(valueStr, 64)
 			if err != nil {
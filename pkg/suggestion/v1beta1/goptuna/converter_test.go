@@ -83,6 +83,43 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Double parameter type with log uniform distribution",
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "0.1",
+						Min:          "0.0001",
+						Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"param-double": goptuna.LogUniformDistribution{
+					High: 0.1,
+					Low:  0.0001,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Double parameter type with normal distribution",
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "5.5",
+						Min:          "1.5",
+						Distribution: api_v1_beta1.Distribution_NORMAL,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Int parameter type",
 			parameters: []*api_v1_beta1.ParameterSpec{
@@ -125,6 +162,44 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Int parameter type with log uniform distribution",
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-int",
+					ParameterType: api_v1_beta1.ParameterType_INT,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "128",
+						Min:          "8",
+						Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"param-int": goptuna.LogUniformDistribution{
+					High: 128,
+					Low:  8,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Double parameter type with quantized log uniform distribution",
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "0.1",
+						Min:          "0.0001",
+						Step:         "0.0001",
+						Distribution: api_v1_beta1.Distribution_QLOG_UNIFORM,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "Discrete parameter type",
 			parameters: []*api_v1_beta1.ParameterSpec{
@@ -2,6 +2,7 @@ package suggestion_goptuna_v1beta1
 
 import (
 	"errors"
+	"math"
 	"reflect"
 	"strconv"
 
@@ -9,7 +10,17 @@ import (
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 )
 
-func sampleNextParam(study *goptuna.Study, searchSpace map[string]interface{}) (int, []*api_v1_beta1.ParameterAssignment, error) {
+// errTrialNotFound is returned if the study has no running trial with parameters of the Katib trial.
+var errTrialNotFound = errors.New("same trial parameter is not found")
+
+// sampleNextParam samples parameters of the new trial. Int parameters in intParams are sampled
+// from goptuna.LogUniformDistribution, so they are rounded and stored in the trial again
+// to be found by findGoptunaTrialIDByParam.
+func sampleNextParam(
+	study *goptuna.Study,
+	searchSpace map[string]interface{},
+	intParams map[string]bool,
+) (int, []*api_v1_beta1.ParameterAssignment, error) {
 	nextTrialID, err := study.Storage.CreateNewTrial(study.ID)
 	if err != nil {
 		return -1, nil, err
@@ -37,6 +48,22 @@ func sampleNextParam(study *goptuna.Study, searchSpace map[string]interface{}) (
 				Name:  name,
 				Value: strconv.FormatFloat(p, 'f', -1, 64),
 			})
+		case goptuna.LogUniformDistribution:
+			p, err := trial.SuggestLogFloat(name, distribution.Low, distribution.High)
+			if err != nil {
+				return nextTrialID, nil, err
+			}
+			if intParams[name] {
+				p = math.Round(p)
+				err = study.Storage.SetTrialParam(nextTrialID, name, p, distribution)
+				if err != nil {
+					return nextTrialID, nil, err
+				}
+			}
+			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
+				Name:  name,
+				Value: strconv.FormatFloat(p, 'f', -1, 64),
+			})
 		case goptuna.DiscreteUniformDistribution:
 			p, err := trial.SuggestDiscreteFloat(name, distribution.Low, distribution.High, distribution.Q)
 			if err != nil {
@@ -107,5 +134,5 @@ func findGoptunaTrialIDByParam(study *goptuna.Study, trialMapping map[string]int
 			return trials[i].ID, nil
 		}
 	}
-	return -1, errors.New("same trial parameter is not found")
+	return -1, errTrialNotFound
 }
@@ -0,0 +1,68 @@
+package suggestion_goptuna_v1beta1
+
+import (
+	"strconv"
+	"testing"
+
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestSampleNextParamIntLogUniform(t *testing.T) {
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: AlgorithmTPE,
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "metric-1",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "param-int",
+						ParameterType: api_v1_beta1.ParameterType_INT,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+							Max:          "128",
+							Min:          "8",
+							Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+						},
+					},
+				},
+			},
+		},
+	}
+	study, searchSpace, err := createStudyAndSearchSpace(experiment)
+	if err != nil {
+		t.Fatalf("createStudyAndSearchSpace() returns error: %v", err)
+	}
+	intParams := toIntLogUniformParams(experiment.GetSpec().GetParameterSpecs().GetParameters())
+
+	for i := 0; i < 10; i++ {
+		trialID, assignments, err := sampleNextParam(study, searchSpace, intParams)
+		if err != nil {
+			t.Fatalf("sampleNextParam() returns error: %v", err)
+		}
+		v, err := strconv.Atoi(assignments[0].GetValue())
+		if err != nil || v < 8 || v > 128 {
+			t.Errorf("sampleNextParam() should return int between 8 and 128, but got %v", assignments[0].GetValue())
+		}
+
+		// The Katib trial with the rounded value must be found in the study.
+		internalParams, externalParams, err := toGoptunaParams(assignments, searchSpace)
+		if err != nil {
+			t.Fatalf("toGoptunaParams() returns error: %v", err)
+		}
+		ktrial, err := study.Storage.GetTrial(trialID)
+		if err != nil {
+			t.Fatalf("GetTrial() returns error: %v", err)
+		}
+		ktrial.InternalParams = internalParams
+		ktrial.Params = externalParams
+		got, err := findGoptunaTrialIDByParam(study, map[string]int{}, ktrial)
+		if err != nil || got != trialID {
+			t.Errorf("findGoptunaTrialIDByParam() should return %v, but got %v, err: %v", trialID, got, err)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package suggestion_goptuna_v1beta1
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/c-bata/goptuna"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
@@ -17,39 +18,59 @@ const (
 	AlgorithmRandom = "random"
 
 	defaultStudyName = "Katib"
+
+	// defaultStateTTL is the time after which the study of the Experiment without requests is released.
+	// The study is created again from the Trials in the request if the Experiment is still running.
+	defaultStateTTL = 24 * time.Hour
 )
 
 func NewSuggestionService() *SuggestionService {
 	return &SuggestionService{
-		searchSpace:  nil,
-		study:        nil,
-		trialMapping: make(map[string]int),
+		experiments: make(map[experimentKey]*experimentState),
 	}
 }
 
+// SuggestionService keeps Goptuna study for each Experiment by the Experiment namespace, name and UID,
+// so the single service can be shared between many Experiments. Studies which are not used
+// for stateTTL are released, since the remote service isn't notified when the Experiment is deleted.
 type SuggestionService struct {
+	mu          sync.Mutex
+	experiments map[experimentKey]*experimentState // Katib Experiment -> Goptuna study
+	stateTTL    time.Duration
+}
+
+// experimentKey identifies the Experiment, UID distinguishes the Experiment which is recreated with the same name.
+type experimentKey struct {
+	namespace string
+	name      string
+	uid       string
+}
+
+type experimentState struct {
 	mu           sync.RWMutex
 	searchSpace  map[string]interface{}
+	intParams    map[string]bool // int parameters with log uniform distribution
 	study        *goptuna.Study
 	trialMapping map[string]int // Katib trial name -> Goptuna trial id
+	lastUsed     time.Time
 }
 
 func (s *SuggestionService) GetSuggestions(
 	ctx context.Context,
 	req *api_v1_beta1.GetSuggestionsRequest,
 ) (*api_v1_beta1.GetSuggestionsReply, error) {
-	err := s.initStudyAndSearchSpaceAtFirstRun(req.GetExperiment())
+	e, err := s.getExperimentState(req.GetExperiment())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to create goptuna study and search space: %s", err.Error())
 	}
 
 	objectMetricName := req.GetExperiment().GetSpec().GetObjective().GetObjectiveMetricName()
-	trials, err := toGoptunaTrials(req.GetTrials(), objectMetricName, s.study, s.searchSpace)
+	trials, err := toGoptunaTrials(req.GetTrials(), objectMetricName, e.study, e.searchSpace)
 	if err != nil {
 		klog.Errorf("Failed to convert to Goptuna trials: %s", err)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	err = s.syncTrials(trials)
+	err = e.syncTrials(trials)
 	if err != nil {
 		klog.Errorf("Failed to sync Goptuna trials: %s", err)
 		return nil, status.Error(codes.Internal, err.Error())
@@ -58,7 +79,7 @@ func (s *SuggestionService) GetSuggestions(
 	requestNumber := int(req.GetRequestNumber())
 	parameterAssignments := make([]*api_v1_beta1.GetSuggestionsReply_ParameterAssignments, requestNumber)
 	for i := 0; i < requestNumber; i++ {
-		trialID, assignments, err := sampleNextParam(s.study, s.searchSpace)
+		trialID, assignments, err := sampleNextParam(e.study, e.searchSpace, e.intParams)
 		if err != nil {
 			klog.Errorf("Failed to sample next param: trialID=%d, err=%s", trialID, err)
 			return nil, status.Error(codes.Internal, err.Error())
@@ -76,13 +97,13 @@ func (s *SuggestionService) GetSuggestions(
 }
 
 // Sync Goptuna trials with Katib trials.
-func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (err error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (e *experimentState) syncTrials(ktrials map[string]goptuna.FrozenTrial) (err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 
 	for katibTrialName := range ktrials {
 		ktrial := ktrials[katibTrialName]
-		gtrialID, found := s.trialMapping[katibTrialName]
+		gtrialID, found := e.trialMapping[katibTrialName]
 		if !found {
 			// In the CMA-ES algorithm, the parameters of Multivariate Normal Distribution MUST be updated by the
 			// solutions that are sampled from the same generation. To ensure this, Goptuna stores the trial
@@ -91,16 +112,21 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 			// But suggestion service cannot know which Katib trial name corresponds to Goptuna trial ID.
 			// Because Katib's trial name is determined by Katib controller after finished this gRPC call.
 			// So `findGoptunaTrialIDByParam()` returns the goptuna trial ID from the parameter values.
-			gtrialID, err = findGoptunaTrialIDByParam(s.study, s.trialMapping, ktrial)
+			gtrialID, err = findGoptunaTrialIDByParam(e.study, e.trialMapping, ktrial)
+			if err == errTrialNotFound {
+				// The trial was sampled before the study is created, e.g. by the service before restart
+				// or by the released study, so it is restored in the study from the Katib trial.
+				gtrialID, err = e.study.Storage.CloneTrial(e.study.ID, ktrial)
+			}
 			if err != nil {
 				klog.Errorf("Failed to find Goptuna Trial ID: trialName=%s, err=%s", katibTrialName, err)
 				return err
 			}
-			s.trialMapping[katibTrialName] = gtrialID
+			e.trialMapping[katibTrialName] = gtrialID
 			klog.Infof("Update trial mapping : trialName=%s -> trialID=%d", katibTrialName, gtrialID)
 		}
 
-		gtrial, err := s.study.Storage.GetTrial(gtrialID)
+		gtrial, err := e.study.Storage.GetTrial(gtrialID)
 		if err != nil {
 			return err
 		}
@@ -115,13 +141,13 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 		}
 
 		if ktrial.State == goptuna.TrialStateComplete {
-			err = s.study.Storage.SetTrialValue(gtrialID, ktrial.Value)
+			err = e.study.Storage.SetTrialValue(gtrialID, ktrial.Value)
 			if err != nil {
 				return err
 			}
 		}
 
-		err = s.study.Storage.SetTrialState(gtrialID, ktrial.State)
+		err = e.study.Storage.SetTrialState(gtrialID, ktrial.State)
 		if err != nil {
 			klog.Errorf("Failed to update state: %s", err)
 			return err
@@ -133,24 +159,67 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 	return nil
 }
 
-func (s *SuggestionService) initStudyAndSearchSpaceAtFirstRun(
+// getExperimentState returns the Goptuna study of the Experiment, it is created at the first run.
+func (s *SuggestionService) getExperimentState(
 	experiment *api_v1_beta1.Experiment,
-) error {
+) (*experimentState, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.study != nil && s.searchSpace != nil {
-		return nil
+	now := time.Now()
+	s.evictExperimentStates(now)
+	key := experimentKey{
+		namespace: experiment.GetNamespace(),
+		name:      experiment.GetName(),
+		uid:       experiment.GetUid(),
+	}
+	if e, ok := s.experiments[key]; ok {
+		e.lastUsed = now
+		return e, nil
 	}
 
 	study, searchSpace, err := createStudyAndSearchSpace(experiment)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	s.study = study
-	s.searchSpace = searchSpace
-	return nil
+	if s.experiments == nil {
+		s.experiments = make(map[experimentKey]*experimentState)
+	}
+	e := &experimentState{
+		searchSpace:  searchSpace,
+		intParams:    toIntLogUniformParams(experiment.GetSpec().GetParameterSpecs().GetParameters()),
+		study:        study,
+		trialMapping: make(map[string]int),
+		lastUsed:     now,
+	}
+	s.experiments[key] = e
+	return e, nil
+}
+
+// evictExperimentStates releases studies which are not used for stateTTL.
+func (s *SuggestionService) evictExperimentStates(now time.Time) {
+	ttl := s.stateTTL
+	if ttl == 0 {
+		ttl = defaultStateTTL
+	}
+	for key, e := range s.experiments {
+		if now.Sub(e.lastUsed) > ttl {
+			klog.Infof("Release Goptuna study of unused Experiment %s/%s", key.namespace, key.name)
+			delete(s.experiments, key)
+		}
+	}
+}
+
+// DeleteExperiment releases the Goptuna studies of the Experiment with all UIDs.
+func (s *SuggestionService) DeleteExperiment(namespace, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.experiments {
+		if key.namespace == namespace && key.name == name {
+			delete(s.experiments, key)
+		}
+	}
 }
 
 func (s *SuggestionService) ValidateAlgorithmSettings(
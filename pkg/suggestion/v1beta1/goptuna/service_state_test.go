@@ -0,0 +1,65 @@
+package suggestion_goptuna_v1beta1
+
+import (
+	"testing"
+	"time"
+
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestGetExperimentStateEviction(t *testing.T) {
+	s := NewSuggestionService()
+	s.stateTTL = time.Hour
+
+	newExperiment := func(namespace, name, uid string) *api_v1_beta1.Experiment {
+		return &api_v1_beta1.Experiment{
+			Name:      name,
+			Namespace: namespace,
+			Uid:       uid,
+			Spec: &api_v1_beta1.ExperimentSpec{
+				Algorithm: &api_v1_beta1.AlgorithmSpec{
+					AlgorithmName: AlgorithmRandom,
+				},
+				Objective: &api_v1_beta1.ObjectiveSpec{
+					Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+					ObjectiveMetricName: "metric-1",
+				},
+				ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+					Parameters: []*api_v1_beta1.ParameterSpec{
+						{
+							Name:          "param-1",
+							ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+							FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+								Max: "1",
+								Min: "0",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	unused, err := s.getExperimentState(newExperiment("kubeflow", "unused", "uid-1"))
+	if err != nil {
+		t.Fatalf("getExperimentState() returns error: %v", err)
+	}
+	unused.lastUsed = time.Now().Add(-2 * time.Hour)
+	if _, err := s.getExperimentState(newExperiment("kubeflow", "test", "uid-2")); err != nil {
+		t.Fatalf("getExperimentState() returns error: %v", err)
+	}
+	if _, err := s.getExperimentState(newExperiment("kubeflow", "test", "uid-3")); err != nil {
+		t.Fatalf("getExperimentState() returns error: %v", err)
+	}
+	if _, ok := s.experiments[experimentKey{namespace: "kubeflow", name: "unused", uid: "uid-1"}]; ok {
+		t.Errorf("Study of unused Experiment should be released")
+	}
+	if len(s.experiments) != 2 {
+		t.Errorf("Expected studies for both UIDs of the Experiment, got %v", s.experiments)
+	}
+
+	s.DeleteExperiment("kubeflow", "test")
+	if len(s.experiments) != 0 {
+		t.Errorf("Expected all studies of the deleted Experiment to be released, got %v", s.experiments)
+	}
+}
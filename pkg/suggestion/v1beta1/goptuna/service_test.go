@@ -171,3 +171,165 @@ func TestSuggestionService_GetSuggestions(t *testing.T) {
 		})
 	}
 }
+
+func TestSuggestionService_GetSuggestionsForManyExperiments(t *testing.T) {
+	ctx := context.TODO()
+	s := suggestion_goptuna_v1beta1.NewSuggestionService()
+
+	newRequest := func(namespace, experimentName, uid, parameterName string) *api_v1_beta1.GetSuggestionsRequest {
+		return &api_v1_beta1.GetSuggestionsRequest{
+			Experiment: &api_v1_beta1.Experiment{
+				Name:      experimentName,
+				Namespace: namespace,
+				Uid:       uid,
+				Spec: &api_v1_beta1.ExperimentSpec{
+					Algorithm: &api_v1_beta1.AlgorithmSpec{
+						AlgorithmName: "tpe",
+					},
+					Objective: &api_v1_beta1.ObjectiveSpec{
+						Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+						ObjectiveMetricName: "metric-1",
+					},
+					ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+						Parameters: []*api_v1_beta1.ParameterSpec{
+							{
+								Name:          parameterName,
+								ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+								FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+									Max: "1",
+									Min: "0",
+								},
+							},
+						},
+					},
+				},
+			},
+			RequestNumber: 1,
+		}
+	}
+
+	for _, tt := range []struct {
+		namespace      string
+		experimentName string
+		uid            string
+		parameterName  string
+	}{
+		{
+			namespace:      "kubeflow",
+			experimentName: "test-1",
+			uid:            "uid-1",
+			parameterName:  "param-1",
+		},
+		{
+			namespace:      "kubeflow",
+			experimentName: "test-2",
+			uid:            "uid-2",
+			parameterName:  "param-2",
+		},
+		{
+			namespace:      "kubeflow",
+			experimentName: "test-1",
+			uid:            "uid-1",
+			parameterName:  "param-1",
+		},
+		{
+			namespace:      "other",
+			experimentName: "test-1",
+			uid:            "uid-3",
+			parameterName:  "param-3",
+		},
+		{
+			namespace:      "kubeflow",
+			experimentName: "test-1",
+			uid:            "uid-4",
+			parameterName:  "param-4",
+		},
+	} {
+		reply, err := s.GetSuggestions(ctx, newRequest(tt.namespace, tt.experimentName, tt.uid, tt.parameterName))
+		if err != nil {
+			t.Errorf("GetSuggestions() for %v returns error: %v", tt.experimentName, err)
+			continue
+		}
+		assignments := reply.ParameterAssignments[0].Assignments
+		if len(assignments) != 1 || assignments[0].Name != tt.parameterName {
+			t.Errorf("GetSuggestions() for %v should return %v parameter, but got %#v", tt.experimentName, tt.parameterName, assignments)
+		}
+	}
+}
+
+func TestSuggestionService_GetSuggestionsAfterRestart(t *testing.T) {
+	newTrial := func(name, value string, condition api_v1_beta1.TrialStatus_TrialConditionType) *api_v1_beta1.Trial {
+		return &api_v1_beta1.Trial{
+			Name: name,
+			Spec: &api_v1_beta1.TrialSpec{
+				ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+					Assignments: []*api_v1_beta1.ParameterAssignment{
+						{
+							Name:  "param-1",
+							Value: value,
+						},
+					},
+				},
+			},
+			Status: &api_v1_beta1.TrialStatus{
+				Condition: condition,
+				Observation: &api_v1_beta1.Observation{
+					Metrics: []*api_v1_beta1.Metric{
+						{
+							Name:  "metric-1",
+							Value: "0.5",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for _, algorithmName := range []string{"cmaes", "tpe", "random"} {
+		// Trials were sampled by the service before restart, so the new study doesn't have them
+		s := suggestion_goptuna_v1beta1.NewSuggestionService()
+		req := &api_v1_beta1.GetSuggestionsRequest{
+			Experiment: &api_v1_beta1.Experiment{
+				Name:      "test",
+				Namespace: "kubeflow",
+				Uid:       "test-uid",
+				Spec: &api_v1_beta1.ExperimentSpec{
+					Algorithm: &api_v1_beta1.AlgorithmSpec{
+						AlgorithmName: algorithmName,
+					},
+					Objective: &api_v1_beta1.ObjectiveSpec{
+						Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+						ObjectiveMetricName: "metric-1",
+					},
+					ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+						Parameters: []*api_v1_beta1.ParameterSpec{
+							{
+								Name:          "param-1",
+								ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+								FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+									Max: "1",
+									Min: "0",
+								},
+							},
+						},
+					},
+				},
+			},
+			Trials: []*api_v1_beta1.Trial{
+				newTrial("trial-1", "0.1", api_v1_beta1.TrialStatus_SUCCEEDED),
+				newTrial("trial-2", "0.2", api_v1_beta1.TrialStatus_RUNNING),
+			},
+			RequestNumber: 1,
+		}
+		if _, err := s.GetSuggestions(context.TODO(), req); err != nil {
+			t.Errorf("GetSuggestions() for %v returns error: %v", algorithmName, err)
+			continue
+		}
+
+		// Trial which was running before restart is completed
+		req.Trials[1].Status.Condition = api_v1_beta1.TrialStatus_SUCCEEDED
+		if _, err := s.GetSuggestions(context.TODO(), req); err != nil {
+			t.Errorf("GetSuggestions() for %v returns error after Trial is completed: %v", algorithmName, err)
+		}
+	}
+}
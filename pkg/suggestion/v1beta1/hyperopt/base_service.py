@@ -2,7 +2,7 @@ import hyperopt
 import numpy as np
 import logging
 
-from pkg.suggestion.v1beta1.internal.constant import INTEGER, DOUBLE, CATEGORICAL, DISCRETE, MAX_GOAL
+from pkg.suggestion.v1beta1.internal.constant import INTEGER, DOUBLE, CATEGORICAL, DISCRETE, MAX_GOAL, LOG_UNIFORM, QLOG_UNIFORM, NORMAL
 from pkg.suggestion.v1beta1.internal.trial import Assignment
 
 logger = logging.getLogger(__name__)
@@ -42,16 +42,14 @@ class BaseHyperoptService(object):
         hyperopt_search_space = {}
         for param in self.search_space.params:
             if param.type == INTEGER:
-                hyperopt_search_space[param.name] = hyperopt.hp.quniform(
-                    param.name,
-                    float(param.min),
-                    float(param.max),
-                    float(param.step))
+                hyperopt_search_space[param.name] = self.create_hyperopt_distribution(
+                    param, float(param.step))
             elif param.type == DOUBLE:
-                hyperopt_search_space[param.name] = hyperopt.hp.uniform(
-                    param.name,
-                    float(param.min),
-                    float(param.max))
+                step = None
+                if param.step is not None and param.step != "":
+                    step = float(param.step)
+                hyperopt_search_space[param.name] = self.create_hyperopt_distribution(
+                    param, step)
             elif param.type == CATEGORICAL or param.type == DISCRETE:
                 hyperopt_search_space[param.name] = hyperopt.hp.choice(
                     param.name, param.list)
@@ -59,6 +57,27 @@ class BaseHyperoptService(object):
         self.hyperopt_domain = hyperopt.Domain(
             None, hyperopt_search_space, pass_expr_memo_ctrl=None)
 
+    @staticmethod
+    def create_hyperopt_distribution(param, step):
+        # Sample numeric parameter from its prior distribution, step quantizes sampled values.
+        # Normal distribution is unbounded and quantized values can be out of the feasible space,
+        # so the sampled values are clipped by convert().
+        low = float(param.min)
+        high = float(param.max)
+        if param.distribution == LOG_UNIFORM or param.distribution == QLOG_UNIFORM:
+            if step is None:
+                return hyperopt.hp.loguniform(param.name, np.log(low), np.log(high))
+            return hyperopt.hp.qloguniform(param.name, np.log(low), np.log(high), step)
+        elif param.distribution == NORMAL:
+            mu = (low + high) / 2
+            sigma = (high - low) / 6
+            if step is None:
+                return hyperopt.hp.normal(param.name, mu, sigma)
+            return hyperopt.hp.qnormal(param.name, mu, sigma, step)
+        if step is None:
+            return hyperopt.hp.uniform(param.name, low, high)
+        return hyperopt.hp.quniform(param.name, low, high, step)
+
     def create_fmin(self):
         self.fmin = hyperopt.FMinIter(
             self.hyperopt_algorithm,
@@ -232,10 +251,16 @@ class BaseHyperoptService(object):
         assignments = []
         for param in search_space.params:
             if param.type == INTEGER:
-                assignments.append(Assignment(param.name, int(vals[param.name][0])))
+                value = BaseHyperoptService.clip(param, vals[param.name][0])
+                assignments.append(Assignment(param.name, int(value)))
             elif param.type == DOUBLE:
-                assignments.append(Assignment(param.name, vals[param.name][0]))
+                value = BaseHyperoptService.clip(param, vals[param.name][0])
+                assignments.append(Assignment(param.name, value))
             elif param.type == CATEGORICAL or param.type == DISCRETE:
                 assignments.append(
                     Assignment(param.name, param.list[vals[param.name][0]]))
         return assignments
+
+    @staticmethod
+    def clip(param, value):
+        return min(max(value, float(param.min)), float(param.max))
@@ -1,4 +1,6 @@
 import logging
+import threading
+import time
 import grpc
 
 from pkg.apis.manager.v1beta1.python import api_pb2
@@ -11,35 +13,56 @@ from pkg.suggestion.v1beta1.internal.base_health_service import HealthServicer
 
 logger = logging.getLogger(__name__)
 
+# Base service of the Experiment without requests is released after this time in seconds.
+# It is created again from the Trials in the request if the Experiment is still running.
+DEFAULT_STATE_TTL = 24 * 60 * 60
+
 
 class HyperoptService(api_pb2_grpc.SuggestionServicer, HealthServicer):
 
-    def __init__(self):
+    def __init__(self, state_ttl=DEFAULT_STATE_TTL):
         super(HyperoptService, self).__init__()
-        self.base_service = None
-        self.is_first_run = True
+        # Base service for each Experiment namespace, name and UID, so the service can be shared
+        # between Experiments. gRPC server calls the service from many threads.
+        self.lock = threading.Lock()
+        self.experiments = {}
+        self.state_ttl = state_ttl
 
     def GetSuggestions(self, request, context):
         """
         Main function to provide suggestion.
         """
-        name, config = OptimizerConfiguration.convert_algorithm_spec(
-            request.experiment.spec.algorithm)
-
-        if self.is_first_run:
-            search_space = HyperParameterSearchSpace.convert(request.experiment)
-            self.base_service = BaseHyperoptService(
-                algorithm_name=name,
-                algorithm_conf=config,
-                search_space=search_space)
-            self.is_first_run = False
-
+        experiment = self._get_experiment_state(request.experiment)
         trials = Trial.convert(request.trials)
-        new_assignments = self.base_service.getSuggestions(trials, request.request_number)
+        with experiment.lock:
+            new_assignments = experiment.base_service.getSuggestions(trials, request.request_number)
         return api_pb2.GetSuggestionsReply(
             parameter_assignments=Assignment.generate(new_assignments)
         )
 
+    def _get_experiment_state(self, experiment):
+        key = (experiment.namespace, experiment.name, experiment.uid)
+        now = time.monotonic()
+        with self.lock:
+            # Remote service isn't notified when the Experiment is deleted, unused states are released.
+            for k, state in list(self.experiments.items()):
+                if now - state.last_used > self.state_ttl:
+                    logger.info("Release state of unused Experiment %s/%s", k[0], k[1])
+                    del self.experiments[k]
+
+            state = self.experiments.get(key)
+            if state is None:
+                name, config = OptimizerConfiguration.convert_algorithm_spec(
+                    experiment.spec.algorithm)
+                search_space = HyperParameterSearchSpace.convert(experiment)
+                state = ExperimentState(BaseHyperoptService(
+                    algorithm_name=name,
+                    algorithm_conf=config,
+                    search_space=search_space))
+                self.experiments[key] = state
+            state.last_used = now
+            return state
+
     def ValidateAlgorithmSettings(self, request, context):
         is_valid, message = OptimizerConfiguration.validate_algorithm_spec(
             request.experiment.spec.algorithm)
@@ -50,6 +73,14 @@ class HyperoptService(api_pb2_grpc.SuggestionServicer, HealthServicer):
         return api_pb2.ValidateAlgorithmSettingsReply()
 
 
+class ExperimentState(object):
+    def __init__(self, base_service):
+        self.base_service = base_service
+        # Base service of the Experiment can't sample suggestions concurrently.
+        self.lock = threading.Lock()
+        self.last_used = 0
+
+
 class OptimizerConfiguration:
     __conversion_dict = {
         'tpe': {
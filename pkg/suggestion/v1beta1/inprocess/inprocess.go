@@ -0,0 +1,85 @@
+// Package inprocess runs pure Go algorithm services inside katib-controller.
+// Suggestion controller calls registered algorithms directly through the Suggestion
+// server interface instead of creating algorithm Deployment and Service.
+package inprocess
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	suggestionapi "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	suggestiongoptuna "github.com/kubeflow/katib/pkg/suggestion/v1beta1/goptuna"
+)
+
+// ExperimentDeleter is implemented by algorithm services which keep state for each Experiment.
+type ExperimentDeleter interface {
+	// DeleteExperiment releases state of the Experiment with the given namespace and name.
+	DeleteExperiment(namespace, name string)
+}
+
+var (
+	mu sync.RWMutex
+	// AlgorithmRegistry is the map from the algorithm name to the in-process algorithm service.
+	AlgorithmRegistry = make(map[string]suggestionapi.SuggestionServer)
+)
+
+// Register registers the in-process algorithm service for the algorithm name.
+func Register(algorithmName string, server suggestionapi.SuggestionServer) {
+	mu.Lock()
+	defer mu.Unlock()
+	AlgorithmRegistry[algorithmName] = server
+}
+
+// IsRegistered returns true if the algorithm can be run in-process.
+func IsRegistered(algorithmName string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := AlgorithmRegistry[algorithmName]
+	return ok
+}
+
+// NewClient returns the Suggestion client which calls the in-process algorithm service directly.
+func NewClient(algorithmName string) (suggestionapi.SuggestionClient, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	server, ok := AlgorithmRegistry[algorithmName]
+	if !ok {
+		return nil, fmt.Errorf("Algorithm %v is not registered to run in-process", algorithmName)
+	}
+	return &client{server: server}, nil
+}
+
+// DeleteExperiment releases state of the Experiment in all in-process algorithm services.
+func DeleteExperiment(namespace, name string) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, server := range AlgorithmRegistry {
+		if deleter, ok := server.(ExperimentDeleter); ok {
+			deleter.DeleteExperiment(namespace, name)
+		}
+	}
+}
+
+// client implements Suggestion client on top of Suggestion server, call options are ignored.
+type client struct {
+	server suggestionapi.SuggestionServer
+}
+
+func (c *client) GetSuggestions(ctx context.Context, in *suggestionapi.GetSuggestionsRequest, opts ...grpc.CallOption) (*suggestionapi.GetSuggestionsReply, error) {
+	return c.server.GetSuggestions(ctx, in)
+}
+
+func (c *client) ValidateAlgorithmSettings(ctx context.Context, in *suggestionapi.ValidateAlgorithmSettingsRequest, opts ...grpc.CallOption) (*suggestionapi.ValidateAlgorithmSettingsReply, error) {
+	return c.server.ValidateAlgorithmSettings(ctx, in)
+}
+
+func init() {
+	// Goptuna service keeps the study for each Experiment, so one service serves all Goptuna algorithms.
+	goptunaService := suggestiongoptuna.NewSuggestionService()
+	Register(suggestiongoptuna.AlgorithmCMAES, goptunaService)
+	Register(suggestiongoptuna.AlgorithmTPE, goptunaService)
+	Register(suggestiongoptuna.AlgorithmRandom, goptunaService)
+}
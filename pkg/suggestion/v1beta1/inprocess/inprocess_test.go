@@ -0,0 +1,83 @@
+package inprocess
+
+import (
+	"context"
+	"testing"
+
+	suggestionapi "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestNewClient(t *testing.T) {
+	tcs := []struct {
+		algorithmName   string
+		err             bool
+		testDescription string
+	}{
+		{
+			algorithmName:   "tpe",
+			err:             false,
+			testDescription: "Registered Goptuna algorithm",
+		},
+		{
+			algorithmName:   "hyperband",
+			err:             true,
+			testDescription: "Algorithm which is not registered",
+		},
+	}
+
+	for _, tc := range tcs {
+		_, err := NewClient(tc.algorithmName)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
+func TestClientGetSuggestions(t *testing.T) {
+	rpcClient, err := NewClient("random")
+	if err != nil {
+		t.Fatalf("NewClient() returns error: %v", err)
+	}
+
+	request := &suggestionapi.GetSuggestionsRequest{
+		Experiment: &suggestionapi.Experiment{
+			Name:      "test",
+			Namespace: "kubeflow",
+			Uid:       "test-uid",
+			Spec: &suggestionapi.ExperimentSpec{
+				Algorithm: &suggestionapi.AlgorithmSpec{
+					AlgorithmName: "random",
+				},
+				Objective: &suggestionapi.ObjectiveSpec{
+					Type:                suggestionapi.ObjectiveType_MAXIMIZE,
+					ObjectiveMetricName: "metric-1",
+				},
+				ParameterSpecs: &suggestionapi.ExperimentSpec_ParameterSpecs{
+					Parameters: []*suggestionapi.ParameterSpec{
+						{
+							Name:          "param-1",
+							ParameterType: suggestionapi.ParameterType_DOUBLE,
+							FeasibleSpace: &suggestionapi.FeasibleSpace{
+								Max: "1",
+								Min: "0",
+							},
+						},
+					},
+				},
+			},
+		},
+		RequestNumber: 2,
+	}
+
+	reply, err := rpcClient.GetSuggestions(context.TODO(), request)
+	if err != nil {
+		t.Fatalf("GetSuggestions() returns error: %v", err)
+	}
+	if len(reply.ParameterAssignments) != 2 {
+		t.Errorf("GetSuggestions() should return 2 assignments, but got %v", len(reply.ParameterAssignments))
+	}
+
+	DeleteExperiment(request.Experiment.Namespace, request.Experiment.Name)
+}
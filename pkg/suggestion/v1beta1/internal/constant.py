@@ -5,3 +5,8 @@ INTEGER = "INTEGER"
 DOUBLE = "DOUBLE"
 CATEGORICAL = "CATEGORICAL"
 DISCRETE = "DISCRETE"
+
+UNIFORM = "UNIFORM"
+LOG_UNIFORM = "LOG_UNIFORM"
+QLOG_UNIFORM = "QLOG_UNIFORM"
+NORMAL = "NORMAL"
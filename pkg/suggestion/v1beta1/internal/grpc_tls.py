@@ -0,0 +1,38 @@
+import os
+
+import grpc
+
+# Env names of the TLS certificate paths, the same as in Go grpctls package.
+CERT_FILE_ENV_NAME = "KATIB_GRPC_TLS_CERT_FILE"
+KEY_FILE_ENV_NAME = "KATIB_GRPC_TLS_KEY_FILE"
+CA_FILE_ENV_NAME = "KATIB_GRPC_TLS_CA_FILE"
+CLIENT_AUTH_ENV_NAME = "KATIB_GRPC_TLS_CLIENT_AUTH"
+
+
+def _read_file(path):
+    with open(path, "rb") as f:
+        return f.read()
+
+
+def add_port(server, address):
+    """
+    Add the port to the gRPC server.
+    TLS is served if the certificate is set in the environment variables,
+    otherwise the port is insecure.
+    """
+    cert_file = os.environ.get(CERT_FILE_ENV_NAME, "")
+    if cert_file == "":
+        return server.add_insecure_port(address)
+
+    private_key = _read_file(os.environ.get(KEY_FILE_ENV_NAME, ""))
+    certificate = _read_file(cert_file)
+    client_auth = os.environ.get(CLIENT_AUTH_ENV_NAME, "").lower() == "true"
+    root_certificates = None
+    if client_auth:
+        root_certificates = _read_file(os.environ.get(CA_FILE_ENV_NAME, ""))
+
+    credentials = grpc.ssl_server_credentials(
+        [(private_key, certificate)],
+        root_certificates=root_certificates,
+        require_client_auth=client_auth)
+    return server.add_secure_port(address, credentials)
@@ -36,9 +36,11 @@ class HyperParameterSearchSpace(object):
             step = 1
             if p.feasible_space.step is not None and p.feasible_space.step != "":
                 step = p.feasible_space.step
-            return HyperParameter.int(p.name, p.feasible_space.min, p.feasible_space.max, step)
+            return HyperParameter.int(p.name, p.feasible_space.min, p.feasible_space.max, step,
+                                      HyperParameterSearchSpace.convertDistribution(p))
         elif p.parameter_type == api.DOUBLE:
-            return HyperParameter.double(p.name, p.feasible_space.min, p.feasible_space.max, p.feasible_space.step)
+            return HyperParameter.double(p.name, p.feasible_space.min, p.feasible_space.max, p.feasible_space.step,
+                                         HyperParameterSearchSpace.convertDistribution(p))
         elif p.parameter_type == api.CATEGORICAL:
             return HyperParameter.categorical(p.name, p.feasible_space.list)
         elif p.parameter_type == api.DISCRETE:
@@ -47,31 +49,42 @@ class HyperParameterSearchSpace(object):
             logger.error(
                 "Cannot get the type for the parameter: %s (%s)", p.name, p.parameter_type)
 
+    @staticmethod
+    def convertDistribution(p):
+        if p.feasible_space.distribution == api.LOG_UNIFORM:
+            return LOG_UNIFORM
+        elif p.feasible_space.distribution == api.QLOG_UNIFORM:
+            return QLOG_UNIFORM
+        elif p.feasible_space.distribution == api.NORMAL:
+            return NORMAL
+        return UNIFORM
+
 
 class HyperParameter(object):
-    def __init__(self, name, type_, min_, max_, list_, step):
+    def __init__(self, name, type_, min_, max_, list_, step, distribution=UNIFORM):
         self.name = name
         self.type = type_
         self.min = min_
         self.max = max_
         self.list = list_
         self.step = step
+        self.distribution = distribution
 
     def __str__(self):
         if self.type == INTEGER or self.type == DOUBLE:
-            return "HyperParameter(name: {}, type: {}, min: {}, max: {}, step: {})".format(
-                self.name, self.type, self.min, self.max, self.step)
+            return "HyperParameter(name: {}, type: {}, min: {}, max: {}, step: {}, distribution: {})".format(
+                self.name, self.type, self.min, self.max, self.step, self.distribution)
         else:
             return "HyperParameter(name: {}, type: {}, list: {})".format(
                 self.name, self.type, ", ".join(self.list))
 
     @staticmethod
-    def int(name, min_, max_, step):
-        return HyperParameter(name, INTEGER, min_, max_, [], step)
+    def int(name, min_, max_, step, distribution=UNIFORM):
+        return HyperParameter(name, INTEGER, min_, max_, [], step, distribution)
 
     @staticmethod
-    def double(name, min_, max_, step):
-        return HyperParameter(name, DOUBLE, min_, max_, [], step)
+    def double(name, min_, max_, step, distribution=UNIFORM):
+        return HyperParameter(name, DOUBLE, min_, max_, [], step, distribution)
 
     @staticmethod
     def categorical(name, lst):
@@ -0,0 +1,658 @@
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	suggestionv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// ServeAPI serves the versioned JSON REST API. All routes are described in the OpenAPI document
+// which is served under APIPrefix + "openapi.json".
+func (k *KatibUIHandler) ServeAPI(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, APIPrefix), "/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case path == "openapi.json":
+		if checkMethod(w, r, http.MethodGet) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(OpenAPIDocument))
+		}
+	case path == "namespaces":
+		if checkMethod(w, r, http.MethodGet) {
+			k.apiListNamespaces(w, r)
+		}
+	case path == "experiments":
+		if checkMethod(w, r, http.MethodGet) {
+			k.apiListExperiments(w, r, r.URL.Query().Get("namespace"))
+		}
+	case len(parts) >= 3 && parts[0] == "namespaces":
+		namespace := parts[1]
+		if errs := validation.IsDNS1123Label(namespace); len(errs) != 0 {
+			writeAPIError(w, http.StatusBadRequest, fmt.Errorf("Invalid namespace %v: %v", namespace, strings.Join(errs, ", ")))
+			return
+		}
+		k.serveNamespacedAPI(w, r, namespace, parts[2:])
+	default:
+		writeAPIError(w, http.StatusNotFound, fmt.Errorf("Path %v is not found", r.URL.Path))
+	}
+}
+
+// serveNamespacedAPI serves routes under namespaces/{namespace}/.
+func (k *KatibUIHandler) serveNamespacedAPI(w http.ResponseWriter, r *http.Request, namespace string, parts []string) {
+	if len(parts) == 1 && parts[0] == "experiments" {
+		if checkMethod(w, r, http.MethodGet) {
+			k.apiListExperiments(w, r, namespace)
+		}
+		return
+	}
+	if len(parts) < 2 {
+		writeAPIError(w, http.StatusNotFound, fmt.Errorf("Path %v is not found", r.URL.Path))
+		return
+	}
+	name := parts[1]
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) != 0 {
+		writeAPIError(w, http.StatusBadRequest, fmt.Errorf("Invalid name %v: %v", name, strings.Join(errs, ", ")))
+		return
+	}
+
+	switch route := parts[0] + "/" + strings.Join(parts[2:], "/"); route {
+	case "experiments/":
+		if checkMethod(w, r, http.MethodGet, http.MethodDelete) {
+			if r.Method == http.MethodDelete {
+				k.apiDeleteExperiment(w, r, namespace, name)
+			} else {
+				k.apiGetExperiment(w, r, namespace, name)
+			}
+		}
+	case "experiments/trials":
+		if checkMethod(w, r, http.MethodGet) {
+			k.apiListTrials(w, r, namespace, name)
+		}
+	case "experiments/events":
+		if checkMethod(w, r, http.MethodGet) {
+			k.apiWatchExperiment(w, r, namespace, name)
+		}
+	case "experiments/promote":
+		if checkMethod(w, r, http.MethodPost) {
+			k.apiPromoteBestTrial(w, r, namespace, name)
+		}
+	case "trials/":
+		if checkMethod(w, r, http.MethodGet) {
+			k.apiGetTrial(w, r, namespace, name)
+		}
+	case "trials/metrics":
+		if checkMethod(w, r, http.MethodGet) {
+			k.apiGetTrialMetrics(w, r, namespace, name)
+		}
+	case "trials/logs":
+		if checkMethod(w, r, http.MethodGet) {
+			k.apiGetTrialLogs(w, r, namespace, name)
+		}
+	case "suggestions/":
+		if checkMethod(w, r, http.MethodGet) {
+			k.apiGetSuggestion(w, r, namespace, name)
+		}
+	default:
+		writeAPIError(w, http.StatusNotFound, fmt.Errorf("Path %v is not found", r.URL.Path))
+	}
+}
+
+func (k *KatibUIHandler) apiListNamespaces(w http.ResponseWriter, r *http.Request) {
+	user, err := k.getUser(r)
+	if err != nil {
+		writeAPIError(w, http.StatusUnauthorized, err)
+		return
+	}
+	namespaces, err := k.getAvailableNamespaces()
+	if err == nil {
+		namespaces, err = k.filterNamespaces(user, VerbList, ResourceExperiments, namespaces)
+	}
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeAPIResponse(w, http.StatusOK, namespaces)
+}
+
+// apiListExperiments lists experiments in the namespace. If namespace is empty,
+// experiments are listed in all namespaces where user can list experiments.
+func (k *KatibUIHandler) apiListExperiments(w http.ResponseWriter, r *http.Request, namespace string) {
+	var experimentList *experimentv1beta1.ExperimentList
+	if namespace != "" {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) != 0 {
+			writeAPIError(w, http.StatusBadRequest, fmt.Errorf("Invalid namespace %v: %v", namespace, strings.Join(errs, ", ")))
+			return
+		}
+		if status, err := k.checkAPIAccess(r, VerbList, ResourceExperiments, namespace); err != nil {
+			writeAPIError(w, status, err)
+			return
+		}
+		el, err := k.katibClient.GetExperimentList(namespace)
+		if err != nil {
+			log.Printf("GetExperimentList failed: %v", err)
+			writeAPIError(w, http.StatusInternalServerError, err)
+			return
+		}
+		experimentList = el
+	} else {
+		user, err := k.getUser(r)
+		if err != nil {
+			writeAPIError(w, http.StatusUnauthorized, err)
+			return
+		}
+		// At first, try to list experiments in cluster scope
+		el, err := k.katibClient.GetExperimentList("")
+		if err != nil {
+			// If failed, just try to list experiments from own namespace
+			el, err = k.katibClient.GetExperimentList()
+		}
+		if err == nil {
+			el.Items, err = k.filterExperimentItems(user, el.Items)
+		}
+		if err != nil {
+			log.Printf("GetExperimentList failed: %v", err)
+			writeAPIError(w, http.StatusInternalServerError, err)
+			return
+		}
+		experimentList = el
+	}
+
+	response := APIExperimentList{Items: []APIExperiment{}}
+	for i := range experimentList.Items {
+		response.Items = append(response.Items, convertExperiment(&experimentList.Items[i]))
+	}
+	writeAPIResponse(w, http.StatusOK, response)
+}
+
+func (k *KatibUIHandler) apiGetExperiment(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	if status, err := k.checkAPIAccess(r, VerbGet, ResourceExperiments, namespace); err != nil {
+		writeAPIError(w, status, err)
+		return
+	}
+	experiment, err := k.katibClient.GetExperiment(name, namespace)
+	if err != nil {
+		writeKubernetesError(w, "GetExperiment", err)
+		return
+	}
+	writeAPIResponse(w, http.StatusOK, convertExperiment(experiment))
+}
+
+func (k *KatibUIHandler) apiDeleteExperiment(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	if status, err := k.checkAPIAccess(r, VerbDelete, ResourceExperiments, namespace); err != nil {
+		writeAPIError(w, status, err)
+		return
+	}
+	experiment, err := k.katibClient.GetExperiment(name, namespace)
+	if err != nil {
+		writeKubernetesError(w, "GetExperiment", err)
+		return
+	}
+	if err := k.katibClient.DeleteRuntimeObject(experiment); err != nil {
+		writeKubernetesError(w, "DeleteRuntimeObject", err)
+		return
+	}
+	writeAPIResponse(w, http.StatusOK, convertExperiment(experiment))
+}
+
+func (k *KatibUIHandler) apiListTrials(w http.ResponseWriter, r *http.Request, namespace, experimentName string) {
+	if status, err := k.checkAPIAccess(r, VerbList, ResourceTrials, namespace); err != nil {
+		writeAPIError(w, status, err)
+		return
+	}
+	trialList, err := k.katibClient.GetTrialList(experimentName, namespace)
+	if err != nil {
+		writeKubernetesError(w, "GetTrialList", err)
+		return
+	}
+	response := APITrialList{Items: []APITrial{}}
+	for i := range trialList.Items {
+		response.Items = append(response.Items, convertTrial(&trialList.Items[i]))
+	}
+	writeAPIResponse(w, http.StatusOK, response)
+}
+
+// apiPromoteBestTrial creates the job from the best Trial of the Experiment.
+// Fields of the request override spec.promoteBestTrial of the Experiment.
+// If dryRun is set, the job is only rendered. Otherwise the user must be allowed to create the job kind.
+func (k *KatibUIHandler) apiPromoteBestTrial(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	request := APIPromoteRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && err != io.EOF {
+		writeAPIError(w, http.StatusBadRequest, fmt.Errorf("Invalid request: %v", err))
+		return
+	}
+	if request.Name != "" {
+		if errs := validation.IsDNS1123Subdomain(request.Name); len(errs) != 0 {
+			writeAPIError(w, http.StatusBadRequest, fmt.Errorf("Invalid job name %v: %v", request.Name, strings.Join(errs, ", ")))
+			return
+		}
+	}
+	if status, err := k.checkAPIAccess(r, VerbCreate, ResourceExperiments, namespace); err != nil {
+		writeAPIError(w, status, err)
+		return
+	}
+	experiment, err := k.katibClient.GetExperiment(name, namespace)
+	if err != nil {
+		writeKubernetesError(w, "GetExperiment", err)
+		return
+	}
+	if experiment.Status.CurrentOptimalTrial.BestTrialName == "" {
+		writeAPIError(w, http.StatusConflict, fmt.Errorf("Experiment %v doesn't have the best trial", name))
+		return
+	}
+
+	spec := &experimentv1beta1.PromotionSpec{}
+	if experiment.Spec.PromoteBestTrial != nil {
+		spec = experiment.Spec.PromoteBestTrial.DeepCopy()
+	}
+	if request.Name != "" {
+		spec.Name = request.Name
+	}
+	for _, o := range request.ParameterOverrides {
+		spec.ParameterOverrides = append(spec.ParameterOverrides, commonv1beta1.ParameterAssignment{Name: o.Name, Value: o.Value})
+	}
+	if request.RunSpecPatch != "" {
+		spec.RunSpecPatch = request.RunSpecPatch
+	}
+
+	// Render and patch errors are caused by the request or the Experiment spec
+	job, err := k.promoter.RenderBestTrialJob(experiment, spec)
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, err)
+		return
+	}
+	if !request.DryRun {
+		// The job is created by the UI service account, so the user must be allowed to create its kind
+		resource, _ := meta.UnsafeGuessKindToResource(job.GroupVersionKind())
+		if _, status, err := k.checkAccessInGroup(r, VerbCreate, resource.Group, resource.Resource, job.GetNamespace()); err != nil {
+			writeAPIError(w, status, err)
+			return
+		}
+		if job, err = k.promoter.PromoteBestTrial(experiment, spec); err != nil {
+			if _, ok := err.(errors.APIStatus); ok {
+				writeKubernetesError(w, "PromoteBestTrial", err)
+			} else {
+				writeAPIError(w, http.StatusBadRequest, err)
+			}
+			return
+		}
+	}
+	manifest, err := yaml.Marshal(job.Object)
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	status := http.StatusCreated
+	if request.DryRun {
+		status = http.StatusOK
+	}
+	writeAPIResponse(w, status, APIPromotedJob{
+		APIVersion: job.GetAPIVersion(),
+		Kind:       job.GetKind(),
+		Name:       job.GetName(),
+		Namespace:  job.GetNamespace(),
+		Manifest:   string(manifest),
+	})
+}
+
+func (k *KatibUIHandler) apiGetTrial(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	if status, err := k.checkAPIAccess(r, VerbGet, ResourceTrials, namespace); err != nil {
+		writeAPIError(w, status, err)
+		return
+	}
+	trial, err := k.katibClient.GetTrial(name, namespace)
+	if err != nil {
+		writeKubernetesError(w, "GetTrial", err)
+		return
+	}
+	writeAPIResponse(w, http.StatusOK, convertTrial(trial))
+}
+
+// apiGetTrialMetrics returns the observation log of the Trial. Log can be filtered
+// by metricName, startTime and endTime query parameters, times are in RFC3339 format.
+func (k *KatibUIHandler) apiGetTrialMetrics(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	query := r.URL.Query()
+	for _, param := range []string{"startTime", "endTime"} {
+		if value := query.Get(param); value != "" {
+			if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+				writeAPIError(w, http.StatusBadRequest, fmt.Errorf("Invalid %v %v, it must be in RFC3339 format", param, value))
+				return
+			}
+		}
+	}
+	if status, err := k.checkAPIAccess(r, VerbGet, ResourceTrials, namespace); err != nil {
+		writeAPIError(w, status, err)
+		return
+	}
+	// Trial must exist in the namespace, observation logs are stored by the Trial namespace, name and UID.
+	trial, err := k.katibClient.GetTrial(name, namespace)
+	if err != nil {
+		writeKubernetesError(w, "GetTrial", err)
+		return
+	}
+
+	conn, c, err := k.connectManager()
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer conn.Close()
+
+	obsLogResp, err := c.GetObservationLog(
+		context.Background(),
+		&api_pb_v1beta1.GetObservationLogRequest{
+			TrialName:  name,
+			Namespace:  namespace,
+			TrialUid:   string(trial.UID),
+			MetricName: query.Get("metricName"),
+			StartTime:  query.Get("startTime"),
+			EndTime:    query.Get("endTime"),
+		},
+	)
+	if err != nil {
+		log.Printf("GetObservationLog failed: %v", err)
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	response := APIMetricLogList{Items: []APIMetricLog{}}
+	if obsLogResp.ObservationLog != nil {
+		for _, m := range obsLogResp.ObservationLog.MetricLogs {
+			response.Items = append(response.Items, APIMetricLog{
+				Name:      m.Metric.Name,
+				Timestamp: m.TimeStamp,
+				Value:     m.Metric.Value,
+			})
+		}
+	}
+	writeAPIResponse(w, http.StatusOK, response)
+}
+
+// apiGetTrialLogs returns the last part of the primary container logs which is stored
+// by Katib controller when the Trial is failed.
+func (k *KatibUIHandler) apiGetTrialLogs(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	if status, err := k.checkAPIAccess(r, VerbGet, ResourceTrials, namespace); err != nil {
+		writeAPIError(w, status, err)
+		return
+	}
+	trial, err := k.katibClient.GetTrial(name, namespace)
+	if err != nil {
+		writeKubernetesError(w, "GetTrial", err)
+		return
+	}
+
+	conn, c, err := k.connectManager()
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer conn.Close()
+
+	logsResp, err := c.GetTrialLogs(
+		context.Background(),
+		&api_pb_v1beta1.GetTrialLogsRequest{
+			TrialName: name,
+			Namespace: namespace,
+			TrialUid:  string(trial.UID),
+		},
+	)
+	if err != nil {
+		log.Printf("GetTrialLogs failed: %v", err)
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeAPIResponse(w, http.StatusOK, APITrialLogs{Logs: logsResp.Logs})
+}
+
+func (k *KatibUIHandler) apiGetSuggestion(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	if status, err := k.checkAPIAccess(r, VerbGet, ResourceSuggestions, namespace); err != nil {
+		writeAPIError(w, status, err)
+		return
+	}
+	suggestion, err := k.katibClient.GetSuggestion(name, namespace)
+	if err != nil {
+		writeKubernetesError(w, "GetSuggestion", err)
+		return
+	}
+	writeAPIResponse(w, http.StatusOK, convertSuggestion(suggestion))
+}
+
+// checkAPIAccess runs checkAccess and drops the user identity.
+func (k *KatibUIHandler) checkAPIAccess(r *http.Request, verb, resource, namespace string) (int, error) {
+	_, status, err := k.checkAccess(r, verb, resource, namespace)
+	return status, err
+}
+
+// filterExperimentItems returns experiments from namespaces where user can list experiments.
+func (k *KatibUIHandler) filterExperimentItems(user string, experiments []experimentv1beta1.Experiment) ([]experimentv1beta1.Experiment, error) {
+	namespaces := []string{}
+	seen := map[string]bool{}
+	for _, experiment := range experiments {
+		if !seen[experiment.Namespace] {
+			seen[experiment.Namespace] = true
+			namespaces = append(namespaces, experiment.Namespace)
+		}
+	}
+	allowedNamespaces, err := k.filterNamespaces(user, VerbList, ResourceExperiments, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	allowed := map[string]bool{}
+	for _, ns := range allowedNamespaces {
+		allowed[ns] = true
+	}
+	filteredExperiments := []experimentv1beta1.Experiment{}
+	for _, experiment := range experiments {
+		if allowed[experiment.Namespace] {
+			filteredExperiments = append(filteredExperiments, experiment)
+		}
+	}
+	return filteredExperiments, nil
+}
+
+// checkMethod returns true if request method is one of the allowed methods.
+// Otherwise, it writes 405 error to the response.
+func checkMethod(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, method := range methods {
+		if r.Method == method {
+			return true
+		}
+	}
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeAPIError(w, http.StatusMethodNotAllowed, fmt.Errorf("Method %v is not allowed", r.Method))
+	return false
+}
+
+func writeAPIResponse(w http.ResponseWriter, status int, response interface{}) {
+	body, err := json.Marshal(response)
+	if err != nil {
+		log.Printf("Marshal API response failed: %v", err)
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+func writeAPIError(w http.ResponseWriter, status int, err error) {
+	body, _ := json.Marshal(APIError{Code: status, Message: err.Error()})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// writeKubernetesError writes 404 if Kubernetes object is not found, 409 if it already exists,
+// 422 if it is invalid, otherwise 500.
+func writeKubernetesError(w http.ResponseWriter, operation string, err error) {
+	// Client errors, e.g. not found, forbidden or conflict, are returned with the status of the Kubernetes API
+	if status, ok := err.(errors.APIStatus); ok {
+		if code := int(status.Status().Code); code >= http.StatusBadRequest && code < http.StatusInternalServerError {
+			writeAPIError(w, code, err)
+			return
+		}
+	}
+	log.Printf("%v failed: %v", operation, err)
+	writeAPIError(w, http.StatusInternalServerError, err)
+}
+
+func convertExperiment(experiment *experimentv1beta1.Experiment) APIExperiment {
+	status := ""
+	if condition, err := experiment.GetLastConditionType(); err == nil {
+		status = string(condition)
+	}
+	experimentType := ExperimentTypeHP
+	if experiment.Spec.NasConfig != nil {
+		experimentType = ExperimentTypeNAS
+	}
+	apiExperiment := APIExperiment{
+		Name:                experiment.Name,
+		Namespace:           experiment.Namespace,
+		Type:                experimentType,
+		Status:              status,
+		Parameters:          []APIParameter{},
+		ParallelTrialCount:  experiment.Spec.ParallelTrialCount,
+		MaxTrialCount:       experiment.Spec.MaxTrialCount,
+		MaxFailedTrialCount: experiment.Spec.MaxFailedTrialCount,
+		Trials:              experiment.Status.Trials,
+		TrialsSucceeded:     experiment.Status.TrialsSucceeded,
+		TrialsFailed:        experiment.Status.TrialsFailed,
+		TrialsKilled:        experiment.Status.TrialsKilled,
+		TrialsPending:       experiment.Status.TrialsPending,
+		TrialsRunning:       experiment.Status.TrialsRunning,
+		CreationTime:        experiment.CreationTimestamp.Time,
+		StartTime:           convertTime(experiment.Status.StartTime),
+		CompletionTime:      convertTime(experiment.Status.CompletionTime),
+	}
+	if experiment.Spec.Objective != nil {
+		apiExperiment.Objective = APIObjective{
+			Type:                  string(experiment.Spec.Objective.Type),
+			Goal:                  experiment.Spec.Objective.Goal,
+			ObjectiveMetricName:   experiment.Spec.Objective.ObjectiveMetricName,
+			AdditionalMetricNames: experiment.Spec.Objective.AdditionalMetricNames,
+		}
+	}
+	if experiment.Spec.Algorithm != nil {
+		apiExperiment.Algorithm = experiment.Spec.Algorithm.AlgorithmName
+	}
+	for _, p := range experiment.Spec.Parameters {
+		apiExperiment.Parameters = append(apiExperiment.Parameters, APIParameter{
+			Name:          p.Name,
+			ParameterType: string(p.ParameterType),
+			Min:           p.FeasibleSpace.Min,
+			Max:           p.FeasibleSpace.Max,
+			Step:          p.FeasibleSpace.Step,
+			List:          p.FeasibleSpace.List,
+			Distribution:  string(p.FeasibleSpace.Distribution),
+		})
+	}
+	if job := experiment.Status.PromotedJob; job != nil {
+		apiExperiment.PromotedJob = &APIPromotedJob{
+			APIVersion: job.APIVersion,
+			Kind:       job.Kind,
+			Name:       job.Name,
+			Namespace:  job.Namespace,
+		}
+	}
+	if optimalTrial := experiment.Status.CurrentOptimalTrial; optimalTrial.BestTrialName != "" {
+		apiExperiment.CurrentOptimalTrial = &APIOptimalTrial{
+			Name:                 optimalTrial.BestTrialName,
+			ParameterAssignments: convertParameterAssignments(optimalTrial.ParameterAssignments),
+			Metrics:              convertMetrics(&optimalTrial.Observation),
+			Artifacts:            convertArtifacts(optimalTrial.Artifacts),
+		}
+	}
+	return apiExperiment
+}
+
+func convertTrial(trial *trialsv1beta1.Trial) APITrial {
+	status := ""
+	if condition, err := trial.GetLastConditionType(); err == nil {
+		status = string(condition)
+	}
+	return APITrial{
+		Name:                 trial.Name,
+		Namespace:            trial.Namespace,
+		Experiment:           trial.Labels[consts.LabelExperimentName],
+		Status:               status,
+		ParameterAssignments: convertParameterAssignments(trial.Spec.ParameterAssignments),
+		Metrics:              convertMetrics(trial.Status.Observation),
+		Artifacts:            convertArtifacts(trial.Status.Artifacts),
+		CreationTime:         trial.CreationTimestamp.Time,
+		StartTime:            convertTime(trial.Status.StartTime),
+		CompletionTime:       convertTime(trial.Status.CompletionTime),
+	}
+}
+
+func convertSuggestion(suggestion *suggestionv1beta1.Suggestion) APISuggestion {
+	status := ""
+	if len(suggestion.Status.Conditions) > 0 {
+		status = string(suggestion.Status.Conditions[len(suggestion.Status.Conditions)-1].Type)
+	}
+	apiSuggestion := APISuggestion{
+		Name:            suggestion.Name,
+		Namespace:       suggestion.Namespace,
+		Algorithm:       suggestion.Spec.AlgorithmName,
+		Status:          status,
+		Requests:        suggestion.Spec.Requests,
+		SuggestionCount: suggestion.Status.SuggestionCount,
+	}
+	if len(suggestion.Status.AlgorithmSettings) > 0 {
+		apiSuggestion.AlgorithmSettings = map[string]string{}
+		for _, s := range suggestion.Status.AlgorithmSettings {
+			apiSuggestion.AlgorithmSettings[s.Name] = s.Value
+		}
+	}
+	return apiSuggestion
+}
+
+func convertParameterAssignments(assignments []commonv1beta1.ParameterAssignment) []APIParameterAssignment {
+	apiAssignments := []APIParameterAssignment{}
+	for _, a := range assignments {
+		apiAssignments = append(apiAssignments, APIParameterAssignment{Name: a.Name, Value: a.Value})
+	}
+	return apiAssignments
+}
+
+func convertMetrics(observation *commonv1beta1.Observation) []APIMetric {
+	apiMetrics := []APIMetric{}
+	if observation == nil {
+		return apiMetrics
+	}
+	for _, m := range observation.Metrics {
+		apiMetrics = append(apiMetrics, APIMetric{Name: m.Name, Min: m.Min, Max: m.Max, Latest: m.Latest, Value: m.Value})
+	}
+	return apiMetrics
+}
+
+func convertArtifacts(artifacts []commonv1beta1.Artifact) []APIArtifact {
+	var apiArtifacts []APIArtifact
+	for _, a := range artifacts {
+		apiArtifacts = append(apiArtifacts, APIArtifact{Name: a.Name, URI: a.URI, Metadata: a.Metadata})
+	}
+	return apiArtifacts
+}
+
+func convertTime(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
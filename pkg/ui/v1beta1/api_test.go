@@ -0,0 +1,323 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	manifestmock "github.com/kubeflow/katib/pkg/mock/v1beta1/experiment/manifest"
+	katibclientmock "github.com/kubeflow/katib/pkg/mock/v1beta1/util/katibclient"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/promotion"
+)
+
+func TestServeAPI(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	kclient := katibclientmock.NewMockClient(mockCtrl)
+	generator := manifestmock.NewMockGenerator(mockCtrl)
+	k := &KatibUIHandler{katibClient: kclient, promoter: promotion.New(nil, generator)}
+
+	experiment := newFakeExperiment()
+	trial := newFakeTrial()
+
+	kclient.EXPECT().GetExperimentList("kubeflow").Return(&experimentv1beta1.ExperimentList{
+		Items: []experimentv1beta1.Experiment{*experiment},
+	}, nil).AnyTimes()
+	kclient.EXPECT().GetExperiment("random-experiment", "kubeflow").Return(experiment, nil).AnyTimes()
+	kclient.EXPECT().GetExperiment("not-found", "kubeflow").Return(nil,
+		errors.NewNotFound(schema.GroupResource{Group: "kubeflow.org", Resource: "experiments"}, "not-found")).AnyTimes()
+	kclient.EXPECT().GetTrialList("random-experiment", "kubeflow").Return(&trialsv1beta1.TrialList{
+		Items: []trialsv1beta1.Trial{*trial},
+	}, nil).AnyTimes()
+	generator.EXPECT().GetRunSpecWithHyperParameters(gomock.Any(), "best-job", "kubeflow", gomock.Any()).Return(
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"metadata":   map[string]interface{}{"name": "best-job", "namespace": "kubeflow"},
+		}}, nil).AnyTimes()
+
+	tcs := []struct {
+		method          string
+		path            string
+		body            string
+		expectedStatus  int
+		response        interface{}
+		testDescription string
+	}{
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "namespaces/kubeflow/experiments",
+			expectedStatus:  http.StatusOK,
+			response:        &APIExperimentList{},
+			testDescription: "List Experiments in namespace",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "namespaces/kubeflow/experiments/random-experiment",
+			expectedStatus:  http.StatusOK,
+			response:        &APIExperiment{},
+			testDescription: "Get Experiment",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "namespaces/kubeflow/experiments/random-experiment/trials",
+			expectedStatus:  http.StatusOK,
+			response:        &APITrialList{},
+			testDescription: "List Trials of Experiment",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "openapi.json",
+			expectedStatus:  http.StatusOK,
+			response:        &map[string]interface{}{},
+			testDescription: "Get OpenAPI document",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "namespaces/kubeflow/experiments/not-found",
+			expectedStatus:  http.StatusNotFound,
+			response:        &APIError{},
+			testDescription: "Experiment is not found",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "namespaces/Invalid_Namespace/experiments",
+			expectedStatus:  http.StatusBadRequest,
+			response:        &APIError{},
+			testDescription: "Invalid namespace",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "experiments?namespace=Invalid_Namespace",
+			expectedStatus:  http.StatusBadRequest,
+			response:        &APIError{},
+			testDescription: "Invalid namespace in query",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "namespaces/kubeflow/trials/Invalid_Trial",
+			expectedStatus:  http.StatusBadRequest,
+			response:        &APIError{},
+			testDescription: "Invalid Trial name",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "namespaces/kubeflow/trials/random-trial/metrics?startTime=yesterday",
+			expectedStatus:  http.StatusBadRequest,
+			response:        &APIError{},
+			testDescription: "Invalid start time of metrics",
+		},
+		{
+			method:          http.MethodPost,
+			path:            APIPrefix + "namespaces/kubeflow/trials/random-trial/logs",
+			expectedStatus:  http.StatusMethodNotAllowed,
+			response:        &APIError{},
+			testDescription: "Trial logs require GET",
+		},
+		{
+			method:          http.MethodPost,
+			path:            APIPrefix + "namespaces/kubeflow/experiments/random-experiment",
+			expectedStatus:  http.StatusMethodNotAllowed,
+			response:        &APIError{},
+			testDescription: "Method is not allowed",
+		},
+		{
+			method:          http.MethodPost,
+			path:            APIPrefix + "namespaces/kubeflow/experiments/random-experiment/promote",
+			body:            `{"name": "best-job", "parameterOverrides": [{"name": "lr", "value": "0.02"}], "dryRun": true}`,
+			expectedStatus:  http.StatusOK,
+			response:        &APIPromotedJob{},
+			testDescription: "Dry run of best Trial promotion",
+		},
+		{
+			method:          http.MethodPost,
+			path:            APIPrefix + "namespaces/kubeflow/experiments/random-experiment/promote",
+			body:            `{"name": "Invalid_Job"}`,
+			expectedStatus:  http.StatusBadRequest,
+			response:        &APIError{},
+			testDescription: "Invalid promoted job name",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "namespaces/kubeflow/experiments/random-experiment/promote",
+			expectedStatus:  http.StatusMethodNotAllowed,
+			response:        &APIError{},
+			testDescription: "Promotion requires POST",
+		},
+		{
+			method:          http.MethodGet,
+			path:            APIPrefix + "namespaces/kubeflow/jobs/random-job",
+			expectedStatus:  http.StatusNotFound,
+			response:        &APIError{},
+			testDescription: "Unknown path",
+		},
+	}
+
+	for _, tc := range tcs {
+		req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+		rec := httptest.NewRecorder()
+		k.ServeAPI(rec, req)
+
+		if rec.Code != tc.expectedStatus {
+			t.Errorf("Case: %v failed. Expected status %v, got %v: %v", tc.testDescription, tc.expectedStatus, rec.Code, rec.Body.String())
+			continue
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), tc.response); err != nil {
+			t.Errorf("Case: %v failed. Response is not valid JSON: %v", tc.testDescription, err)
+		}
+	}
+}
+
+func TestWriteKubernetesError(t *testing.T) {
+	jobs := schema.GroupResource{Group: "batch", Resource: "jobs"}
+	tcs := []struct {
+		err             error
+		expectedStatus  int
+		testDescription string
+	}{
+		{
+			err:             errors.NewForbidden(jobs, "best-job", fmt.Errorf("denied")),
+			expectedStatus:  http.StatusForbidden,
+			testDescription: "Forbidden error",
+		},
+		{
+			err:             errors.NewConflict(jobs, "best-job", fmt.Errorf("conflict")),
+			expectedStatus:  http.StatusConflict,
+			testDescription: "Conflict error",
+		},
+		{
+			err:             errors.NewInternalError(fmt.Errorf("internal")),
+			expectedStatus:  http.StatusInternalServerError,
+			testDescription: "Internal error",
+		},
+		{
+			err:             fmt.Errorf("connection refused"),
+			expectedStatus:  http.StatusInternalServerError,
+			testDescription: "Not Kubernetes API error",
+		},
+	}
+	for _, tc := range tcs {
+		rec := httptest.NewRecorder()
+		writeKubernetesError(rec, "Test", tc.err)
+		if rec.Code != tc.expectedStatus {
+			t.Errorf("Case: %v failed. Expected status %v, got %v", tc.testDescription, tc.expectedStatus, rec.Code)
+		}
+	}
+}
+
+func TestConvertExperiment(t *testing.T) {
+	apiExperiment := convertExperiment(newFakeExperiment())
+
+	if apiExperiment.Status != string(experimentv1beta1.ExperimentRunning) {
+		t.Errorf("Expected status %v, got %v", experimentv1beta1.ExperimentRunning, apiExperiment.Status)
+	}
+	if apiExperiment.Type != ExperimentTypeHP {
+		t.Errorf("Expected type %v, got %v", ExperimentTypeHP, apiExperiment.Type)
+	}
+	if apiExperiment.Objective.ObjectiveMetricName != "accuracy" {
+		t.Errorf("Expected objective metric accuracy, got %v", apiExperiment.Objective.ObjectiveMetricName)
+	}
+	if len(apiExperiment.Parameters) != 1 || apiExperiment.Parameters[0].Max != "0.05" {
+		t.Errorf("Unexpected parameters %v", apiExperiment.Parameters)
+	}
+	if apiExperiment.CurrentOptimalTrial == nil || apiExperiment.CurrentOptimalTrial.Metrics[0].Latest != "0.95" {
+		t.Errorf("Unexpected current optimal Trial %v", apiExperiment.CurrentOptimalTrial)
+	} else if len(apiExperiment.CurrentOptimalTrial.Artifacts) != 1 || apiExperiment.CurrentOptimalTrial.Artifacts[0].URI != "s3://bucket/random-trial" {
+		t.Errorf("Unexpected current optimal Trial artifacts %v", apiExperiment.CurrentOptimalTrial.Artifacts)
+	}
+}
+
+func newFakeExperiment() *experimentv1beta1.Experiment {
+	return &experimentv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "random-experiment",
+			Namespace: "kubeflow",
+		},
+		Spec: experimentv1beta1.ExperimentSpec{
+			Objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "accuracy",
+			},
+			Algorithm: &commonv1beta1.AlgorithmSpec{
+				AlgorithmName: "random",
+			},
+			Parameters: []experimentv1beta1.ParameterSpec{
+				{
+					Name:          "lr",
+					ParameterType: experimentv1beta1.ParameterTypeDouble,
+					FeasibleSpace: experimentv1beta1.FeasibleSpace{
+						Min: "0.01",
+						Max: "0.05",
+					},
+				},
+			},
+		},
+		Status: experimentv1beta1.ExperimentStatus{
+			Conditions: []experimentv1beta1.ExperimentCondition{
+				{
+					Type: experimentv1beta1.ExperimentCreated,
+				},
+				{
+					Type: experimentv1beta1.ExperimentRunning,
+				},
+			},
+			CurrentOptimalTrial: experimentv1beta1.OptimalTrial{
+				BestTrialName: "random-trial",
+				ParameterAssignments: []commonv1beta1.ParameterAssignment{
+					{
+						Name:  "lr",
+						Value: "0.03",
+					},
+				},
+				Observation: commonv1beta1.Observation{
+					Metrics: []commonv1beta1.Metric{
+						{
+							Name:   "accuracy",
+							Latest: "0.95",
+						},
+					},
+				},
+				Artifacts: []commonv1beta1.Artifact{
+					{
+						Name: "model",
+						URI:  "s3://bucket/random-trial",
+					},
+				},
+			},
+		},
+	}
+}
+
+func newFakeTrial() *trialsv1beta1.Trial {
+	return &trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "random-trial",
+			Namespace: "kubeflow",
+			Labels: map[string]string{
+				consts.LabelExperimentName: "random-experiment",
+			},
+		},
+		Spec: trialsv1beta1.TrialSpec{
+			ParameterAssignments: []commonv1beta1.ParameterAssignment{
+				{
+					Name:  "lr",
+					Value: "0.03",
+				},
+			},
+		},
+	}
+}
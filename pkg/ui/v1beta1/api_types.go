@@ -0,0 +1,189 @@
+package v1beta1
+
+import (
+	"time"
+)
+
+// APIPrefix is the path prefix of the versioned JSON REST API.
+const APIPrefix = "/katib/api/v1beta1/"
+
+// APIError is the response of the REST API in case of error.
+type APIError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// APIExperimentList is the list of Experiments.
+type APIExperimentList struct {
+	Items []APIExperiment `json:"items"`
+}
+
+// APIExperiment describes the Experiment spec and status.
+type APIExperiment struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Type is hp or nas.
+	Type   string `json:"type"`
+	Status string `json:"status"`
+
+	Objective  APIObjective   `json:"objective"`
+	Algorithm  string         `json:"algorithm"`
+	Parameters []APIParameter `json:"parameters"`
+
+	ParallelTrialCount  *int32 `json:"parallelTrialCount,omitempty"`
+	MaxTrialCount       *int32 `json:"maxTrialCount,omitempty"`
+	MaxFailedTrialCount *int32 `json:"maxFailedTrialCount,omitempty"`
+
+	Trials          int32 `json:"trials"`
+	TrialsSucceeded int32 `json:"trialsSucceeded"`
+	TrialsFailed    int32 `json:"trialsFailed"`
+	TrialsKilled    int32 `json:"trialsKilled"`
+	TrialsPending   int32 `json:"trialsPending"`
+	TrialsRunning   int32 `json:"trialsRunning"`
+
+	// CurrentOptimalTrial is nil until the first Trial is succeeded.
+	CurrentOptimalTrial *APIOptimalTrial `json:"currentOptimalTrial,omitempty"`
+	// PromotedJob is the job created from the best Trial by spec.promoteBestTrial.
+	PromotedJob *APIPromotedJob `json:"promotedJob,omitempty"`
+
+	CreationTime   time.Time  `json:"creationTime"`
+	StartTime      *time.Time `json:"startTime,omitempty"`
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+}
+
+// APIObjective describes the objective of the Experiment.
+type APIObjective struct {
+	Type                  string   `json:"type"`
+	Goal                  *float64 `json:"goal,omitempty"`
+	ObjectiveMetricName   string   `json:"objectiveMetricName"`
+	AdditionalMetricNames []string `json:"additionalMetricNames,omitempty"`
+}
+
+// APIParameter describes the search space of the parameter.
+type APIParameter struct {
+	Name          string   `json:"name"`
+	ParameterType string   `json:"parameterType"`
+	Min           string   `json:"min,omitempty"`
+	Max           string   `json:"max,omitempty"`
+	Step          string   `json:"step,omitempty"`
+	List          []string `json:"list,omitempty"`
+	Distribution  string   `json:"distribution,omitempty"`
+}
+
+// APIParameterAssignment is the value of the parameter in the Trial.
+type APIParameterAssignment struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// APIMetric is the observed metric of the Trial.
+type APIMetric struct {
+	Name   string `json:"name"`
+	Min    string `json:"min,omitempty"`
+	Max    string `json:"max,omitempty"`
+	Latest string `json:"latest,omitempty"`
+	Value  string `json:"value,omitempty"`
+}
+
+// APIArtifact is a checkpoint, model or other output reported by the Trial.
+type APIArtifact struct {
+	Name     string            `json:"name"`
+	URI      string            `json:"uri"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// APIOptimalTrial describes the best Trial of the Experiment.
+type APIOptimalTrial struct {
+	Name                 string                   `json:"name"`
+	ParameterAssignments []APIParameterAssignment `json:"parameterAssignments"`
+	Metrics              []APIMetric              `json:"metrics"`
+	Artifacts            []APIArtifact            `json:"artifacts,omitempty"`
+}
+
+// APIPromoteRequest describes the job which is created from the best Trial.
+// Empty fields are taken from spec.promoteBestTrial of the Experiment.
+type APIPromoteRequest struct {
+	Name               string                   `json:"name,omitempty"`
+	ParameterOverrides []APIParameterAssignment `json:"parameterOverrides,omitempty"`
+	RunSpecPatch       string                   `json:"runSpecPatch,omitempty"`
+	// DryRun renders the job without creating it.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// APIPromotedJob is the job created from the best Trial.
+type APIPromotedJob struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	// Manifest is the rendered job in YAML format.
+	Manifest string `json:"manifest,omitempty"`
+}
+
+// APITrialList is the list of Trials.
+type APITrialList struct {
+	Items []APITrial `json:"items"`
+}
+
+// APITrial describes the Trial parameters and observation.
+type APITrial struct {
+	Name                 string                   `json:"name"`
+	Namespace            string                   `json:"namespace"`
+	Experiment           string                   `json:"experiment"`
+	Status               string                   `json:"status"`
+	ParameterAssignments []APIParameterAssignment `json:"parameterAssignments"`
+	Metrics              []APIMetric              `json:"metrics"`
+	Artifacts            []APIArtifact            `json:"artifacts,omitempty"`
+
+	CreationTime   time.Time  `json:"creationTime"`
+	StartTime      *time.Time `json:"startTime,omitempty"`
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+}
+
+// APIMetricLogList is the observation log of the Trial.
+type APIMetricLogList struct {
+	Items []APIMetricLog `json:"items"`
+}
+
+// APIMetricLog is the single metric value reported by the Trial.
+type APIMetricLog struct {
+	Name      string `json:"name"`
+	Timestamp string `json:"timestamp"`
+	Value     string `json:"value"`
+}
+
+// APITrialLogs is the last part of the failed Trial primary container logs.
+type APITrialLogs struct {
+	Logs string `json:"logs"`
+}
+
+// APISuggestion describes the Suggestion status.
+type APISuggestion struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace"`
+	Algorithm         string            `json:"algorithm"`
+	Status            string            `json:"status"`
+	Requests          int32             `json:"requests"`
+	SuggestionCount   int32             `json:"suggestionCount"`
+	AlgorithmSettings map[string]string `json:"algorithmSettings,omitempty"`
+}
+
+// APIExperimentEvent is the change of the Experiment in the event stream.
+type APIExperimentEvent struct {
+	// Type is ADDED, MODIFIED or DELETED.
+	Type       string        `json:"type"`
+	Experiment APIExperiment `json:"experiment"`
+}
+
+// APITrialEvent is the change of the Trial status in the event stream.
+type APITrialEvent struct {
+	// Type is ADDED, MODIFIED or DELETED.
+	Type  string   `json:"type"`
+	Trial APITrial `json:"trial"`
+}
+
+// APIMetricLogEvent is the list of new metric points reported by the Trial in the event stream.
+type APIMetricLogEvent struct {
+	Trial string         `json:"trial"`
+	Items []APIMetricLog `json:"items"`
+}
@@ -0,0 +1,173 @@
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+
+	experimentv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+const (
+	// Kubernetes API verbs which are checked by the UI backend
+	VerbGet    = "get"
+	VerbList   = "list"
+	VerbWatch  = "watch"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+
+	// Resources which are accessed by the UI backend
+	ResourceExperiments = "experiments"
+	ResourceTrials      = "trials"
+	ResourceSuggestions = "suggestions"
+	ResourceConfigMaps  = "configmaps"
+)
+
+// AuthConfig describes how the UI backend gets the user identity from the request.
+// The identity must be set by the authenticating proxy in front of the UI, e.g. Kubeflow or OIDC proxy.
+type AuthConfig struct {
+	// UserHeader is the name of the request header with the user identity, e.g. kubeflow-userid.
+	// If it is empty, authorization is disabled and UI acts with its own service account.
+	UserHeader string
+	// UserPrefix is the prefix which is trimmed from the user header value, e.g. accounts.google.com:
+	UserPrefix string
+}
+
+// Enabled returns true if the UI backend must authorize requests.
+func (a AuthConfig) Enabled() bool {
+	return a.UserHeader != ""
+}
+
+// errUnauthenticated is returned when request doesn't have the user identity.
+var errUnauthenticated = fmt.Errorf("user identity is not found in the request")
+
+// resourceGroup returns the API group of the resource accessed by the UI backend.
+func resourceGroup(resource string) string {
+	if resource == ResourceConfigMaps {
+		return ""
+	}
+	return experimentv1beta1.SchemeGroupVersion.Group
+}
+
+// getUser returns the user identity from the request.
+func (k *KatibUIHandler) getUser(r *http.Request) (string, error) {
+	if !k.authConfig.Enabled() {
+		return "", nil
+	}
+	user := strings.TrimPrefix(r.Header.Get(k.authConfig.UserHeader), k.authConfig.UserPrefix)
+	if user == "" {
+		return "", errUnauthenticated
+	}
+	return user, nil
+}
+
+// isAllowed runs SubjectAccessReview to check if the user can perform the verb
+// on the resource in the namespace. All actions are allowed if authorization is disabled.
+func (k *KatibUIHandler) isAllowed(user, verb, resource, namespace string) (bool, error) {
+	return k.isAllowedInGroup(user, verb, resourceGroup(resource), resource, namespace)
+}
+
+// isAllowedInGroup is isAllowed for the resource of the arbitrary API group, e.g. the promoted job.
+func (k *KatibUIHandler) isAllowedInGroup(user, verb, group, resource, namespace string) (bool, error) {
+	if !k.authConfig.Enabled() {
+		return true, nil
+	}
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User: user,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      verb,
+				Group:     group,
+				Resource:  resource,
+			},
+		},
+	}
+	if err := k.katibClient.GetClient().Create(context.TODO(), sar); err != nil {
+		return false, err
+	}
+	return sar.Status.Allowed, nil
+}
+
+// checkAccess gets the user identity from the request and checks if the user can perform the verb
+// on the resource in the namespace. If user is not authorized, checkAccess returns the error
+// with the HTTP status code for the response.
+func (k *KatibUIHandler) checkAccess(r *http.Request, verb, resource, namespace string) (string, int, error) {
+	return k.checkAccessInGroup(r, verb, resourceGroup(resource), resource, namespace)
+}
+
+// checkAccessInGroup is checkAccess for the resource of the arbitrary API group.
+func (k *KatibUIHandler) checkAccessInGroup(r *http.Request, verb, group, resource, namespace string) (string, int, error) {
+	user, err := k.getUser(r)
+	if err != nil {
+		return "", http.StatusUnauthorized, err
+	}
+	allowed, err := k.isAllowedInGroup(user, verb, group, resource, namespace)
+	if err != nil {
+		log.Printf("SubjectAccessReview failed: %v", err)
+		return "", http.StatusInternalServerError, err
+	}
+	if !allowed {
+		err = fmt.Errorf("User %v is not allowed to %v %v in namespace %v", user, verb, resource, namespace)
+		log.Print(err)
+		return "", http.StatusForbidden, err
+	}
+	return user, http.StatusOK, nil
+}
+
+// authorize runs checkAccess and writes the error to the response if user is not authorized.
+func (k *KatibUIHandler) authorize(w http.ResponseWriter, r *http.Request, verb, resource, namespace string) (string, bool) {
+	user, status, err := k.checkAccess(r, verb, resource, namespace)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return "", false
+	}
+	return user, true
+}
+
+// filterNamespaces returns namespaces where user can perform the verb on the resource.
+func (k *KatibUIHandler) filterNamespaces(user, verb, resource string, namespaces []string) ([]string, error) {
+	if !k.authConfig.Enabled() {
+		return namespaces, nil
+	}
+	allowedNamespaces := []string{}
+	for _, ns := range namespaces {
+		allowed, err := k.isAllowed(user, verb, resource, ns)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			allowedNamespaces = append(allowedNamespaces, ns)
+		}
+	}
+	return allowedNamespaces, nil
+}
+
+// filterExperiments returns experiments from namespaces where user can list experiments.
+func (k *KatibUIHandler) filterExperiments(user string, experiments []ExperimentView) ([]ExperimentView, error) {
+	if !k.authConfig.Enabled() {
+		return experiments, nil
+	}
+	allowedNamespaces := map[string]bool{}
+	filteredExperiments := []ExperimentView{}
+	for _, experiment := range experiments {
+		allowed, checked := allowedNamespaces[experiment.Namespace]
+		if !checked {
+			var err error
+			allowed, err = k.isAllowed(user, VerbList, ResourceExperiments, experiment.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			allowedNamespaces[experiment.Namespace] = allowed
+		}
+		if allowed {
+			filteredExperiments = append(filteredExperiments, experiment)
+		}
+	}
+	return filteredExperiments, nil
+}
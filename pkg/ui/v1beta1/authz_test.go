@@ -0,0 +1,124 @@
+package v1beta1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	manifestmock "github.com/kubeflow/katib/pkg/mock/v1beta1/experiment/manifest"
+	katibclientmock "github.com/kubeflow/katib/pkg/mock/v1beta1/util/katibclient"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/promotion"
+)
+
+// fakeAccessReviewClient records SubjectAccessReviews and denies all of them
+// except for the allowed resources.
+type fakeAccessReviewClient struct {
+	client.Client
+	reviews          []authorizationv1.ResourceAttributes
+	allowedResources map[string]bool
+}
+
+func (f *fakeAccessReviewClient) Create(ctx context.Context, obj runtime.Object) error {
+	sar := obj.(*authorizationv1.SubjectAccessReview)
+	f.reviews = append(f.reviews, *sar.Spec.ResourceAttributes)
+	sar.Status.Allowed = f.allowedResources[sar.Spec.ResourceAttributes.Resource]
+	return nil
+}
+
+func TestTemplateHandlersAuthorization(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	reviewClient := &fakeAccessReviewClient{}
+	kclient := katibclientmock.NewMockClient(mockCtrl)
+	kclient.EXPECT().GetClient().Return(reviewClient).AnyTimes()
+	k := &KatibUIHandler{katibClient: kclient, authConfig: AuthConfig{UserHeader: "kubeflow-userid"}}
+
+	tcs := []struct {
+		handler         http.HandlerFunc
+		body            string
+		testDescription string
+	}{
+		{
+			handler:         k.AddTemplate,
+			body:            `{"updatedConfigMapNamespace": "kubeflow", "updatedConfigMapName": "trial-template", "updatedConfigMapPath": "new-template", "updatedTemplateYaml": ""}`,
+			testDescription: "Add template updates ConfigMap",
+		},
+		{
+			handler:         k.EditTemplate,
+			body:            `{"updatedConfigMapNamespace": "kubeflow", "updatedConfigMapName": "trial-template", "configMapPath": "template", "updatedConfigMapPath": "template", "updatedTemplateYaml": ""}`,
+			testDescription: "Edit template updates ConfigMap",
+		},
+		{
+			handler:         k.DeleteTemplate,
+			body:            `{"updatedConfigMapNamespace": "kubeflow", "updatedConfigMapName": "trial-template", "updatedConfigMapPath": "template"}`,
+			testDescription: "Delete template updates ConfigMap",
+		},
+	}
+	for _, tc := range tcs {
+		reviewClient.reviews = nil
+		req := httptest.NewRequest(http.MethodPost, "/katib/", strings.NewReader(tc.body))
+		req.Header.Set("kubeflow-userid", "user@example.com")
+		rec := httptest.NewRecorder()
+		tc.handler(rec, req)
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("Case: %v failed. Expected status %v, got %v", tc.testDescription, http.StatusForbidden, rec.Code)
+		}
+		if len(reviewClient.reviews) != 1 {
+			t.Errorf("Case: %v failed. Expected one SubjectAccessReview, got %v", tc.testDescription, reviewClient.reviews)
+			continue
+		}
+		review := reviewClient.reviews[0]
+		if review.Verb != VerbUpdate || review.Resource != ResourceConfigMaps || review.Group != "" || review.Namespace != "kubeflow" {
+			t.Errorf("Case: %v failed. Unexpected SubjectAccessReview %+v", tc.testDescription, review)
+		}
+	}
+}
+
+func TestPromoteBestTrialAuthorization(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	reviewClient := &fakeAccessReviewClient{allowedResources: map[string]bool{ResourceExperiments: true}}
+	kclient := katibclientmock.NewMockClient(mockCtrl)
+	generator := manifestmock.NewMockGenerator(mockCtrl)
+	kclient.EXPECT().GetClient().Return(reviewClient).AnyTimes()
+	kclient.EXPECT().GetExperiment("random-experiment", "kubeflow").Return(newFakeExperiment(), nil)
+	generator.EXPECT().GetRunSpecWithHyperParameters(gomock.Any(), "best-job", "kubeflow", gomock.Any()).Return(
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "batch/v1",
+			"kind":       "Job",
+			"metadata":   map[string]interface{}{"name": "best-job", "namespace": "kubeflow"},
+		}}, nil)
+	k := &KatibUIHandler{
+		katibClient: kclient,
+		promoter:    promotion.New(nil, generator),
+		authConfig:  AuthConfig{UserHeader: "kubeflow-userid"},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, APIPrefix+"namespaces/kubeflow/experiments/random-experiment/promote",
+		strings.NewReader(`{"name": "best-job"}`))
+	req.Header.Set("kubeflow-userid", "user@example.com")
+	rec := httptest.NewRecorder()
+	k.ServeAPI(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("Expected status %v, got %v: %v", http.StatusForbidden, rec.Code, rec.Body.String())
+	}
+	if len(reviewClient.reviews) != 2 {
+		t.Fatalf("Expected two SubjectAccessReviews, got %v", reviewClient.reviews)
+	}
+	review := reviewClient.reviews[1]
+	if review.Verb != VerbCreate || review.Group != "batch" || review.Resource != "jobs" || review.Namespace != "kubeflow" {
+		t.Errorf("Unexpected SubjectAccessReview %+v", review)
+	}
+}
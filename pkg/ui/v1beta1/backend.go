@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 
@@ -9,33 +10,58 @@ import (
 	"google.golang.org/grpc"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
 
 	experimentv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/manifest"
 
+	"github.com/kubeflow/katib/pkg/util/v1beta1/dryrun"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/promotion"
 )
 
-func NewKatibUIHandler(dbManagerAddr string) *KatibUIHandler {
+func NewKatibUIHandler(dbManagerAddr string, authConfig AuthConfig) *KatibUIHandler {
 	kclient, err := katibclient.NewClient(client.Options{})
 	if err != nil {
 		log.Printf("NewClient for Katib failed: %v", err)
 		panic(err)
 	}
+	cfg, err := config.GetConfig()
+	if err != nil {
+		log.Printf("GetConfig failed: %v", err)
+		panic(err)
+	}
+	generator := manifest.New(kclient.GetClient())
+	dryRunner, err := dryrun.New(cfg, generator)
+	if err != nil {
+		log.Printf("New dry-runner failed: %v", err)
+		panic(err)
+	}
 	return &KatibUIHandler{
 		katibClient:   kclient,
+		dryRunner:     dryRunner,
+		promoter:      promotion.New(kclient.GetClient(), generator),
 		dbManagerAddr: dbManagerAddr,
+		authConfig:    authConfig,
 	}
 }
 
-func (k *KatibUIHandler) connectManager() (*grpc.ClientConn, api_pb_v1beta1.DBManagerClient) {
-	conn, err := grpc.Dial(k.dbManagerAddr, grpc.WithInsecure())
+// connectManager dials Katib DB manager, the returned connection must be closed by the caller.
+func (k *KatibUIHandler) connectManager() (*grpc.ClientConn, api_pb_v1beta1.DBManagerClient, error) {
+	tlsOpt, err := grpctls.ConfigFromEnv().DialOption()
+	if err != nil {
+		log.Printf("Load TLS config failed: %v", err)
+		return nil, nil, fmt.Errorf("Failed to load TLS config for Katib DB manager: %v", err)
+	}
+	conn, err := grpc.Dial(k.dbManagerAddr, tlsOpt)
 	if err != nil {
 		log.Printf("Dial to GRPC failed: %v", err)
-		return nil, nil
+		return nil, nil, fmt.Errorf("Failed to connect to Katib DB manager: %v", err)
 	}
 	c := api_pb_v1beta1.NewDBManagerClient(conn)
-	return conn, c
+	return conn, c, nil
 }
 
 func (k *KatibUIHandler) SubmitYamlJob(w http.ResponseWriter, r *http.Request) {
@@ -52,6 +78,9 @@ func (k *KatibUIHandler) SubmitYamlJob(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+		if _, ok := k.authorize(w, r, VerbCreate, ResourceExperiments, job.GetNamespace()); !ok {
+			return
+		}
 		err = k.katibClient.CreateRuntimeObject(&job)
 		if err != nil {
 			log.Printf("CreateRuntimeObject from YAML failed: %v", err)
@@ -89,6 +118,9 @@ func (k *KatibUIHandler) SubmitParamsJob(w http.ResponseWriter, r *http.Request)
 			Name:      dataMap["metadata"].(map[string]interface{})["name"].(string),
 			Namespace: dataMap["metadata"].(map[string]interface{})["namespace"].(string),
 		}
+		if _, ok := k.authorize(w, r, VerbCreate, ResourceExperiments, job.GetNamespace()); !ok {
+			return
+		}
 		err = k.katibClient.CreateRuntimeObject(&job)
 		if err != nil {
 			log.Printf("CreateRuntimeObject from parameters failed: %v", err)
@@ -98,14 +130,64 @@ func (k *KatibUIHandler) SubmitParamsJob(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// FetchAllExperiments gets HP and NAS experiments in all namespaces.
+// DryRunTrialTemplate renders Trial template of the experiment from YAML with sample
+// parameter assignments and validates rendered jobs using server-side dry-run.
+func (k *KatibUIHandler) DryRunTrialTemplate(w http.ResponseWriter, r *http.Request) {
+	var data map[string]interface{}
+
+	json.NewDecoder(r.Body).Decode(&data)
+
+	yamlContent, ok := data["yaml"].(string)
+	if !ok {
+		http.Error(w, "yaml must be specified", http.StatusBadRequest)
+		return
+	}
+	experiment := experimentv1beta1.Experiment{}
+	if err := yaml.Unmarshal([]byte(yamlContent), &experiment); err != nil {
+		log.Printf("Unmarshal YAML content failed: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if experiment.GetNamespace() == "" {
+		http.Error(w, "metadata.namespace must be specified", http.StatusBadRequest)
+		return
+	}
+	if _, ok := k.authorize(w, r, VerbCreate, ResourceExperiments, experiment.GetNamespace()); !ok {
+		return
+	}
+
+	results, err := k.dryRunner.DryRunTrialTemplate(&experiment)
+	if err != nil {
+		log.Printf("DryRunTrialTemplate failed: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	response, err := json.Marshal(results)
+	if err != nil {
+		log.Printf("Marshal dry-run results failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(response)
+}
+
+// FetchAllExperiments gets HP and NAS experiments in all namespaces
+// where user can list experiments.
 func (k *KatibUIHandler) FetchAllExperiments(w http.ResponseWriter, r *http.Request) {
+	user, err := k.getUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
 	// At first, try to list experiments in cluster scope
 	experiments, err := k.getExperiments([]string{""})
 	if err != nil {
 		// If failed, just try to list experiments from own namespace
 		experiments, err = k.getExperiments([]string{})
 	}
+	if err == nil {
+		experiments, err = k.filterExperiments(user, experiments)
+	}
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -120,8 +202,19 @@ func (k *KatibUIHandler) FetchAllExperiments(w http.ResponseWriter, r *http.Requ
 }
 
 func (k *KatibUIHandler) DeleteExperiment(w http.ResponseWriter, r *http.Request) {
-	experimentName := r.URL.Query()["experimentName"][0]
-	namespace := r.URL.Query()["namespace"][0]
+	experimentName, ok := getQueryParam(w, r, "experimentName")
+	if !ok {
+		return
+	}
+	namespace, ok := getQueryParam(w, r, "namespace")
+	if !ok {
+		return
+	}
+
+	user, ok := k.authorize(w, r, VerbDelete, ResourceExperiments, namespace)
+	if !ok {
+		return
+	}
 
 	experiment, err := k.katibClient.GetExperiment(experimentName, namespace)
 	if err != nil {
@@ -148,6 +241,9 @@ func (k *KatibUIHandler) DeleteExperiment(w http.ResponseWriter, r *http.Request
 			// If failed, just try to list experiments from own namespace
 			experiments, err = k.getExperiments([]string{})
 		}
+		if err == nil {
+			experiments, err = k.filterExperiments(user, experiments)
+		}
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -155,7 +251,7 @@ func (k *KatibUIHandler) DeleteExperiment(w http.ResponseWriter, r *http.Request
 
 		isExperimentDeleted = true
 		for _, experiment := range experiments {
-			if experiment.Name == experimentName {
+			if experiment.Name == experimentName && experiment.Namespace == namespace {
 				isExperimentDeleted = false
 				break
 			}
@@ -173,8 +269,13 @@ func (k *KatibUIHandler) DeleteExperiment(w http.ResponseWriter, r *http.Request
 
 // FetchTrialTemplates gets all trial templates in all namespaces
 func (k *KatibUIHandler) FetchTrialTemplates(w http.ResponseWriter, r *http.Request) {
+	user, err := k.getUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
 
-	trialTemplatesViewList, err := k.getTrialTemplatesViewList()
+	trialTemplatesViewList, err := k.getTrialTemplatesViewList(user)
 	if err != nil {
 		log.Printf("getTrialTemplatesViewList failed: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -203,7 +304,12 @@ func (k *KatibUIHandler) AddTemplate(w http.ResponseWriter, r *http.Request) {
 	updatedConfigMapPath := data["updatedConfigMapPath"].(string)
 	updatedTemplateYaml := data["updatedTemplateYaml"].(string)
 
-	newTemplates, err := k.updateTrialTemplates(updatedConfigMapNamespace, updatedConfigMapName, "", updatedConfigMapPath, updatedTemplateYaml, ActionTypeAdd)
+	user, ok := k.authorize(w, r, VerbUpdate, ResourceConfigMaps, updatedConfigMapNamespace)
+	if !ok {
+		return
+	}
+
+	newTemplates, err := k.updateTrialTemplates(user, updatedConfigMapNamespace, updatedConfigMapName, "", updatedConfigMapPath, updatedTemplateYaml, ActionTypeAdd)
 	if err != nil {
 		log.Printf("updateTrialTemplates failed: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -235,7 +341,12 @@ func (k *KatibUIHandler) EditTemplate(w http.ResponseWriter, r *http.Request) {
 	updatedConfigMapPath := data["updatedConfigMapPath"].(string)
 	updatedTemplateYaml := data["updatedTemplateYaml"].(string)
 
-	newTemplates, err := k.updateTrialTemplates(updatedConfigMapNamespace, updatedConfigMapName, configMapPath, updatedConfigMapPath, updatedTemplateYaml, ActionTypeEdit)
+	user, ok := k.authorize(w, r, VerbUpdate, ResourceConfigMaps, updatedConfigMapNamespace)
+	if !ok {
+		return
+	}
+
+	newTemplates, err := k.updateTrialTemplates(user, updatedConfigMapNamespace, updatedConfigMapName, configMapPath, updatedConfigMapPath, updatedTemplateYaml, ActionTypeEdit)
 	if err != nil {
 		log.Printf("updateTrialTemplates failed: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -264,7 +375,12 @@ func (k *KatibUIHandler) DeleteTemplate(w http.ResponseWriter, r *http.Request)
 	updatedConfigMapName := data["updatedConfigMapName"].(string)
 	updatedConfigMapPath := data["updatedConfigMapPath"].(string)
 
-	newTemplates, err := k.updateTrialTemplates(updatedConfigMapNamespace, updatedConfigMapName, "", updatedConfigMapPath, "", ActionTypeDelete)
+	user, ok := k.authorize(w, r, VerbUpdate, ResourceConfigMaps, updatedConfigMapNamespace)
+	if !ok {
+		return
+	}
+
+	newTemplates, err := k.updateTrialTemplates(user, updatedConfigMapNamespace, updatedConfigMapName, "", updatedConfigMapPath, "", ActionTypeDelete)
 	if err != nil {
 		log.Printf("updateTrialTemplates failed: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -284,10 +400,19 @@ func (k *KatibUIHandler) DeleteTemplate(w http.ResponseWriter, r *http.Request)
 	w.Write(response)
 }
 
+// FetchNamespaces gets namespaces where user can list experiments.
 func (k *KatibUIHandler) FetchNamespaces(w http.ResponseWriter, r *http.Request) {
+	user, err := k.getUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
 
 	// Get all available namespaces
 	namespaces, err := k.getAvailableNamespaces()
+	if err == nil {
+		namespaces, err = k.filterNamespaces(user, VerbList, ResourceExperiments, namespaces)
+	}
 	if err != nil {
 		log.Printf("GetAvailableNamespaces failed: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -305,8 +430,18 @@ func (k *KatibUIHandler) FetchNamespaces(w http.ResponseWriter, r *http.Request)
 
 // FetchExperiment gets experiment in specific namespace.
 func (k *KatibUIHandler) FetchExperiment(w http.ResponseWriter, r *http.Request) {
-	experimentName := r.URL.Query()["experimentName"][0]
-	namespace := r.URL.Query()["namespace"][0]
+	experimentName, ok := getQueryParam(w, r, "experimentName")
+	if !ok {
+		return
+	}
+	namespace, ok := getQueryParam(w, r, "namespace")
+	if !ok {
+		return
+	}
+
+	if _, ok := k.authorize(w, r, VerbGet, ResourceExperiments, namespace); !ok {
+		return
+	}
 
 	experiment, err := k.katibClient.GetExperiment(experimentName, namespace)
 	if err != nil {
@@ -325,8 +460,18 @@ func (k *KatibUIHandler) FetchExperiment(w http.ResponseWriter, r *http.Request)
 
 // FetchSuggestion gets suggestion in specific namespace
 func (k *KatibUIHandler) FetchSuggestion(w http.ResponseWriter, r *http.Request) {
-	suggestionName := r.URL.Query()["suggestionName"][0]
-	namespace := r.URL.Query()["namespace"][0]
+	suggestionName, ok := getQueryParam(w, r, "suggestionName")
+	if !ok {
+		return
+	}
+	namespace, ok := getQueryParam(w, r, "namespace")
+	if !ok {
+		return
+	}
+
+	if _, ok := k.authorize(w, r, VerbGet, ResourceSuggestions, namespace); !ok {
+		return
+	}
 
 	suggestion, err := k.katibClient.GetSuggestion(suggestionName, namespace)
 	if err != nil {
@@ -99,6 +99,9 @@ const addParameter = (source, destination) => {
       if (param.step !== '') {
         tempParam.feasibleSpace.step = param.step;
       }
+      if (param.distribution && param.distribution !== 'uniform') {
+        tempParam.feasibleSpace.distribution = param.distribution;
+      }
     }
     return destination.push(tempParam);
   });
@@ -194,6 +194,21 @@ const Parameters = props => {
                         onChange={onGeneralEdit(i, 'step')}
                       />
                     )}
+                    <FormControl className={classes.textField}>
+                      <InputLabel>Distribution</InputLabel>
+                      <Select
+                        value={param.distribution}
+                        onChange={onGeneralEdit(i, 'distribution')}
+                      >
+                        {props.allDistributions.map((distribution, i) => {
+                          return (
+                            <MenuItem value={distribution} key={i}>
+                              {distribution}
+                            </MenuItem>
+                          );
+                        })}
+                      </Select>
+                    </FormControl>
                   </div>
                 )}
               </Grid>
@@ -228,6 +243,7 @@ const mapStateToProps = state => {
   return {
     parameters: state[HP_CREATE_MODULE].parameters,
     allParameterTypes: state[HP_CREATE_MODULE].allParameterTypes,
+    allDistributions: state[HP_CREATE_MODULE].allDistributions,
     algorithmName: state[HP_CREATE_MODULE].algorithmName,
   };
 };
@@ -65,6 +65,7 @@ const initialState = {
       feasibleSpace: 'feasibleSpace',
       min: '0.01',
       max: '0.03',
+      distribution: 'uniform',
       list: [],
     },
     {
@@ -73,6 +74,7 @@ const initialState = {
       feasibleSpace: 'feasibleSpace',
       min: '2',
       max: '5',
+      distribution: 'uniform',
       list: [],
     },
     {
@@ -95,6 +97,7 @@ const initialState = {
     },
   ],
   allParameterTypes: ['int', 'double', 'categorical'],
+  allDistributions: ['uniform', 'logUniform', 'qLogUniform', 'normal'],
   currentYaml: '',
   mcSpec: {
     collector: {
@@ -202,6 +205,7 @@ const hpCreateReducer = (state = initialState, action) => {
         feasibleSpace: 'feasibleSpace',
         min: '',
         max: '',
+        distribution: 'uniform',
         list: [],
       });
       return {
@@ -18,10 +18,24 @@ import (
 
 func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request) {
 	//enableCors(&w)
-	experimentName := r.URL.Query()["experimentName"][0]
-	namespace := r.URL.Query()["namespace"][0]
+	experimentName, ok := getQueryParam(w, r, "experimentName")
+	if !ok {
+		return
+	}
+	namespace, ok := getQueryParam(w, r, "namespace")
+	if !ok {
+		return
+	}
 
-	conn, c := k.connectManager()
+	if _, ok := k.authorize(w, r, VerbGet, ResourceExperiments, namespace); !ok {
+		return
+	}
+
+	conn, c, err := k.connectManager()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	defer conn.Close()
 
 	resultText := "trialName,Status"
@@ -78,6 +92,8 @@ func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request)
 				context.Background(),
 				&api_pb_v1beta1.GetObservationLogRequest{
 					TrialName: t.Name,
+					Namespace: t.Namespace,
+					TrialUid:  string(t.UID),
 					StartTime: "",
 					EndTime:   "",
 				},
@@ -120,9 +136,24 @@ func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request)
 // FetchHPJobTrialInfo returns all metrics for the HP Job Trial
 func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Request) {
 	//enableCors(&w)
-	trialName := r.URL.Query()["trialName"][0]
-	namespace := r.URL.Query()["namespace"][0]
-	conn, c := k.connectManager()
+	trialName, ok := getQueryParam(w, r, "trialName")
+	if !ok {
+		return
+	}
+	namespace, ok := getQueryParam(w, r, "namespace")
+	if !ok {
+		return
+	}
+
+	if _, ok := k.authorize(w, r, VerbGet, ResourceTrials, namespace); !ok {
+		return
+	}
+
+	conn, c, err := k.connectManager()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	defer conn.Close()
 
 	trial, err := k.katibClient.GetTrial(trialName, namespace)
@@ -130,6 +161,7 @@ func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Requ
 	if err != nil {
 		log.Printf("GetTrial from HP job failed: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
 	objectiveType := trial.Spec.Objective.Type
@@ -141,6 +173,8 @@ func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Requ
 		context.Background(),
 		&api_pb_v1beta1.GetObservationLogRequest{
 			TrialName: trialName,
+			Namespace: namespace,
+			TrialUid:  string(trial.UID),
 			StartTime: "",
 			EndTime:   "",
 		},
@@ -212,3 +246,55 @@ func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Requ
 	}
 	w.Write(response)
 }
+
+// FetchHPJobTrialLogs returns the last part of the failed HP Job Trial primary container logs
+func (k *KatibUIHandler) FetchHPJobTrialLogs(w http.ResponseWriter, r *http.Request) {
+	trialName, ok := getQueryParam(w, r, "trialName")
+	if !ok {
+		return
+	}
+	namespace, ok := getQueryParam(w, r, "namespace")
+	if !ok {
+		return
+	}
+
+	if _, ok := k.authorize(w, r, VerbGet, ResourceTrials, namespace); !ok {
+		return
+	}
+
+	conn, c, err := k.connectManager()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer conn.Close()
+
+	trial, err := k.katibClient.GetTrial(trialName, namespace)
+	if err != nil {
+		log.Printf("GetTrial from HP job failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	logsResp, err := c.GetTrialLogs(
+		context.Background(),
+		&api_pb_v1beta1.GetTrialLogsRequest{
+			TrialName: trialName,
+			Namespace: namespace,
+			TrialUid:  string(trial.UID),
+		},
+	)
+	if err != nil {
+		log.Printf("GetTrialLogs failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response, err := json.Marshal(logsResp.Logs)
+	if err != nil {
+		log.Printf("Marshal Trial logs failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(response)
+}
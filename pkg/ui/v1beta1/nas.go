@@ -13,15 +13,28 @@ import (
 
 func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request) {
 	//enableCors(&w)
-	experimentName := r.URL.Query()["experimentName"][0]
-	namespace := r.URL.Query()["namespace"][0]
+	experimentName, ok := getQueryParam(w, r, "experimentName")
+	if !ok {
+		return
+	}
+	namespace, ok := getQueryParam(w, r, "namespace")
+	if !ok {
+		return
+	}
+
+	if _, ok := k.authorize(w, r, VerbGet, ResourceExperiments, namespace); !ok {
+		return
+	}
 
 	responseRaw := make([]NNView, 0)
 	var architecture string
 	var decoder string
 
-	conn, c := k.connectManager()
-
+	conn, c, err := k.connectManager()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	defer conn.Close()
 
 	trials, err := k.katibClient.GetTrialList(experimentName, namespace)
@@ -44,6 +57,8 @@ func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request)
 				context.Background(),
 				&api_pb_v1beta1.GetObservationLogRequest{
 					TrialName: t.Name,
+					Namespace: t.Namespace,
+					TrialUid:  string(t.UID),
 					StartTime: "",
 					EndTime:   "",
 				},
@@ -0,0 +1,310 @@
+package v1beta1
+
+// OpenAPIDocument describes the versioned JSON REST API served by ServeAPI.
+// Keep it in sync with api.go and api_types.go.
+const OpenAPIDocument = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "Katib UI REST API",
+    "version": "v1beta1",
+    "description": "Read Katib Experiments, Trials, metrics and Suggestions and promote the best Trials. If the UI is started with --user-header, requests are authorized with SubjectAccessReviews for the user from that header."
+  },
+  "servers": [{"url": "/katib/api/v1beta1"}],
+  "paths": {
+    "/namespaces": {
+      "get": {
+        "summary": "List namespaces where user can list Experiments",
+        "responses": {
+          "200": {"description": "Namespaces", "content": {"application/json": {"schema": {"type": "array", "items": {"type": "string"}}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/experiments": {
+      "get": {
+        "summary": "List Experiments in all namespaces where user can list Experiments",
+        "parameters": [
+          {"name": "namespace", "in": "query", "required": false, "description": "List Experiments only in this namespace", "schema": {"type": "string"}}
+        ],
+        "responses": {
+          "200": {"description": "Experiments", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ExperimentList"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/namespaces/{namespace}/experiments": {
+      "parameters": [{"$ref": "#/components/parameters/Namespace"}],
+      "get": {
+        "summary": "List Experiments in the namespace",
+        "responses": {
+          "200": {"description": "Experiments", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ExperimentList"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/namespaces/{namespace}/experiments/{name}": {
+      "parameters": [{"$ref": "#/components/parameters/Namespace"}, {"$ref": "#/components/parameters/Name"}],
+      "get": {
+        "summary": "Get the Experiment",
+        "responses": {
+          "200": {"description": "Experiment", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Experiment"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "delete": {
+        "summary": "Delete the Experiment",
+        "responses": {
+          "200": {"description": "Deleted Experiment", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Experiment"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/namespaces/{namespace}/experiments/{name}/trials": {
+      "parameters": [{"$ref": "#/components/parameters/Namespace"}, {"$ref": "#/components/parameters/Name"}],
+      "get": {
+        "summary": "List Trials of the Experiment",
+        "responses": {
+          "200": {"description": "Trials", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/TrialList"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/namespaces/{namespace}/experiments/{name}/events": {
+      "parameters": [{"$ref": "#/components/parameters/Namespace"}, {"$ref": "#/components/parameters/Name"}],
+      "get": {
+        "summary": "Stream changes of the Experiment and its Trials as server-sent events. The current state is sent first as ADDED events. Events are experiment with ExperimentEvent data, trial with TrialEvent data and metrics with MetricLogEvent data for new metric points of the Trials which are not completed",
+        "responses": {
+          "200": {"description": "Event stream", "content": {"text/event-stream": {"schema": {"type": "string"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/namespaces/{namespace}/experiments/{name}/promote": {
+      "parameters": [{"$ref": "#/components/parameters/Namespace"}, {"$ref": "#/components/parameters/Name"}],
+      "post": {
+        "summary": "Create the job from the best Trial of the Experiment. Empty request fields are taken from spec.promoteBestTrial. User must be allowed to create Experiments and, unless dryRun is set, the job kind",
+        "requestBody": {"required": false, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/PromoteRequest"}}}},
+        "responses": {
+          "200": {"description": "Rendered job if dryRun is set", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/PromotedJob"}}}},
+          "201": {"description": "Created job", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/PromotedJob"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/namespaces/{namespace}/trials/{name}": {
+      "parameters": [{"$ref": "#/components/parameters/Namespace"}, {"$ref": "#/components/parameters/Name"}],
+      "get": {
+        "summary": "Get the Trial",
+        "responses": {
+          "200": {"description": "Trial", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Trial"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/namespaces/{namespace}/trials/{name}/metrics": {
+      "parameters": [{"$ref": "#/components/parameters/Namespace"}, {"$ref": "#/components/parameters/Name"}],
+      "get": {
+        "summary": "Get the observation log of the Trial",
+        "parameters": [
+          {"name": "metricName", "in": "query", "required": false, "schema": {"type": "string"}},
+          {"name": "startTime", "in": "query", "required": false, "schema": {"type": "string", "format": "date-time"}},
+          {"name": "endTime", "in": "query", "required": false, "schema": {"type": "string", "format": "date-time"}}
+        ],
+        "responses": {
+          "200": {"description": "Metric logs", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/MetricLogList"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/namespaces/{namespace}/trials/{name}/logs": {
+      "parameters": [{"$ref": "#/components/parameters/Namespace"}, {"$ref": "#/components/parameters/Name"}],
+      "get": {
+        "summary": "Get the last part of the primary container logs of the failed Trial",
+        "responses": {
+          "200": {"description": "Trial logs", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/TrialLogs"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/namespaces/{namespace}/suggestions/{name}": {
+      "parameters": [{"$ref": "#/components/parameters/Namespace"}, {"$ref": "#/components/parameters/Name"}],
+      "get": {
+        "summary": "Get the Suggestion",
+        "responses": {
+          "200": {"description": "Suggestion", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Suggestion"}}}},
+          "default": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    }
+  },
+  "components": {
+    "parameters": {
+      "Namespace": {"name": "namespace", "in": "path", "required": true, "schema": {"type": "string"}},
+      "Name": {"name": "name", "in": "path", "required": true, "schema": {"type": "string"}}
+    },
+    "responses": {
+      "Error": {
+        "description": "400 for invalid input, 401 if user identity is missing, 403 if user is not allowed, 404 if object is not found, 409 if object already exists",
+        "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}
+      }
+    },
+    "schemas": {
+      "Error": {
+        "type": "object",
+        "properties": {"code": {"type": "integer"}, "message": {"type": "string"}}
+      },
+      "ExperimentList": {
+        "type": "object",
+        "properties": {"items": {"type": "array", "items": {"$ref": "#/components/schemas/Experiment"}}}
+      },
+      "Experiment": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "namespace": {"type": "string"},
+          "type": {"type": "string", "enum": ["hp", "nas"]},
+          "status": {"type": "string"},
+          "objective": {"$ref": "#/components/schemas/Objective"},
+          "algorithm": {"type": "string"},
+          "parameters": {"type": "array", "items": {"$ref": "#/components/schemas/Parameter"}},
+          "parallelTrialCount": {"type": "integer"},
+          "maxTrialCount": {"type": "integer"},
+          "maxFailedTrialCount": {"type": "integer"},
+          "trials": {"type": "integer"},
+          "trialsSucceeded": {"type": "integer"},
+          "trialsFailed": {"type": "integer"},
+          "trialsKilled": {"type": "integer"},
+          "trialsPending": {"type": "integer"},
+          "trialsRunning": {"type": "integer"},
+          "currentOptimalTrial": {"$ref": "#/components/schemas/OptimalTrial"},
+          "promotedJob": {"$ref": "#/components/schemas/PromotedJob"},
+          "creationTime": {"type": "string", "format": "date-time"},
+          "startTime": {"type": "string", "format": "date-time"},
+          "completionTime": {"type": "string", "format": "date-time"}
+        }
+      },
+      "Objective": {
+        "type": "object",
+        "properties": {
+          "type": {"type": "string", "enum": ["minimize", "maximize"]},
+          "goal": {"type": "number"},
+          "objectiveMetricName": {"type": "string"},
+          "additionalMetricNames": {"type": "array", "items": {"type": "string"}}
+        }
+      },
+      "Parameter": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "parameterType": {"type": "string"},
+          "min": {"type": "string"},
+          "max": {"type": "string"},
+          "step": {"type": "string"},
+          "list": {"type": "array", "items": {"type": "string"}},
+          "distribution": {"type": "string", "enum": ["uniform", "logUniform", "qLogUniform", "normal"]}
+        }
+      },
+      "ParameterAssignment": {
+        "type": "object",
+        "properties": {"name": {"type": "string"}, "value": {"type": "string"}}
+      },
+      "Metric": {
+        "type": "object",
+        "properties": {"name": {"type": "string"}, "min": {"type": "string"}, "max": {"type": "string"}, "latest": {"type": "string"}, "value": {"type": "string"}}
+      },
+      "Artifact": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "uri": {"type": "string"},
+          "metadata": {"type": "object", "additionalProperties": {"type": "string"}}
+        }
+      },
+      "OptimalTrial": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "parameterAssignments": {"type": "array", "items": {"$ref": "#/components/schemas/ParameterAssignment"}},
+          "metrics": {"type": "array", "items": {"$ref": "#/components/schemas/Metric"}},
+          "artifacts": {"type": "array", "items": {"$ref": "#/components/schemas/Artifact"}}
+        }
+      },
+      "PromoteRequest": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "parameterOverrides": {"type": "array", "items": {"$ref": "#/components/schemas/ParameterAssignment"}},
+          "runSpecPatch": {"type": "string", "description": "JSON merge patch applied to the rendered job, it can't change apiVersion, kind and metadata"},
+          "dryRun": {"type": "boolean"}
+        }
+      },
+      "PromotedJob": {
+        "type": "object",
+        "properties": {
+          "apiVersion": {"type": "string"},
+          "kind": {"type": "string"},
+          "name": {"type": "string"},
+          "namespace": {"type": "string"},
+          "manifest": {"type": "string", "description": "Rendered job in YAML format"}
+        }
+      },
+      "TrialList": {
+        "type": "object",
+        "properties": {"items": {"type": "array", "items": {"$ref": "#/components/schemas/Trial"}}}
+      },
+      "Trial": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "namespace": {"type": "string"},
+          "experiment": {"type": "string"},
+          "status": {"type": "string"},
+          "parameterAssignments": {"type": "array", "items": {"$ref": "#/components/schemas/ParameterAssignment"}},
+          "metrics": {"type": "array", "items": {"$ref": "#/components/schemas/Metric"}},
+          "artifacts": {"type": "array", "items": {"$ref": "#/components/schemas/Artifact"}},
+          "creationTime": {"type": "string", "format": "date-time"},
+          "startTime": {"type": "string", "format": "date-time"},
+          "completionTime": {"type": "string", "format": "date-time"}
+        }
+      },
+      "MetricLogList": {
+        "type": "object",
+        "properties": {"items": {"type": "array", "items": {"$ref": "#/components/schemas/MetricLog"}}}
+      },
+      "MetricLog": {
+        "type": "object",
+        "properties": {"name": {"type": "string"}, "timestamp": {"type": "string"}, "value": {"type": "string"}}
+      },
+      "ExperimentEvent": {
+        "type": "object",
+        "properties": {"type": {"$ref": "#/components/schemas/EventType"}, "experiment": {"$ref": "#/components/schemas/Experiment"}}
+      },
+      "TrialEvent": {
+        "type": "object",
+        "properties": {"type": {"$ref": "#/components/schemas/EventType"}, "trial": {"$ref": "#/components/schemas/Trial"}}
+      },
+      "MetricLogEvent": {
+        "type": "object",
+        "properties": {"trial": {"type": "string"}, "items": {"type": "array", "items": {"$ref": "#/components/schemas/MetricLog"}}}
+      },
+      "EventType": {"type": "string", "enum": ["ADDED", "MODIFIED", "DELETED"]},
+      "TrialLogs": {
+        "type": "object",
+        "properties": {"logs": {"type": "string"}}
+      },
+      "Suggestion": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "namespace": {"type": "string"},
+          "algorithm": {"type": "string"},
+          "status": {"type": "string"},
+          "requests": {"type": "integer"},
+          "suggestionCount": {"type": "integer"},
+          "algorithmSettings": {"type": "object", "additionalProperties": {"type": "string"}}
+        }
+      }
+    }
+  }
+}
+`
@@ -1,8 +1,12 @@
 package v1beta1
 
 import (
+	"sync"
+
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/dryrun"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/promotion"
 )
 
 const maxMsgSize = 1<<31 - 1
@@ -62,7 +66,15 @@ type Template struct {
 
 type KatibUIHandler struct {
 	katibClient   katibclient.Client
+	dryRunner     dryrun.DryRunner
+	promoter      promotion.Promoter
 	dbManagerAddr string
+	authConfig    AuthConfig
+
+	// watchHub serves event streams, it is started by the first stream request.
+	watchHub     *watchHub
+	watchHubOnce sync.Once
+	watchHubErr  error
 }
 
 type NNView struct {
@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 	"sort"
@@ -48,6 +49,17 @@ func (k *KatibUIHandler) getExperiments(namespace []string) ([]ExperimentView, e
 	return experiments, nil
 }
 
+// getQueryParam returns the value of the required query parameter.
+// If parameter is missing, it writes 400 error to the response.
+func getQueryParam(w http.ResponseWriter, r *http.Request, name string) (string, bool) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		http.Error(w, fmt.Sprintf("Query parameter %v must be specified", name), http.StatusBadRequest)
+		return "", false
+	}
+	return value, true
+}
+
 func enableCors(w *http.ResponseWriter) {
 	(*w).Header().Set("Content-Type", "text/html; charset=utf-8")
 	(*w).Header().Set("Access-Control-Allow-Origin", "*")
@@ -57,11 +69,14 @@ func enableCors(w *http.ResponseWriter) {
 	(*w).Header().Set("Access-Control-Allow-Credentials", "true")
 }
 
-func (k *KatibUIHandler) getTrialTemplatesViewList() ([]TrialTemplatesDataView, error) {
+func (k *KatibUIHandler) getTrialTemplatesViewList(user string) ([]TrialTemplatesDataView, error) {
 	trialTemplatesDataView := make([]TrialTemplatesDataView, 0)
 
-	// Get all available namespaces
+	// Get all available namespaces where user can list Trial templates
 	namespaces, err := k.getAvailableNamespaces()
+	if err == nil {
+		namespaces, err = k.filterNamespaces(user, VerbList, ResourceConfigMaps, namespaces)
+	}
 	if err != nil {
 		log.Printf("GetAvailableNamespaces failed: %v", err)
 		return nil, err
@@ -135,6 +150,7 @@ func getTrialTemplatesView(templatesConfigMapList *apiv1.ConfigMapList) TrialTem
 }
 
 func (k *KatibUIHandler) updateTrialTemplates(
+	user,
 	updatedConfigMapNamespace,
 	updatedConfigMapName,
 	configMapPath,
@@ -194,7 +210,7 @@ func (k *KatibUIHandler) updateTrialTemplates(
 		}
 	}
 
-	newTemplates, err := k.getTrialTemplatesViewList()
+	newTemplates, err := k.getTrialTemplatesViewList(user)
 	if err != nil {
 		log.Printf("getTrialTemplatesViewList: %v", err)
 		return nil, err
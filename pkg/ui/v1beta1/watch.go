@@ -0,0 +1,454 @@
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	experimentv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+const (
+	// Types of the Experiment and Trial changes in the event stream
+	WatchEventAdded    = "ADDED"
+	WatchEventModified = "MODIFIED"
+	WatchEventDeleted  = "DELETED"
+
+	// Names of the server-sent events
+	eventExperiment = "experiment"
+	eventTrial      = "trial"
+	eventMetrics    = "metrics"
+
+	// defaultMetricsInterval is how often observation logs of the running Trials are read from Katib DB manager.
+	// Stream comment is sent with the same interval to keep the idle connection open.
+	defaultMetricsInterval = 5 * time.Second
+	// watchBufferSize is the number of events which are queued for the slow client.
+	// If the queue is full, the stream is closed and the client must reconnect.
+	watchBufferSize = 100
+)
+
+// watchEvent is the change of the Experiment or the Trial which is sent to the stream.
+type watchEvent struct {
+	name string
+	data interface{}
+	// trial is set for Trial events to follow the observation log of the Trial.
+	trial *trialsv1beta1.Trial
+}
+
+// watchSubscriber receives events of the Experiment and its Trials.
+type watchSubscriber struct {
+	events chan watchEvent
+}
+
+// watchHub fans out Experiment and Trial changes from the informer cache to the event streams.
+// Only changes which are visible in the REST API are sent, so resyncs don't produce events.
+type watchHub struct {
+	// reader reads the current state of the Experiment and Trials from the cache.
+	reader client.Reader
+	// waitForSync blocks until the cache is synced, it returns false if stop is closed before.
+	waitForSync     func(stop <-chan struct{}) bool
+	metricsInterval time.Duration
+
+	mu sync.Mutex
+	// subscribers are grouped by the Experiment namespace and name.
+	subscribers map[types.NamespacedName]map[*watchSubscriber]bool
+}
+
+func newWatchHub(reader client.Reader, waitForSync func(stop <-chan struct{}) bool) *watchHub {
+	return &watchHub{
+		reader:          reader,
+		waitForSync:     waitForSync,
+		metricsInterval: defaultMetricsInterval,
+		subscribers:     map[types.NamespacedName]map[*watchSubscriber]bool{},
+	}
+}
+
+// startWatchHub starts the informer cache for Experiments and Trials in all namespaces.
+// Informers run until the UI is stopped.
+func startWatchHub() (*watchHub, error) {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	informerCache, err := cache.New(cfg, cache.Options{})
+	if err != nil {
+		return nil, err
+	}
+	hub := newWatchHub(informerCache, informerCache.WaitForCacheSync)
+	experimentInformer, err := informerCache.GetInformer(&experimentv1beta1.Experiment{})
+	if err != nil {
+		return nil, err
+	}
+	experimentInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    hub.onExperimentAdd,
+		UpdateFunc: hub.onExperimentUpdate,
+		DeleteFunc: hub.onExperimentDelete,
+	})
+	trialInformer, err := informerCache.GetInformer(&trialsv1beta1.Trial{})
+	if err != nil {
+		return nil, err
+	}
+	trialInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    hub.onTrialAdd,
+		UpdateFunc: hub.onTrialUpdate,
+		DeleteFunc: hub.onTrialDelete,
+	})
+	go func() {
+		if err := informerCache.Start(make(chan struct{})); err != nil {
+			log.Printf("Informer cache for event streams failed: %v", err)
+		}
+	}()
+	return hub, nil
+}
+
+func (h *watchHub) subscribe(experiment types.NamespacedName) *watchSubscriber {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s := &watchSubscriber{events: make(chan watchEvent, watchBufferSize)}
+	if h.subscribers[experiment] == nil {
+		h.subscribers[experiment] = map[*watchSubscriber]bool{}
+	}
+	h.subscribers[experiment][s] = true
+	return s
+}
+
+func (h *watchHub) unsubscribe(experiment types.NamespacedName, s *watchSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(experiment, s)
+}
+
+// remove closes the subscriber channel, h.mu must be locked.
+func (h *watchHub) remove(experiment types.NamespacedName, s *watchSubscriber) {
+	if !h.subscribers[experiment][s] {
+		return
+	}
+	close(s.events)
+	delete(h.subscribers[experiment], s)
+	if len(h.subscribers[experiment]) == 0 {
+		delete(h.subscribers, experiment)
+	}
+}
+
+// publish sends the event to subscribers of the Experiment without blocking the informer.
+func (h *watchHub) publish(experiment types.NamespacedName, event watchEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subscribers[experiment] {
+		select {
+		case s.events <- event:
+		default:
+			log.Printf("Event stream of Experiment %v is too slow, closing it", experiment)
+			h.remove(experiment, s)
+		}
+	}
+}
+
+func (h *watchHub) onExperimentAdd(obj interface{}) {
+	if experiment, ok := obj.(*experimentv1beta1.Experiment); ok {
+		h.publishExperiment(WatchEventAdded, experiment)
+	}
+}
+
+func (h *watchHub) onExperimentUpdate(oldObj, newObj interface{}) {
+	oldExperiment, ok := oldObj.(*experimentv1beta1.Experiment)
+	if !ok {
+		return
+	}
+	newExperiment, ok := newObj.(*experimentv1beta1.Experiment)
+	if !ok {
+		return
+	}
+	if !reflect.DeepEqual(convertExperiment(oldExperiment), convertExperiment(newExperiment)) {
+		h.publishExperiment(WatchEventModified, newExperiment)
+	}
+}
+
+func (h *watchHub) onExperimentDelete(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if experiment, ok := obj.(*experimentv1beta1.Experiment); ok {
+		h.publishExperiment(WatchEventDeleted, experiment)
+	}
+}
+
+func (h *watchHub) publishExperiment(eventType string, experiment *experimentv1beta1.Experiment) {
+	h.publish(types.NamespacedName{Namespace: experiment.Namespace, Name: experiment.Name}, watchEvent{
+		name: eventExperiment,
+		data: APIExperimentEvent{Type: eventType, Experiment: convertExperiment(experiment)},
+	})
+}
+
+func (h *watchHub) onTrialAdd(obj interface{}) {
+	if trial, ok := obj.(*trialsv1beta1.Trial); ok {
+		h.publishTrial(WatchEventAdded, trial)
+	}
+}
+
+func (h *watchHub) onTrialUpdate(oldObj, newObj interface{}) {
+	oldTrial, ok := oldObj.(*trialsv1beta1.Trial)
+	if !ok {
+		return
+	}
+	newTrial, ok := newObj.(*trialsv1beta1.Trial)
+	if !ok {
+		return
+	}
+	if !reflect.DeepEqual(convertTrial(oldTrial), convertTrial(newTrial)) {
+		h.publishTrial(WatchEventModified, newTrial)
+	}
+}
+
+func (h *watchHub) onTrialDelete(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if trial, ok := obj.(*trialsv1beta1.Trial); ok {
+		h.publishTrial(WatchEventDeleted, trial)
+	}
+}
+
+func (h *watchHub) publishTrial(eventType string, trial *trialsv1beta1.Trial) {
+	experimentName := trial.Labels[consts.LabelExperimentName]
+	if experimentName == "" {
+		return
+	}
+	h.publish(types.NamespacedName{Namespace: trial.Namespace, Name: experimentName}, watchEvent{
+		name:  eventTrial,
+		data:  APITrialEvent{Type: eventType, Trial: convertTrial(trial)},
+		trial: trial,
+	})
+}
+
+// getWatchHub returns the hub for event streams, informers are started on the first call.
+func (k *KatibUIHandler) getWatchHub() (*watchHub, error) {
+	k.watchHubOnce.Do(func() {
+		if k.watchHub == nil {
+			k.watchHub, k.watchHubErr = startWatchHub()
+		}
+	})
+	return k.watchHub, k.watchHubErr
+}
+
+// apiWatchExperiment streams changes of the Experiment and its Trials as server-sent events.
+// The current state is sent first as ADDED events. New metric points of the Trials which are not
+// completed are read from Katib DB manager and sent as metrics events.
+func (k *KatibUIHandler) apiWatchExperiment(w http.ResponseWriter, r *http.Request, namespace, name string) {
+	for _, resource := range []string{ResourceExperiments, ResourceTrials} {
+		if status, err := k.checkAPIAccess(r, VerbWatch, resource, namespace); err != nil {
+			writeAPIError(w, status, err)
+			return
+		}
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeAPIError(w, http.StatusInternalServerError, fmt.Errorf("Streaming is not supported"))
+		return
+	}
+	hub, err := k.getWatchHub()
+	if err != nil {
+		log.Printf("Start informer cache failed: %v", err)
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	ctx := r.Context()
+	if !hub.waitForSync(ctx.Done()) {
+		return
+	}
+
+	// Subscribe before reading the current state, so changes are not missed
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	subscriber := hub.subscribe(key)
+	defer hub.unsubscribe(key, subscriber)
+
+	experiment := &experimentv1beta1.Experiment{}
+	if err := hub.reader.Get(ctx, key, experiment); err != nil {
+		writeKubernetesError(w, "GetExperiment", err)
+		return
+	}
+	trialList := &trialsv1beta1.TrialList{}
+	listOptions := client.InNamespace(namespace).MatchingLabels(map[string]string{consts.LabelExperimentName: name})
+	if err := hub.reader.List(ctx, listOptions, trialList); err != nil {
+		writeKubernetesError(w, "GetTrialList", err)
+		return
+	}
+
+	var dbManager api_pb_v1beta1.DBManagerClient
+	if conn, c, err := k.connectManager(); err == nil {
+		defer conn.Close()
+		dbManager = c
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Disable response buffering in nginx ingress
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	stream := &eventStream{w: w, flusher: flusher}
+
+	cursors := map[string]*metricLogCursor{}
+	stream.send(eventExperiment, APIExperimentEvent{Type: WatchEventAdded, Experiment: convertExperiment(experiment)})
+	for i := range trialList.Items {
+		trial := &trialList.Items[i]
+		stream.send(eventTrial, APITrialEvent{Type: WatchEventAdded, Trial: convertTrial(trial)})
+		followTrial(cursors, WatchEventAdded, trial)
+	}
+	streamMetricLogs(ctx, stream, dbManager, namespace, cursors)
+	if stream.flush() != nil {
+		return
+	}
+
+	ticker := time.NewTicker(hub.metricsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-subscriber.events:
+			if !ok {
+				return
+			}
+			stream.send(event.name, event.data)
+			if event.trial != nil {
+				followTrial(cursors, event.data.(APITrialEvent).Type, event.trial)
+			} else if event.data.(APIExperimentEvent).Type == WatchEventDeleted {
+				stream.flush()
+				return
+			}
+		case <-ticker.C:
+			if !streamMetricLogs(ctx, stream, dbManager, namespace, cursors) {
+				stream.comment("keep-alive")
+			}
+		}
+		if stream.flush() != nil {
+			return
+		}
+	}
+}
+
+// eventStream writes server-sent events, the first write error is kept and returned by flush.
+type eventStream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	err     error
+}
+
+func (s *eventStream) send(name string, data interface{}) {
+	if s.err != nil {
+		return
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		log.Printf("Marshal %v event failed: %v", name, err)
+		return
+	}
+	_, s.err = fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", name, body)
+}
+
+func (s *eventStream) comment(text string) {
+	if s.err == nil {
+		_, s.err = fmt.Fprintf(s.w, ": %s\n\n", text)
+	}
+}
+
+func (s *eventStream) flush() error {
+	if s.err == nil {
+		s.flusher.Flush()
+	}
+	return s.err
+}
+
+// metricLogCursor is the position of the last metric point which is sent for the Trial.
+type metricLogCursor struct {
+	uid string
+	// lastTime is the timestamp of the last sent metric point. Observation log is read from lastTime
+	// since the start time is inclusive, lastTimeLogs points at lastTime are skipped.
+	lastTime     string
+	lastTimeLogs int
+	// completed Trials are read for the last time and removed.
+	completed bool
+}
+
+// followTrial updates the metric log cursors by the Trial event. Metric logs are followed for the
+// Trials which are not completed, completed Trials are read once more to get the last points.
+func followTrial(cursors map[string]*metricLogCursor, eventType string, trial *trialsv1beta1.Trial) {
+	cursor, found := cursors[trial.Name]
+	if eventType == WatchEventDeleted || (found && cursor.uid != string(trial.UID)) {
+		delete(cursors, trial.Name)
+		found = false
+	}
+	if eventType == WatchEventDeleted {
+		return
+	}
+	if !found {
+		if trial.IsCompleted() {
+			return
+		}
+		cursor = &metricLogCursor{uid: string(trial.UID)}
+		cursors[trial.Name] = cursor
+	}
+	cursor.completed = trial.IsCompleted()
+}
+
+// streamMetricLogs sends new metric points of the followed Trials. It returns true if any event is sent.
+func streamMetricLogs(ctx context.Context, stream *eventStream, dbManager api_pb_v1beta1.DBManagerClient,
+	namespace string, cursors map[string]*metricLogCursor) bool {
+	if dbManager == nil {
+		return false
+	}
+	sent := false
+	for trialName, cursor := range cursors {
+		obsLogResp, err := dbManager.GetObservationLog(ctx, &api_pb_v1beta1.GetObservationLogRequest{
+			TrialName: trialName,
+			Namespace: namespace,
+			TrialUid:  cursor.uid,
+			StartTime: cursor.lastTime,
+		})
+		if err != nil {
+			log.Printf("GetObservationLog for Trial %v failed: %v", trialName, err)
+			continue
+		}
+		if cursor.completed {
+			delete(cursors, trialName)
+		}
+		event := APIMetricLogEvent{Trial: trialName, Items: []APIMetricLog{}}
+		skip := cursor.lastTimeLogs
+		for _, m := range obsLogResp.GetObservationLog().GetMetricLogs() {
+			if m.TimeStamp == cursor.lastTime && skip > 0 {
+				skip--
+				continue
+			}
+			if m.TimeStamp == cursor.lastTime {
+				cursor.lastTimeLogs++
+			} else {
+				cursor.lastTime = m.TimeStamp
+				cursor.lastTimeLogs = 1
+			}
+			event.Items = append(event.Items, APIMetricLog{
+				Name:      m.GetMetric().GetName(),
+				Timestamp: m.TimeStamp,
+				Value:     m.GetMetric().GetValue(),
+			})
+		}
+		if len(event.Items) > 0 {
+			stream.send(eventMetrics, event)
+			sent = true
+		}
+	}
+	return sent
+}
@@ -0,0 +1,228 @@
+package v1beta1
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	experimentv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// fakeReader reads the Experiment and Trials instead of the informer cache.
+type fakeReader struct {
+	experiment *experimentv1beta1.Experiment
+	trials     []trialsv1beta1.Trial
+}
+
+func (f *fakeReader) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	if key.Namespace != f.experiment.Namespace || key.Name != f.experiment.Name {
+		return errors.NewNotFound(schema.GroupResource{Group: "kubeflow.org", Resource: "experiments"}, key.Name)
+	}
+	f.experiment.DeepCopyInto(obj.(*experimentv1beta1.Experiment))
+	return nil
+}
+
+func (f *fakeReader) List(ctx context.Context, opts *client.ListOptions, list runtime.Object) error {
+	list.(*trialsv1beta1.TrialList).Items = f.trials
+	return nil
+}
+
+type serverSentEvent struct {
+	name string
+	data string
+}
+
+func readEvent(t *testing.T, r *bufio.Reader) *serverSentEvent {
+	event := &serverSentEvent{}
+	for {
+		line, err := r.ReadString('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			t.Fatalf("Read event failed: %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "" && event.name != "":
+			return event
+		case strings.HasPrefix(line, "event: "):
+			event.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestWatchExperiment(t *testing.T) {
+	experiment := newFakeExperiment()
+	trial := newFakeTrial()
+	trial.MarkTrialStatusRunning("", "")
+	hub := newWatchHub(&fakeReader{experiment: experiment, trials: []trialsv1beta1.Trial{*trial}},
+		func(stop <-chan struct{}) bool { return true })
+	hub.metricsInterval = time.Hour
+	k := &KatibUIHandler{watchHub: hub, dbManagerAddr: "localhost:1"}
+	server := httptest.NewServer(http.HandlerFunc(k.ServeAPI))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + APIPrefix + "namespaces/kubeflow/experiments/not-found/events")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status %v for not found Experiment, got %v", http.StatusNotFound, resp.StatusCode)
+	}
+
+	resp, err = http.Get(server.URL + APIPrefix + "namespaces/kubeflow/experiments/random-experiment/events")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("Unexpected response %v %v", resp.StatusCode, resp.Header)
+	}
+	r := bufio.NewReader(resp.Body)
+
+	experimentEvent := APIExperimentEvent{}
+	trialEvent := APITrialEvent{}
+	expectEvent := func(name string, data interface{}) {
+		event := readEvent(t, r)
+		if event == nil || event.name != name {
+			t.Fatalf("Expected %v event, got %+v", name, event)
+		}
+		if err := json.Unmarshal([]byte(event.data), data); err != nil {
+			t.Fatalf("Event data is not valid JSON: %v", err)
+		}
+	}
+
+	expectEvent(eventExperiment, &experimentEvent)
+	if experimentEvent.Type != WatchEventAdded || experimentEvent.Experiment.Name != "random-experiment" {
+		t.Errorf("Unexpected Experiment event %+v", experimentEvent)
+	}
+	expectEvent(eventTrial, &trialEvent)
+	if trialEvent.Type != WatchEventAdded || trialEvent.Trial.Status != string(trialsv1beta1.TrialRunning) {
+		t.Errorf("Unexpected Trial event %+v", trialEvent)
+	}
+
+	// Resync and Trials of other Experiments don't produce events
+	hub.onTrialUpdate(trial, trial.DeepCopy())
+	otherTrial := newFakeTrial()
+	otherTrial.Labels[consts.LabelExperimentName] = "other-experiment"
+	hub.onTrialAdd(otherTrial)
+
+	succeededTrial := trial.DeepCopy()
+	succeededTrial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "", "")
+	hub.onTrialUpdate(trial, succeededTrial)
+	expectEvent(eventTrial, &trialEvent)
+	if trialEvent.Type != WatchEventModified || trialEvent.Trial.Status != string(trialsv1beta1.TrialSucceeded) {
+		t.Errorf("Unexpected Trial event %+v", trialEvent)
+	}
+
+	hub.onExperimentDelete(experiment)
+	expectEvent(eventExperiment, &experimentEvent)
+	if experimentEvent.Type != WatchEventDeleted {
+		t.Errorf("Unexpected Experiment event %+v", experimentEvent)
+	}
+	if event := readEvent(t, r); event != nil {
+		t.Errorf("Expected stream to be closed after Experiment is deleted, got %+v", event)
+	}
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if len(hub.subscribers) != 0 {
+		t.Errorf("Expected no subscribers, got %v", hub.subscribers)
+	}
+}
+
+type fakeDBManager struct {
+	api_pb_v1beta1.DBManagerClient
+	requests []*api_pb_v1beta1.GetObservationLogRequest
+	logs     []*api_pb_v1beta1.MetricLog
+}
+
+func (f *fakeDBManager) GetObservationLog(ctx context.Context, in *api_pb_v1beta1.GetObservationLogRequest, opts ...grpc.CallOption) (*api_pb_v1beta1.GetObservationLogReply, error) {
+	f.requests = append(f.requests, in)
+	logs := []*api_pb_v1beta1.MetricLog{}
+	for _, m := range f.logs {
+		if m.TimeStamp >= in.StartTime {
+			logs = append(logs, m)
+		}
+	}
+	return &api_pb_v1beta1.GetObservationLogReply{ObservationLog: &api_pb_v1beta1.ObservationLog{MetricLogs: logs}}, nil
+}
+
+func newMetricLog(timestamp, value string) *api_pb_v1beta1.MetricLog {
+	return &api_pb_v1beta1.MetricLog{TimeStamp: timestamp, Metric: &api_pb_v1beta1.Metric{Name: "accuracy", Value: value}}
+}
+
+func TestStreamMetricLogs(t *testing.T) {
+	trial := newFakeTrial()
+	trial.UID = "trial-uid"
+	trial.MarkTrialStatusRunning("", "")
+	cursors := map[string]*metricLogCursor{}
+	followTrial(cursors, WatchEventAdded, trial)
+
+	dbManager := &fakeDBManager{logs: []*api_pb_v1beta1.MetricLog{
+		newMetricLog("2020-01-01T00:00:00Z", "0.5"),
+		newMetricLog("2020-01-01T00:00:00Z", "0.6"),
+	}}
+	rec := httptest.NewRecorder()
+	stream := &eventStream{w: rec, flusher: rec}
+
+	if !streamMetricLogs(context.Background(), stream, dbManager, "kubeflow", cursors) {
+		t.Fatalf("Expected metrics event to be sent")
+	}
+	// Points at the last time are not sent again
+	if streamMetricLogs(context.Background(), stream, dbManager, "kubeflow", cursors) {
+		t.Errorf("Expected no new metric points")
+	}
+	if request := dbManager.requests[1]; request.StartTime != "2020-01-01T00:00:00Z" || request.TrialUid != "trial-uid" {
+		t.Errorf("Unexpected request %+v", request)
+	}
+
+	// Last points are read once more when the Trial is completed
+	dbManager.logs = append(dbManager.logs, newMetricLog("2020-01-01T00:01:00Z", "0.9"))
+	completedTrial := trial.DeepCopy()
+	completedTrial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "", "")
+	followTrial(cursors, WatchEventModified, completedTrial)
+	if !streamMetricLogs(context.Background(), stream, dbManager, "kubeflow", cursors) {
+		t.Fatalf("Expected metrics event to be sent")
+	}
+	if len(cursors) != 0 {
+		t.Errorf("Expected completed Trial not to be followed, got %v", cursors)
+	}
+
+	r := bufio.NewReader(rec.Body)
+	expectedValues := [][]string{{"0.5", "0.6"}, {"0.9"}}
+	for _, values := range expectedValues {
+		event := readEvent(t, r)
+		metricLogEvent := APIMetricLogEvent{}
+		if event == nil || event.name != eventMetrics || json.Unmarshal([]byte(event.data), &metricLogEvent) != nil {
+			t.Fatalf("Unexpected event %+v", event)
+		}
+		if metricLogEvent.Trial != "random-trial" || len(metricLogEvent.Items) != len(values) {
+			t.Fatalf("Unexpected metrics event %+v", metricLogEvent)
+		}
+		for i, value := range values {
+			if metricLogEvent.Items[i].Value != value {
+				t.Errorf("Expected value %v, got %+v", value, metricLogEvent.Items[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,250 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun renders Trial templates of the Experiment with sample
+// parameter assignments and validates the result with Kubernetes
+// server-side dry-run, so invalid templates are found before any Trial runs.
+package dryrun
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	commonapiv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/manifest"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+)
+
+const (
+	// SampleMin assigns the minimum value (or the first list item) to every parameter.
+	SampleMin = "min"
+	// SampleMax assigns the maximum value (or the last list item) to every parameter.
+	SampleMax = "max"
+	// SampleRandom assigns a random value from the feasible space to every parameter.
+	SampleRandom = "random"
+)
+
+// Samples is the list of samples which are rendered for every dry-run.
+var Samples = []string{SampleMin, SampleMax, SampleRandom}
+
+// Result is the dry-run result for the one sample of parameter assignments.
+type Result struct {
+	// Sample is the name of the sample, e.g. min, max or random.
+	Sample string `json:"sample"`
+	// Assignments are the parameter assignments used to render the Trial template.
+	Assignments []commonapiv1beta1.ParameterAssignment `json:"assignments"`
+	// Manifest is the rendered Trial run spec in YAML format.
+	Manifest string `json:"manifest,omitempty"`
+	// Error is the render or server-side dry-run error, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Failed returns true if any of the results contains error.
+func Failed(results []Result) bool {
+	for _, r := range results {
+		if r.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// DryRunner is the interface to dry-run Trial templates.
+type DryRunner interface {
+	DryRunTrialTemplate(experiment *experimentsv1beta1.Experiment) ([]Result, error)
+}
+
+// DefaultDryRunner renders Trial templates using manifest.Generator
+// and creates them with server-side dry-run using dynamic client.
+type DefaultDryRunner struct {
+	generator     manifest.Generator
+	dynamicClient dynamic.Interface
+	restMapper    meta.RESTMapper
+}
+
+// New creates a new DryRunner.
+func New(cfg *rest.Config, generator manifest.Generator) (DryRunner, error) {
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create dynamic client: %v", err)
+	}
+	restMapper, err := apiutil.NewDiscoveryRESTMapper(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create REST mapper: %v", err)
+	}
+	return &DefaultDryRunner{
+		generator:     generator,
+		dynamicClient: dynamicClient,
+		restMapper:    restMapper,
+	}, nil
+}
+
+// DryRunTrialTemplate renders the Trial template of the experiment for min, max and random
+// parameter assignments and runs Kubernetes server-side dry-run for each rendered job.
+// The returned error is set only if the experiment can't be dry-run at all,
+// errors of the particular sample are reported in the Result.
+func (d *DefaultDryRunner) DryRunTrialTemplate(experiment *experimentsv1beta1.Experiment) ([]Result, error) {
+	if len(experiment.Spec.Parameters) == 0 {
+		return nil, fmt.Errorf("Dry-run is supported only for experiments with spec.parameters")
+	}
+	// rand.Rand is not safe for concurrent use, the UI backend runs the dry-run for parallel requests.
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	results := []Result{}
+	for _, sample := range Samples {
+		assignments, err := SampleAssignments(experiment.Spec.Parameters, sample, random)
+		if err != nil {
+			return nil, err
+		}
+		result := Result{
+			Sample:      sample,
+			Assignments: assignments,
+		}
+		trialName := fmt.Sprintf("%s-dry-run-%s", experiment.GetName(), sample)
+		manifest, err := d.dryRun(experiment, trialName, assignments)
+		result.Manifest = manifest
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (d *DefaultDryRunner) dryRun(experiment *experimentsv1beta1.Experiment, trialName string, assignments []commonapiv1beta1.ParameterAssignment) (string, error) {
+	runSpec, err := d.generator.GetRunSpecWithHyperParameters(experiment, trialName, experiment.GetNamespace(), assignments)
+	if err != nil {
+		return "", fmt.Errorf("Unable to render Trial template: %v", err)
+	}
+	if err := util.TrainingJobAnnotations(runSpec); err != nil {
+		return "", fmt.Errorf("Unable to append annotations to Trial template: %v", err)
+	}
+
+	manifestBytes, err := yaml.Marshal(runSpec.Object)
+	if err != nil {
+		return "", fmt.Errorf("Unable to marshal rendered Trial template: %v", err)
+	}
+	manifest := string(manifestBytes)
+
+	gvk := runSpec.GroupVersionKind()
+	mapping, err := d.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return manifest, fmt.Errorf("Unable to find resource for %v: %v", gvk, err)
+	}
+	resourceClient := d.dynamicClient.Resource(mapping.Resource)
+	createOptions := metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		_, err = resourceClient.Namespace(runSpec.GetNamespace()).Create(runSpec, createOptions)
+	} else {
+		_, err = resourceClient.Create(runSpec, createOptions)
+	}
+	if err != nil {
+		return manifest, fmt.Errorf("Server-side dry-run failed: %v", err)
+	}
+	return manifest, nil
+}
+
+// SampleAssignments returns the parameter assignments for the given sample.
+func SampleAssignments(parameters []experimentsv1beta1.ParameterSpec, sample string, random *rand.Rand) ([]commonapiv1beta1.ParameterAssignment, error) {
+	assignments := []commonapiv1beta1.ParameterAssignment{}
+	for _, param := range parameters {
+		value, err := sampleValue(param, sample, random)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to sample parameter %v: %v", param.Name, err)
+		}
+		assignments = append(assignments, commonapiv1beta1.ParameterAssignment{
+			Name:  param.Name,
+			Value: value,
+		})
+	}
+	return assignments, nil
+}
+
+func sampleValue(param experimentsv1beta1.ParameterSpec, sample string, random *rand.Rand) (string, error) {
+	feasibleSpace := param.FeasibleSpace
+	switch param.ParameterType {
+	case experimentsv1beta1.ParameterTypeCategorical, experimentsv1beta1.ParameterTypeDiscrete:
+		if len(feasibleSpace.List) == 0 {
+			return "", fmt.Errorf("feasibleSpace.list is empty")
+		}
+		switch sample {
+		case SampleMin:
+			return feasibleSpace.List[0], nil
+		case SampleMax:
+			return feasibleSpace.List[len(feasibleSpace.List)-1], nil
+		default:
+			return feasibleSpace.List[random.Intn(len(feasibleSpace.List))], nil
+		}
+	case experimentsv1beta1.ParameterTypeInt, experimentsv1beta1.ParameterTypeDouble:
+		// Only one of min or max can be specified, use it for both bounds.
+		min, max := feasibleSpace.Min, feasibleSpace.Max
+		if min == "" {
+			min = max
+		}
+		if max == "" {
+			max = min
+		}
+		switch sample {
+		case SampleMin:
+			return min, nil
+		case SampleMax:
+			return max, nil
+		}
+		if param.ParameterType == experimentsv1beta1.ParameterTypeInt {
+			minInt, err := strconv.Atoi(min)
+			if err != nil {
+				return "", fmt.Errorf("feasibleSpace.min is not integer: %v", err)
+			}
+			maxInt, err := strconv.Atoi(max)
+			if err != nil {
+				return "", fmt.Errorf("feasibleSpace.max is not integer: %v", err)
+			}
+			if maxInt < minInt {
+				return "", fmt.Errorf("feasibleSpace.max is less than feasibleSpace.min")
+			}
+			return strconv.Itoa(minInt + random.Intn(maxInt-minInt+1)), nil
+		}
+		minFloat, err := strconv.ParseFloat(min, 64)
+		if err != nil {
+			return "", fmt.Errorf("feasibleSpace.min is not float: %v", err)
+		}
+		maxFloat, err := strconv.ParseFloat(max, 64)
+		if err != nil {
+			return "", fmt.Errorf("feasibleSpace.max is not float: %v", err)
+		}
+		if maxFloat < minFloat {
+			return "", fmt.Errorf("feasibleSpace.max is less than feasibleSpace.min")
+		}
+		logUniform := feasibleSpace.Distribution == experimentsv1beta1.DistributionLogUniform ||
+			feasibleSpace.Distribution == experimentsv1beta1.DistributionQLogUniform
+		if logUniform && minFloat > 0 {
+			logMin, logMax := math.Log(minFloat), math.Log(maxFloat)
+			return strconv.FormatFloat(math.Exp(logMin+random.Float64()*(logMax-logMin)), 'f', -1, 64), nil
+		}
+		return strconv.FormatFloat(minFloat+random.Float64()*(maxFloat-minFloat), 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("parameterType: %v is not supported", param.ParameterType)
+	}
+}
@@ -0,0 +1,83 @@
+package dryrun
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+func TestSampleAssignments(t *testing.T) {
+	parameters := []experimentsv1beta1.ParameterSpec{
+		{
+			Name:          "lr",
+			ParameterType: experimentsv1beta1.ParameterTypeDouble,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{Min: "0.01", Max: "0.05"},
+		},
+		{
+			Name:          "num-layers",
+			ParameterType: experimentsv1beta1.ParameterTypeInt,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{Min: "2", Max: "5"},
+		},
+		{
+			Name:          "optimizer",
+			ParameterType: experimentsv1beta1.ParameterTypeCategorical,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{List: []string{"sgd", "adam", "ftrl"}},
+		},
+	}
+	random := rand.New(rand.NewSource(1))
+
+	tcs := []struct {
+		sample          string
+		expected        []string
+		testDescription string
+	}{
+		{
+			sample:          SampleMin,
+			expected:        []string{"0.01", "2", "sgd"},
+			testDescription: "Min sample",
+		},
+		{
+			sample:          SampleMax,
+			expected:        []string{"0.05", "5", "ftrl"},
+			testDescription: "Max sample",
+		},
+	}
+	for _, tc := range tcs {
+		assignments, err := SampleAssignments(parameters, tc.sample, random)
+		if err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+			continue
+		}
+		for i, a := range assignments {
+			if a.Name != parameters[i].Name || a.Value != tc.expected[i] {
+				t.Errorf("Case: %v failed. Expected %v=%v, got %v=%v", tc.testDescription, parameters[i].Name, tc.expected[i], a.Name, a.Value)
+			}
+		}
+	}
+
+	assignments, err := SampleAssignments(parameters, SampleRandom, random)
+	if err != nil {
+		t.Fatalf("Random sample failed: %v", err)
+	}
+	lr, err := strconv.ParseFloat(assignments[0].Value, 64)
+	if err != nil || lr < 0.01 || lr > 0.05 {
+		t.Errorf("Random double %v is out of feasible space", assignments[0].Value)
+	}
+	layers, err := strconv.Atoi(assignments[1].Value)
+	if err != nil || layers < 2 || layers > 5 {
+		t.Errorf("Random int %v is out of feasible space", assignments[1].Value)
+	}
+
+	invalid := []experimentsv1beta1.ParameterSpec{
+		{
+			Name:          "num-layers",
+			ParameterType: experimentsv1beta1.ParameterTypeInt,
+			FeasibleSpace: experimentsv1beta1.FeasibleSpace{Min: "5", Max: "2"},
+		},
+	}
+	if _, err := SampleAssignments(invalid, SampleRandom, random); err == nil {
+		t.Errorf("Expected error for max less than min, got nil")
+	}
+}
@@ -0,0 +1,494 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package expression implements the small expression language which is used in Trial template
+// substitutions, e.g. ${trialParameters.learningRate * 10} or ${trialParameters.format("%d", numberLayers)}.
+//
+// The language supports number and string literals, variables, arithmetic operators (+, -, *, /, %),
+// parentheses and the set of built-in functions (see Functions). Variables are strings, they are
+// converted to numbers in arithmetic operations. The "+" operator adds numbers and
+// concatenates strings if any operand is not a number.
+// Expressions can't have side effects, loops or access to anything except given variables.
+package expression
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expression is the parsed expression.
+type Expression struct {
+	source string
+	root   node
+}
+
+// Parse parses the expression.
+func Parse(source string) (*Expression, error) {
+	p := &parser{lexer: lexer{input: source}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if p.token.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %v in expression %q", p.token.text, p.token.pos, source)
+	}
+	return &Expression{source: source, root: root}, nil
+}
+
+// Identifiers returns the unique names of variables which are used in the expression.
+func (e *Expression) Identifiers() []string {
+	seen := map[string]bool{}
+	identifiers := []string{}
+	walk(e.root, func(n node) {
+		if id, ok := n.(identNode); ok && !seen[id.name] {
+			seen[id.name] = true
+			identifiers = append(identifiers, id.name)
+		}
+	})
+	return identifiers
+}
+
+// Evaluate evaluates the expression with the given variables and returns the result as string.
+func (e *Expression) Evaluate(variables map[string]string) (string, error) {
+	v, err := e.root.eval(variables)
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate expression %q: %v", e.source, err)
+	}
+	return v.String(), nil
+}
+
+// value is the result of the node evaluation, it is either number or string.
+type value struct {
+	str   string
+	num   float64
+	isNum bool
+}
+
+func numberValue(n float64) value {
+	return value{num: n, isNum: true}
+}
+
+func stringValue(s string) value {
+	return value{str: s}
+}
+
+func (v value) String() string {
+	if !v.isNum {
+		return v.str
+	}
+	return formatNumber(v.num)
+}
+
+func (v value) Number() (float64, error) {
+	if v.isNum {
+		return v.num, nil
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(v.str), 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", v.str)
+	}
+	return n, nil
+}
+
+// formatNumber formats integral numbers without fractional part and others in the shortest form.
+func formatNumber(n float64) string {
+	if n == math.Trunc(n) && math.Abs(n) < 1e15 {
+		return strconv.FormatInt(int64(n), 10)
+	}
+	return strconv.FormatFloat(n, 'g', -1, 64)
+}
+
+type node interface {
+	eval(variables map[string]string) (value, error)
+}
+
+type numberNode struct {
+	value float64
+}
+
+func (n numberNode) eval(map[string]string) (value, error) {
+	return numberValue(n.value), nil
+}
+
+type stringNode struct {
+	value string
+}
+
+func (n stringNode) eval(map[string]string) (value, error) {
+	return stringValue(n.value), nil
+}
+
+type identNode struct {
+	name string
+}
+
+func (n identNode) eval(variables map[string]string) (value, error) {
+	v, ok := variables[n.name]
+	if !ok {
+		return value{}, fmt.Errorf("unknown variable %q", n.name)
+	}
+	return stringValue(v), nil
+}
+
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+func (n unaryNode) eval(variables map[string]string) (value, error) {
+	v, err := n.operand.eval(variables)
+	if err != nil {
+		return value{}, err
+	}
+	num, err := v.Number()
+	if err != nil {
+		return value{}, fmt.Errorf("operator %v: %v", n.op, err)
+	}
+	if n.op == "-" {
+		num = -num
+	}
+	return numberValue(num), nil
+}
+
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+func (n binaryNode) eval(variables map[string]string) (value, error) {
+	l, err := n.left.eval(variables)
+	if err != nil {
+		return value{}, err
+	}
+	r, err := n.right.eval(variables)
+	if err != nil {
+		return value{}, err
+	}
+	lNum, lErr := l.Number()
+	rNum, rErr := r.Number()
+	if n.op == "+" && (lErr != nil || rErr != nil) {
+		return stringValue(l.String() + r.String()), nil
+	}
+	if lErr != nil {
+		return value{}, fmt.Errorf("operator %v: %v", n.op, lErr)
+	}
+	if rErr != nil {
+		return value{}, fmt.Errorf("operator %v: %v", n.op, rErr)
+	}
+	switch n.op {
+	case "+":
+		return numberValue(lNum + rNum), nil
+	case "-":
+		return numberValue(lNum - rNum), nil
+	case "*":
+		return numberValue(lNum * rNum), nil
+	case "/":
+		if rNum == 0 {
+			return value{}, fmt.Errorf("division by zero")
+		}
+		return numberValue(lNum / rNum), nil
+	case "%":
+		if rNum == 0 {
+			return value{}, fmt.Errorf("division by zero")
+		}
+		return numberValue(math.Mod(lNum, rNum)), nil
+	}
+	return value{}, fmt.Errorf("unknown operator %v", n.op)
+}
+
+type callNode struct {
+	function string
+	args     []node
+}
+
+func (n callNode) eval(variables map[string]string) (value, error) {
+	args := make([]value, 0, len(n.args))
+	for _, arg := range n.args {
+		v, err := arg.eval(variables)
+		if err != nil {
+			return value{}, err
+		}
+		args = append(args, v)
+	}
+	v, err := Functions[n.function].call(args)
+	if err != nil {
+		return value{}, fmt.Errorf("%v(): %v", n.function, err)
+	}
+	return v, nil
+}
+
+func walk(n node, visit func(node)) {
+	visit(n)
+	switch t := n.(type) {
+	case unaryNode:
+		walk(t.operand, visit)
+	case binaryNode:
+		walk(t.left, visit)
+		walk(t.right, visit)
+	case callNode:
+		for _, arg := range t.args {
+			walk(arg, visit)
+		}
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+type lexer struct {
+	input string
+	pos   int
+}
+
+func isIdentStart(r byte) bool {
+	return r == '_' || unicode.IsLetter(rune(r))
+}
+
+func isIdentPart(r byte) bool {
+	// Dot is allowed to access built-in variables, e.g. experiment.name
+	return isIdentStart(r) || unicode.IsDigit(rune(r)) || r == '.'
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.input) && unicode.IsSpace(rune(l.input[l.pos])) {
+		l.pos++
+	}
+	start := l.pos
+	if l.pos >= len(l.input) {
+		return token{kind: tokenEOF, pos: start}, nil
+	}
+	c := l.input[l.pos]
+	switch {
+	case unicode.IsDigit(rune(c)) || (c == '.' && l.pos+1 < len(l.input) && unicode.IsDigit(rune(l.input[l.pos+1]))):
+		for l.pos < len(l.input) && (unicode.IsDigit(rune(l.input[l.pos])) || l.input[l.pos] == '.') {
+			l.pos++
+		}
+		// Exponent, e.g. 1e-5
+		if l.pos < len(l.input) && (l.input[l.pos] == 'e' || l.input[l.pos] == 'E') {
+			l.pos++
+			if l.pos < len(l.input) && (l.input[l.pos] == '+' || l.input[l.pos] == '-') {
+				l.pos++
+			}
+			for l.pos < len(l.input) && unicode.IsDigit(rune(l.input[l.pos])) {
+				l.pos++
+			}
+		}
+		return token{kind: tokenNumber, text: l.input[start:l.pos], pos: start}, nil
+	case isIdentStart(c):
+		for l.pos < len(l.input) && isIdentPart(l.input[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokenIdent, text: l.input[start:l.pos], pos: start}, nil
+	case c == '"' || c == '\'':
+		var sb strings.Builder
+		l.pos++
+		for l.pos < len(l.input) && l.input[l.pos] != c {
+			if l.input[l.pos] == '\\' && l.pos+1 < len(l.input) {
+				l.pos++
+			}
+			sb.WriteByte(l.input[l.pos])
+			l.pos++
+		}
+		if l.pos >= len(l.input) {
+			return token{}, fmt.Errorf("unterminated string at position %v in expression %q", start, l.input)
+		}
+		l.pos++
+		return token{kind: tokenString, text: sb.String(), pos: start}, nil
+	case strings.IndexByte("+-*/%(),", c) >= 0:
+		l.pos++
+		return token{kind: tokenOperator, text: string(c), pos: start}, nil
+	}
+	return token{}, fmt.Errorf("unexpected character %q at position %v in expression %q", c, start, l.input)
+}
+
+// parser is the recursive descent parser for the grammar:
+//   expression = term { ("+" | "-") term }
+//   term       = unary { ("*" | "/" | "%") unary }
+//   unary      = ("-" | "+") unary | primary
+//   primary    = number | string | identifier | identifier "(" [ expression { "," expression } ] ")" | "(" expression ")"
+type parser struct {
+	lexer lexer
+	token token
+}
+
+func (p *parser) next() error {
+	t, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.token = t
+	return nil
+}
+
+func (p *parser) isOperator(ops ...string) bool {
+	if p.token.kind != tokenOperator {
+		return false
+	}
+	for _, op := range ops {
+		if p.token.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	if !p.isOperator(op) {
+		if p.token.kind == tokenEOF {
+			return fmt.Errorf("expected %q at the end of expression %q", op, p.lexer.input)
+		}
+		return fmt.Errorf("expected %q at position %v in expression %q", op, p.token.pos, p.lexer.input)
+	}
+	return p.next()
+}
+
+func (p *parser) parseExpression() (node, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("+", "-") {
+		op := p.token.text
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseTerm() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("*", "/", "%") {
+		op := p.token.text
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOperator("-", "+") {
+		op := p.token.text
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: op, operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.token
+	switch t.kind {
+	case tokenNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %v in expression %q", t.text, t.pos, p.lexer.input)
+		}
+		return numberNode{value: n}, p.next()
+	case tokenString:
+		return stringNode{value: t.text}, p.next()
+	case tokenIdent:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if !p.isOperator("(") {
+			return identNode{name: t.text}, nil
+		}
+		return p.parseCall(t)
+	case tokenOperator:
+		if t.text == "(" {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			n, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			return n, p.expect(")")
+		}
+	case tokenEOF:
+		return nil, fmt.Errorf("unexpected end of expression %q", p.lexer.input)
+	}
+	return nil, fmt.Errorf("unexpected %q at position %v in expression %q", t.text, t.pos, p.lexer.input)
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	f, ok := Functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %v in expression %q", name.text, name.pos, p.lexer.input)
+	}
+	// Skip "("
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	args := []node{}
+	for !p.isOperator(")") {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if len(args) < f.minArgs || (f.maxArgs >= 0 && len(args) > f.maxArgs) {
+		return nil, fmt.Errorf("invalid number of arguments for function %q in expression %q", name.text, p.lexer.input)
+	}
+	return callNode{function: name.text, args: args}, nil
+}
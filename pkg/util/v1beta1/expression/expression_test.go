@@ -0,0 +1,187 @@
+package expression
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	variables := map[string]string{
+		"learningRate":    "0.01",
+		"numberLayers":    "3",
+		"optimizer":       "adam",
+		"emptyValue":      "",
+		"experiment.name": "mnist",
+	}
+
+	tcs := []struct {
+		expression      string
+		expected        string
+		err             bool
+		testDescription string
+	}{
+		{
+			expression:      "learningRate",
+			expected:        "0.01",
+			testDescription: "Variable value is not changed",
+		},
+		{
+			expression:      "learningRate * 10",
+			expected:        "0.1",
+			testDescription: "Multiplication of float",
+		},
+		{
+			expression:      "numberLayers * 2 + 1",
+			expected:        "7",
+			testDescription: "Operator precedence",
+		},
+		{
+			expression:      "(numberLayers + 1) * -2",
+			expected:        "-8",
+			testDescription: "Parentheses and unary minus",
+		},
+		{
+			expression:      "int(numberLayers / 2)",
+			expected:        "1",
+			testDescription: "Integer conversion",
+		},
+		{
+			expression:      "pow(10, -numberLayers)",
+			expected:        "0.001",
+			testDescription: "Power function",
+		},
+		{
+			expression:      `format("%.3f", learningRate)`,
+			expected:        "0.010",
+			testDescription: "Float formatting",
+		},
+		{
+			expression:      `format("%03d-%s", numberLayers, optimizer)`,
+			expected:        "003-adam",
+			testDescription: "Integer and string formatting",
+		},
+		{
+			expression:      `join("-", experiment.name, optimizer, numberLayers)`,
+			expected:        "mnist-adam-3",
+			testDescription: "Join values with built-in variable",
+		},
+		{
+			expression:      `optimizer + "-" + numberLayers`,
+			expected:        "adam-3",
+			testDescription: "String concatenation",
+		},
+		{
+			expression:      `default(emptyValue, 'sgd')`,
+			expected:        "sgd",
+			testDescription: "Default for empty value",
+		},
+		{
+			expression:      `default(optimizer, 'sgd')`,
+			expected:        "adam",
+			testDescription: "Default for not empty value",
+		},
+		{
+			expression:      "optimizer * 2",
+			err:             true,
+			testDescription: "Arithmetic operation with string",
+		},
+		{
+			expression:      "numberLayers / 0",
+			err:             true,
+			testDescription: "Division by zero",
+		},
+		{
+			expression:      "unknownParameter",
+			err:             true,
+			testDescription: "Unknown variable",
+		},
+		{
+			expression:      `format("%999999999d", numberLayers)`,
+			err:             true,
+			testDescription: "Format width is too large",
+		},
+		{
+			expression:      `format("%.100f", learningRate)`,
+			err:             true,
+			testDescription: "Format precision is too large",
+		},
+	}
+
+	for _, tc := range tcs {
+		e, err := Parse(tc.expression)
+		if err != nil {
+			t.Errorf("Case: %v failed. Parse error: %v", tc.testDescription, err)
+			continue
+		}
+		actual, err := e.Evaluate(variables)
+		if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		} else if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if actual != tc.expected {
+			t.Errorf("Case: %v failed. Expected %v, got %v", tc.testDescription, tc.expected, actual)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tcs := []struct {
+		expression      string
+		identifiers     []string
+		err             bool
+		testDescription string
+	}{
+		{
+			expression:      `format("%d", batchSize * workers) + batchSize`,
+			identifiers:     []string{"batchSize", "workers"},
+			testDescription: "Valid expression",
+		},
+		{
+			expression:      "learningRate *",
+			err:             true,
+			testDescription: "Missing operand",
+		},
+		{
+			expression:      "(learningRate * 2",
+			err:             true,
+			testDescription: "Missing parenthesis",
+		},
+		{
+			expression:      "exec(learningRate)",
+			err:             true,
+			testDescription: "Unknown function",
+		},
+		{
+			expression:      "pow(learningRate)",
+			err:             true,
+			testDescription: "Invalid number of arguments",
+		},
+		{
+			expression:      `"unterminated`,
+			err:             true,
+			testDescription: "Unterminated string",
+		},
+		{
+			expression:      "learningRate; rm",
+			err:             true,
+			testDescription: "Invalid character",
+		},
+	}
+
+	for _, tc := range tcs {
+		e, err := Parse(tc.expression)
+		if tc.err {
+			if err == nil {
+				t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+			continue
+		}
+		if !reflect.DeepEqual(e.Identifiers(), tc.identifiers) {
+			t.Errorf("Case: %v failed. Expected identifiers %v, got %v", tc.testDescription, tc.identifiers, e.Identifiers())
+		}
+	}
+}
@@ -0,0 +1,201 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expression
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxFormatWidth is the maximum width and precision of the format verb.
+const maxFormatWidth = 64
+
+// Function is the built-in function of the expression language.
+type Function struct {
+	minArgs int
+	// maxArgs is -1 for variadic functions.
+	maxArgs int
+	call    func(args []value) (value, error)
+}
+
+// Functions is the list of built-in functions which can be used in expressions.
+var Functions = map[string]Function{
+	// int(x) truncates x to integer.
+	"int": numberFunction(math.Trunc),
+	// float(x) converts x to number.
+	"float": numberFunction(func(x float64) float64 { return x }),
+	"round": numberFunction(math.Round),
+	"floor": numberFunction(math.Floor),
+	"ceil":  numberFunction(math.Ceil),
+	"abs":   numberFunction(math.Abs),
+	"exp":   numberFunction(math.Exp),
+	"log":   numberFunction(math.Log),
+	"log10": numberFunction(math.Log10),
+	"pow": {minArgs: 2, maxArgs: 2, call: func(args []value) (value, error) {
+		nums, err := numbers(args)
+		if err != nil {
+			return value{}, err
+		}
+		return numberValue(math.Pow(nums[0], nums[1])), nil
+	}},
+	"min": {minArgs: 1, maxArgs: -1, call: func(args []value) (value, error) {
+		nums, err := numbers(args)
+		if err != nil {
+			return value{}, err
+		}
+		res := nums[0]
+		for _, n := range nums[1:] {
+			res = math.Min(res, n)
+		}
+		return numberValue(res), nil
+	}},
+	"max": {minArgs: 1, maxArgs: -1, call: func(args []value) (value, error) {
+		nums, err := numbers(args)
+		if err != nil {
+			return value{}, err
+		}
+		res := nums[0]
+		for _, n := range nums[1:] {
+			res = math.Max(res, n)
+		}
+		return numberValue(res), nil
+	}},
+	// str(x) converts x to string, e.g. to concatenate numbers.
+	"str": stringFunction(func(s string) string { return s }),
+	"lower": stringFunction(strings.ToLower),
+	"upper": stringFunction(strings.ToUpper),
+	// join(sep, a, b, ...) joins values with the separator.
+	"join": {minArgs: 2, maxArgs: -1, call: func(args []value) (value, error) {
+		elems := []string{}
+		for _, arg := range args[1:] {
+			elems = append(elems, arg.String())
+		}
+		return stringValue(strings.Join(elems, args[0].String())), nil
+	}},
+	// default(x, fallback) returns fallback if x is empty.
+	"default": {minArgs: 2, maxArgs: 2, call: func(args []value) (value, error) {
+		if args[0].String() == "" {
+			return args[1], nil
+		}
+		return args[0], nil
+	}},
+	// format(format, args...) formats values, only %d, %f, %e, %g, %s, %x and %% verbs
+	// with flags, width and precision are supported.
+	"format": {minArgs: 1, maxArgs: -1, call: func(args []value) (value, error) {
+		return format(args[0].String(), args[1:])
+	}},
+}
+
+func numberFunction(f func(float64) float64) Function {
+	return Function{minArgs: 1, maxArgs: 1, call: func(args []value) (value, error) {
+		n, err := args[0].Number()
+		if err != nil {
+			return value{}, err
+		}
+		return numberValue(f(n)), nil
+	}}
+}
+
+func stringFunction(f func(string) string) Function {
+	return Function{minArgs: 1, maxArgs: 1, call: func(args []value) (value, error) {
+		return stringValue(f(args[0].String())), nil
+	}}
+}
+
+func numbers(args []value) ([]float64, error) {
+	nums := make([]float64, 0, len(args))
+	for _, arg := range args {
+		n, err := arg.Number()
+		if err != nil {
+			return nil, err
+		}
+		nums = append(nums, n)
+	}
+	return nums, nil
+}
+
+func format(formatStr string, args []value) (value, error) {
+	var sb strings.Builder
+	argIndex := 0
+	for i := 0; i < len(formatStr); i++ {
+		if formatStr[i] != '%' {
+			sb.WriteByte(formatStr[i])
+			continue
+		}
+		// Parse verb with flags, width and precision, e.g. %-08.3f
+		j := i + 1
+		for j < len(formatStr) && strings.IndexByte("+- #0123456789.", formatStr[j]) >= 0 {
+			j++
+		}
+		if j >= len(formatStr) {
+			return value{}, fmt.Errorf("missing verb in format %q", formatStr)
+		}
+		verb := formatStr[i : j+1]
+		if err := validateVerbWidth(verb); err != nil {
+			return value{}, fmt.Errorf("%v in format %q", err, formatStr)
+		}
+		if formatStr[j] == '%' {
+			sb.WriteByte('%')
+			i = j
+			continue
+		}
+		if argIndex >= len(args) {
+			return value{}, fmt.Errorf("missing argument for %v in format %q", verb, formatStr)
+		}
+		arg := args[argIndex]
+		argIndex++
+		switch formatStr[j] {
+		case 'd', 'x':
+			n, err := arg.Number()
+			if err != nil {
+				return value{}, err
+			}
+			sb.WriteString(fmt.Sprintf(verb, int64(n)))
+		case 'f', 'e', 'g', 'E', 'G':
+			n, err := arg.Number()
+			if err != nil {
+				return value{}, err
+			}
+			sb.WriteString(fmt.Sprintf(verb, n))
+		case 's':
+			sb.WriteString(fmt.Sprintf(verb, arg.String()))
+		default:
+			return value{}, fmt.Errorf("unsupported verb %v in format %q", verb, formatStr)
+		}
+		i = j
+	}
+	if argIndex != len(args) {
+		return value{}, fmt.Errorf("too many arguments for format %q", formatStr)
+	}
+	return stringValue(sb.String()), nil
+}
+
+// validateVerbWidth checks that the width and precision of the verb don't exceed maxFormatWidth,
+// so the formatted value can't grow unbounded.
+func validateVerbWidth(verb string) error {
+	for _, field := range strings.Split(strings.TrimLeft(verb[1:len(verb)-1], "+- #"), ".") {
+		if field == "" {
+			continue
+		}
+		n, err := strconv.Atoi(field)
+		if err != nil || n > maxFormatWidth {
+			return fmt.Errorf("width and precision of %v must be at most %v", verb, maxFormatWidth)
+		}
+	}
+	return nil
+}
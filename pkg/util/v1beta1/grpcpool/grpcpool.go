@@ -0,0 +1,142 @@
+// Package grpcpool caches gRPC client connections by endpoint, so controllers
+// reuse the connection instead of dialing and closing it for every call.
+package grpcpool
+
+import (
+	"sync"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+)
+
+// DefaultIdleTimeout is the time after which the unused connection is closed.
+const DefaultIdleTimeout = 10 * time.Minute
+
+// DialFunc dials a new connection to the endpoint.
+type DialFunc func(endpoint string) (*grpc.ClientConn, error)
+
+// Pool is the cache of gRPC client connections keyed by endpoint.
+type Pool struct {
+	mu          sync.Mutex
+	conns       map[string]*pooledConn
+	dial        DialFunc
+	idleTimeout time.Duration
+	now         func() time.Time
+}
+
+type pooledConn struct {
+	conn *grpc.ClientConn
+	// refs is the number of callers which hold the connection
+	refs     int
+	lastUsed time.Time
+}
+
+// New creates a new Pool. Connections unused for idleTimeout are closed.
+func New(dial DialFunc, idleTimeout time.Duration) *Pool {
+	return &Pool{
+		conns:       make(map[string]*pooledConn),
+		dial:        dial,
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+	}
+}
+
+// Get returns the cached connection to the endpoint or dials a new one.
+// Caller must call the returned release function when it doesn't use the connection anymore.
+// Connection which is shut down is dialed again. Other failures are recovered by gRPC,
+// which reconnects to the endpoint, e.g. after the Service of the endpoint is recreated.
+func (p *Pool) Get(endpoint string) (*grpc.ClientConn, func(), error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	p.evictIdle(now)
+	c, ok := p.conns[endpoint]
+	if !ok || c.conn.GetState() == connectivity.Shutdown {
+		conn, err := p.dial(endpoint)
+		if err != nil {
+			return nil, nil, err
+		}
+		c = &pooledConn{conn: conn}
+		p.conns[endpoint] = c
+	}
+	c.refs++
+	c.lastUsed = now
+	once := sync.Once{}
+	return c.conn, func() { once.Do(func() { p.release(endpoint, c) }) }, nil
+}
+
+// release decrements references of the connection. Connection which is removed
+// from the Pool is closed when the last caller releases it.
+func (p *Pool) release(endpoint string, c *pooledConn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	c.refs--
+	c.lastUsed = p.now()
+	if c.refs == 0 && p.conns[endpoint] != c {
+		c.conn.Close()
+	}
+}
+
+// Remove removes the connection to the endpoint from the Pool.
+// Connection is closed immediately if it isn't used, otherwise when it is released.
+func (p *Pool) Remove(endpoint string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if c, ok := p.conns[endpoint]; ok {
+		delete(p.conns, endpoint)
+		if c.refs == 0 {
+			c.conn.Close()
+		}
+	}
+}
+
+// Len returns the number of cached connections.
+func (p *Pool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.conns)
+}
+
+// evictIdle closes connections which aren't used by anyone for idleTimeout.
+func (p *Pool) evictIdle(now time.Time) {
+	if p.idleTimeout <= 0 {
+		return
+	}
+	for endpoint, c := range p.conns {
+		if c.refs == 0 && now.Sub(c.lastUsed) > p.idleTimeout {
+			c.conn.Close()
+			delete(p.conns, endpoint)
+		}
+	}
+}
+
+// defaultPool is shared by Katib controllers.
+var defaultPool = New(dialWithTLS, DefaultIdleTimeout)
+
+// dialWithTLS dials the endpoint with TLS from the environment variables.
+// Retry interceptors are added without default retries, callers set retries with call options.
+func dialWithTLS(endpoint string) (*grpc.ClientConn, error) {
+	tlsOpt, err := grpctls.ConfigFromEnv().DialOption()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Dial(endpoint, tlsOpt,
+		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor()),
+		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor()),
+	)
+}
+
+// Get returns the connection to the endpoint from the shared Pool and the function to release it.
+func Get(endpoint string) (*grpc.ClientConn, func(), error) {
+	return defaultPool.Get(endpoint)
+}
+
+// Remove removes the connection to the endpoint from the shared Pool.
+func Remove(endpoint string) {
+	defaultPool.Remove(endpoint)
+}
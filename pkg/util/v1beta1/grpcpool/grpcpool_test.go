@@ -0,0 +1,112 @@
+package grpcpool
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+func newTestPool(dialCount *int) *Pool {
+	return New(func(endpoint string) (*grpc.ClientConn, error) {
+		*dialCount++
+		return grpc.Dial(endpoint, grpc.WithInsecure())
+	}, time.Minute)
+}
+
+func TestPoolGet(t *testing.T) {
+	dialCount := 0
+	p := newTestPool(&dialCount)
+
+	conn1, release1, err := p.Get("katib-suggestion-1.kubeflow:6789")
+	if err != nil {
+		t.Fatalf("Get() returns error: %v", err)
+	}
+	defer release1()
+	conn2, release2, err := p.Get("katib-suggestion-1.kubeflow:6789")
+	if err != nil {
+		t.Fatalf("Get() returns error: %v", err)
+	}
+	defer release2()
+	if conn1 != conn2 || dialCount != 1 {
+		t.Errorf("Expected cached connection for the same endpoint, dialed %v times", dialCount)
+	}
+
+	_, release3, err := p.Get("katib-suggestion-2.kubeflow:6789")
+	if err != nil {
+		t.Fatalf("Get() returns error: %v", err)
+	}
+	defer release3()
+	if p.Len() != 2 || dialCount != 2 {
+		t.Errorf("Expected 2 connections for different endpoints, got %v connections", p.Len())
+	}
+
+	// Connection which is shut down is dialed again
+	conn1.Close()
+	conn4, release4, err := p.Get("katib-suggestion-1.kubeflow:6789")
+	if err != nil {
+		t.Fatalf("Get() returns error: %v", err)
+	}
+	defer release4()
+	if conn4 == conn1 || dialCount != 3 {
+		t.Errorf("Expected new connection after the cached one is closed, dialed %v times", dialCount)
+	}
+}
+
+func TestPoolRemove(t *testing.T) {
+	dialCount := 0
+	p := newTestPool(&dialCount)
+
+	conn, release, err := p.Get("katib-suggestion.kubeflow:6789")
+	if err != nil {
+		t.Fatalf("Get() returns error: %v", err)
+	}
+	p.Remove("katib-suggestion.kubeflow:6789")
+	if p.Len() != 0 {
+		t.Errorf("Expected no connections after Remove(), got %v", p.Len())
+	}
+	if conn.GetState() == connectivity.Shutdown {
+		t.Errorf("Expected removed connection not to be closed while it is used")
+	}
+	release()
+	if conn.GetState() != connectivity.Shutdown {
+		t.Errorf("Expected removed connection to be closed after release, got state %v", conn.GetState())
+	}
+	// Removing unknown endpoint is no-op
+	p.Remove("unknown.kubeflow:6789")
+}
+
+func TestPoolEvictIdle(t *testing.T) {
+	dialCount := 0
+	p := newTestPool(&dialCount)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	idleConn, release1, err := p.Get("katib-suggestion-1.kubeflow:6789")
+	if err != nil {
+		t.Fatalf("Get() returns error: %v", err)
+	}
+	release1()
+	usedConn, release2, err := p.Get("katib-suggestion-2.kubeflow:6789")
+	if err != nil {
+		t.Fatalf("Get() returns error: %v", err)
+	}
+	defer release2()
+
+	now = now.Add(2 * time.Minute)
+	_, release3, err := p.Get("katib-suggestion-3.kubeflow:6789")
+	if err != nil {
+		t.Fatalf("Get() returns error: %v", err)
+	}
+	defer release3()
+	if p.Len() != 2 {
+		t.Errorf("Expected only unused idle connection to be evicted, got %v connections", p.Len())
+	}
+	if idleConn.GetState() != connectivity.Shutdown {
+		t.Errorf("Expected evicted connection to be closed, got state %v", idleConn.GetState())
+	}
+	if usedConn.GetState() == connectivity.Shutdown {
+		t.Errorf("Expected used connection not to be closed")
+	}
+}
@@ -0,0 +1,135 @@
+// Package grpctls configures TLS for Katib gRPC servers and clients.
+// Certificates are read from PEM files, e.g. mounted from Kubernetes Secret,
+// and the file paths are set in the environment variables.
+package grpctls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// CertFileEnvName is the env name of the certificate file.
+	// Servers serve TLS with the certificate, clients use it as the client certificate.
+	CertFileEnvName = "KATIB_GRPC_TLS_CERT_FILE"
+	// KeyFileEnvName is the env name of the private key file of the certificate.
+	KeyFileEnvName = "KATIB_GRPC_TLS_KEY_FILE"
+	// CAFileEnvName is the env name of the CA certificate file.
+	// Clients verify the server certificate with it, servers verify the client certificate.
+	CAFileEnvName = "KATIB_GRPC_TLS_CA_FILE"
+	// ClientAuthEnvName is the env name which indicates if servers require and verify the client certificate.
+	ClientAuthEnvName = "KATIB_GRPC_TLS_CLIENT_AUTH"
+
+	// SecretCertKey is the key of the certificate in the TLS Secret.
+	SecretCertKey = "tls.crt"
+	// SecretKeyKey is the key of the private key in the TLS Secret.
+	SecretKeyKey = "tls.key"
+	// SecretCAKey is the key of the CA certificate in the TLS Secret.
+	SecretCAKey = "ca.crt"
+)
+
+// Config is the TLS configuration of the gRPC server or client.
+// TLS is disabled if neither certificate nor CA certificate is set.
+type Config struct {
+	CertFile   string
+	KeyFile    string
+	CAFile     string
+	ClientAuth bool
+}
+
+// ConfigFromEnv returns the TLS configuration from the environment variables.
+func ConfigFromEnv() Config {
+	clientAuth, _ := strconv.ParseBool(os.Getenv(ClientAuthEnvName))
+	return Config{
+		CertFile:   os.Getenv(CertFileEnvName),
+		KeyFile:    os.Getenv(KeyFileEnvName),
+		CAFile:     os.Getenv(CAFileEnvName),
+		ClientAuth: clientAuth,
+	}
+}
+
+// ConfigFromDir returns the TLS configuration for the TLS Secret mounted in the directory.
+func ConfigFromDir(dir string, clientAuth bool) Config {
+	return Config{
+		CertFile:   filepath.Join(dir, SecretCertKey),
+		KeyFile:    filepath.Join(dir, SecretKeyKey),
+		CAFile:     filepath.Join(dir, SecretCAKey),
+		ClientAuth: clientAuth,
+	}
+}
+
+// Enabled returns true if TLS is configured.
+func (c Config) Enabled() bool {
+	return c.CertFile != "" || c.CAFile != ""
+}
+
+// ServerOptions returns the gRPC server options to serve TLS.
+// Server runs in plaintext if the certificate is not set.
+func (c Config) ServerOptions() ([]grpc.ServerOption, error) {
+	if c.CertFile == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load TLS certificate: %v", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+	if c.ClientAuth {
+		if c.CAFile == "" {
+			return nil, fmt.Errorf("CA certificate must be set to verify client certificates")
+		}
+		pool, err := loadCertPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+}
+
+// DialOption returns the gRPC dial option to connect to the server.
+// Insecure connection is used if TLS is not configured.
+func (c Config) DialOption() (grpc.DialOption, error) {
+	if !c.Enabled() {
+		return grpc.WithInsecure(), nil
+	}
+	tlsConfig := &tls.Config{}
+	if c.CAFile != "" {
+		pool, err := loadCertPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load TLS client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	ca, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read CA certificate: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("Failed to parse CA certificate %v", caFile)
+	}
+	return pool, nil
+}
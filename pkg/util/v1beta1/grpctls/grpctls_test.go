@@ -0,0 +1,198 @@
+package grpctls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
+)
+
+type healthService struct{}
+
+func (s *healthService) Check(ctx context.Context, in *health_pb.HealthCheckRequest) (*health_pb.HealthCheckResponse, error) {
+	return &health_pb.HealthCheckResponse{Status: health_pb.HealthCheckResponse_SERVING}, nil
+}
+
+func TestTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grpctls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	caCert, caKey := newCertificate(t, nil, nil, true)
+	writePEM(t, filepath.Join(dir, "ca.crt"), "CERTIFICATE", caCert.Raw)
+	serverCert, serverKey := newCertificate(t, caCert, caKey, false)
+	writeCertificate(t, filepath.Join(dir, "server"), serverCert, serverKey, caCert)
+	clientCert, clientKey := newCertificate(t, caCert, caKey, false)
+	writeCertificate(t, filepath.Join(dir, "client"), clientCert, clientKey, caCert)
+
+	serverConfig := Config{
+		CertFile: filepath.Join(dir, "server", SecretCertKey),
+		KeyFile:  filepath.Join(dir, "server", SecretKeyKey),
+		CAFile:   filepath.Join(dir, "ca.crt"),
+	}
+	mTLSServerConfig := serverConfig
+	mTLSServerConfig.ClientAuth = true
+
+	tcs := []struct {
+		serverConfig    Config
+		clientConfig    Config
+		err             bool
+		testDescription string
+	}{
+		{
+			serverConfig:    Config{},
+			clientConfig:    Config{},
+			err:             false,
+			testDescription: "Plaintext server and client",
+		},
+		{
+			serverConfig:    serverConfig,
+			clientConfig:    Config{CAFile: filepath.Join(dir, "ca.crt")},
+			err:             false,
+			testDescription: "TLS server and client",
+		},
+		{
+			serverConfig:    serverConfig,
+			clientConfig:    Config{},
+			err:             true,
+			testDescription: "TLS server and plaintext client",
+		},
+		{
+			serverConfig:    mTLSServerConfig,
+			clientConfig:    ConfigFromDir(filepath.Join(dir, "client"), false),
+			err:             false,
+			testDescription: "mTLS server and client with certificate",
+		},
+		{
+			serverConfig:    mTLSServerConfig,
+			clientConfig:    Config{CAFile: filepath.Join(dir, "ca.crt")},
+			err:             true,
+			testDescription: "mTLS server and client without certificate",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := checkHealth(t, tc.serverConfig, tc.clientConfig)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	os.Setenv(CertFileEnvName, "/tls/tls.crt")
+	os.Setenv(ClientAuthEnvName, "true")
+	defer os.Unsetenv(CertFileEnvName)
+	defer os.Unsetenv(ClientAuthEnvName)
+
+	c := ConfigFromEnv()
+	if !c.Enabled() || c.CertFile != "/tls/tls.crt" || !c.ClientAuth {
+		t.Errorf("Unexpected config from env: %+v", c)
+	}
+	if _, err := c.ServerOptions(); err == nil {
+		t.Errorf("Expected err for missing certificate file, got nil")
+	}
+}
+
+func checkHealth(t *testing.T, serverConfig, clientConfig Config) error {
+	serverOpts, err := serverConfig.ServerOptions()
+	if err != nil {
+		t.Fatalf("ServerOptions() returns error: %v", err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(serverOpts...)
+	health_pb.RegisterHealthServer(srv, &healthService{})
+	go srv.Serve(l)
+	defer srv.Stop()
+
+	dialOpt, err := clientConfig.DialOption()
+	if err != nil {
+		t.Fatalf("DialOption() returns error: %v", err)
+	}
+	conn, err := grpc.Dial(l.Addr().String(), dialOpt)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = health_pb.NewHealthClient(conn).Check(ctx, &health_pb.HealthCheckRequest{})
+	return err
+}
+
+func newCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, isCA bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "katib"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if isCA {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func writeCertificate(t *testing.T, dir string, cert *x509.Certificate, key *ecdsa.PrivateKey, caCert *x509.Certificate) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, filepath.Join(dir, SecretCertKey), "CERTIFICATE", cert.Raw)
+	writePEM(t, filepath.Join(dir, SecretKeyKey), "EC PRIVATE KEY", keyDER)
+	writePEM(t, filepath.Join(dir, SecretCAKey), "CERTIFICATE", caCert.Raw)
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -8,11 +8,13 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 )
 
 // SuggestionConfig is the JSON suggestion structure in Katib config
@@ -24,6 +26,12 @@ type SuggestionConfig struct {
 	VolumeMountPath           string                           `json:"volumeMountPath"`
 	PersistentVolumeClaimSpec corev1.PersistentVolumeClaimSpec `json:"persistentVolumeClaimSpec"`
 	PersistentVolumeSpec      corev1.PersistentVolumeSpec      `json:"persistentVolumeSpec"`
+	// Endpoint of the shared algorithm service, e.g. katib-suggestion-tpe.kubeflow:6789.
+	// If it is set, Suggestions use this service instead of creating Deployment and Service per Experiment.
+	// ${namespace} in the endpoint is replaced with the Suggestion namespace.
+	Endpoint string `json:"endpoint,omitempty"`
+	// InProcess runs the algorithm inside katib-controller if the algorithm is registered in-process.
+	InProcess bool `json:"inProcess,omitempty"`
 }
 
 // MetricsCollectorConfig is the JSON metrics collector structure in Katib config
@@ -31,6 +39,45 @@ type MetricsCollectorConfig struct {
 	Image           string                      `json:"image"`
 	ImagePullPolicy corev1.PullPolicy           `json:"imagePullPolicy"`
 	Resource        corev1.ResourceRequirements `json:"resources"`
+	// StdOutMode is the way how StdOut metrics collector gets the training container output.
+	// Launcher mode is used by default.
+	StdOutMode mccommon.StdOutMode `json:"stdOutMode,omitempty"`
+}
+
+// LauncherConfig is the JSON training container launcher structure in Katib config
+type LauncherConfig struct {
+	Image           string                      `json:"image"`
+	ImagePullPolicy corev1.PullPolicy           `json:"imagePullPolicy"`
+	Resource        corev1.ResourceRequirements `json:"resources"`
+	// ImageCache enables the image ENTRYPOINT and CMD cache in the pod webhook.
+	// If it is not set, the launcher gets the image config from the registry in every Trial pod.
+	ImageCache *ImageCacheConfig `json:"imageCache,omitempty"`
+}
+
+// ImageCacheConfig is the JSON image ENTRYPOINT and CMD cache structure in Katib config
+type ImageCacheConfig struct {
+	// TTL of the image tag in the cache. Image digests don't expire.
+	TTL metav1.Duration `json:"ttl,omitempty"`
+	// Timeout of the image config lookup in the registry.
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// ConfigMapName is the name of ConfigMap in Katib namespace where the cache is persisted.
+	// Cache is kept only in memory if it is empty.
+	ConfigMapName string `json:"configMapName,omitempty"`
+}
+
+// GRPCTLSConfig is the JSON gRPC TLS structure in Katib config
+type GRPCTLSConfig struct {
+	// SuggestionSecretName is the name of the TLS Secret of the Suggestion services with tls.crt,
+	// tls.key and ca.crt keys. It is mounted only to the Suggestion containers.
+	// The Secret must exist in the namespaces of Suggestions.
+	SuggestionSecretName string `json:"suggestionSecretName"`
+	// ClientSecretName is the name of the TLS Secret with ca.crt key and, if client auth is enabled,
+	// tls.crt and tls.key keys of the client certificate. It is mounted to the metrics collectors,
+	// so it must not contain any server private key.
+	// The Secret must exist in the namespaces of Suggestions and Trials.
+	ClientSecretName string `json:"clientSecretName"`
+	// ClientAuth indicates if Katib gRPC servers require and verify the client certificate.
+	ClientAuth bool `json:"clientAuth"`
 }
 
 // GetSuggestionConfigData gets the config data for the given algorithm name.
@@ -63,6 +110,12 @@ func GetSuggestionConfigData(algorithmName string, client client.Client) (Sugges
 		return SuggestionConfig{}, errors.New("Failed to find suggestion config for algorithm: " + algorithmName + " in ConfigMap: " + consts.KatibConfigMapName)
 	}
 
+	// Shared algorithm service is deployed by the cluster admin or algorithm runs in-process,
+	// other settings are not used
+	if strings.TrimSpace(suggestionConfigData.Endpoint) != "" || suggestionConfigData.InProcess {
+		return suggestionConfigData, nil
+	}
+
 	// Get image from config
 	image := suggestionConfigData.Image
 	if strings.TrimSpace(image) == "" {
@@ -198,9 +251,90 @@ func GetMetricsCollectorConfigData(cKind common.CollectorKind, client client.Cli
 	// Set resource requirements for metrics collector
 	metricsCollectorConfigData.Resource = setResourceRequirements(metricsCollectorConfigData.Resource)
 
+	// Set default StdOut mode
+	switch metricsCollectorConfigData.StdOutMode {
+	case "":
+		metricsCollectorConfigData.StdOutMode = mccommon.StdOutModeLauncher
+	case mccommon.StdOutModeLauncher, mccommon.StdOutModePodLogs, mccommon.StdOutModeKubeletLogs:
+	default:
+		return MetricsCollectorConfig{}, errors.New("Invalid stdOutMode " + string(metricsCollectorConfigData.StdOutMode) + " of metrics collector kind: " + kind)
+	}
+
 	return metricsCollectorConfigData, nil
 }
 
+// GetGRPCTLSConfigData gets the gRPC TLS config data.
+// TLS is disabled if there is no gRPC TLS config in Katib config.
+func GetGRPCTLSConfigData(client client.Client) (GRPCTLSConfig, error) {
+	configMap := &corev1.ConfigMap{}
+	grpcTLSConfigData := GRPCTLSConfig{}
+	err := client.Get(
+		context.TODO(),
+		apitypes.NamespacedName{Name: consts.KatibConfigMapName, Namespace: consts.DefaultKatibNamespace},
+		configMap)
+	if err != nil {
+		return GRPCTLSConfig{}, err
+	}
+
+	config, ok := configMap.Data[consts.LabelGRPCTLS]
+	if !ok {
+		return grpcTLSConfigData, nil
+	}
+	if err := json.Unmarshal([]byte(config), &grpcTLSConfigData); err != nil {
+		return GRPCTLSConfig{}, err
+	}
+	if grpcTLSConfigData.ClientAuth && grpcTLSConfigData.ClientSecretName == "" {
+		return GRPCTLSConfig{}, errors.New("Required value for clientSecretName of gRPC TLS config if client auth is enabled")
+	}
+	return grpcTLSConfigData, nil
+}
+
+// GetLauncherConfigData gets the training container launcher config data.
+// Default launcher image is used if there is no launcher config in Katib config.
+func GetLauncherConfigData(client client.Client) (LauncherConfig, error) {
+	configMap := &corev1.ConfigMap{}
+	launcherConfigData := LauncherConfig{}
+	err := client.Get(
+		context.TODO(),
+		apitypes.NamespacedName{Name: consts.KatibConfigMapName, Namespace: consts.DefaultKatibNamespace},
+		configMap)
+	if err != nil {
+		return LauncherConfig{}, err
+	}
+
+	if config, ok := configMap.Data[consts.LabelLauncher]; ok {
+		if err := json.Unmarshal([]byte(config), &launcherConfigData); err != nil {
+			return LauncherConfig{}, err
+		}
+	}
+
+	// Set default image
+	if strings.TrimSpace(launcherConfigData.Image) == "" {
+		launcherConfigData.Image = consts.DefaultLauncherImage
+	}
+
+	// Get Image Pull Policy
+	imagePullPolicy := launcherConfigData.ImagePullPolicy
+	if imagePullPolicy != corev1.PullAlways && imagePullPolicy != corev1.PullIfNotPresent && imagePullPolicy != corev1.PullNever {
+		launcherConfigData.ImagePullPolicy = consts.DefaultImagePullPolicy
+	}
+
+	// Set resource requirements for launcher
+	launcherConfigData.Resource = setResourceRequirements(launcherConfigData.Resource)
+
+	// Set default image cache TTL and timeout
+	if launcherConfigData.ImageCache != nil {
+		if launcherConfigData.ImageCache.TTL.Duration <= 0 {
+			launcherConfigData.ImageCache.TTL.Duration = consts.DefaultImageCacheTTL
+		}
+		if launcherConfigData.ImageCache.Timeout.Duration <= 0 {
+			launcherConfigData.ImageCache.Timeout.Duration = consts.DefaultImageCacheTimeout
+		}
+	}
+
+	return launcherConfigData, nil
+}
+
 func setResourceRequirements(configResource corev1.ResourceRequirements) corev1.ResourceRequirements {
 
 	// If requests are empty create new map
@@ -0,0 +1,127 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+// Apply creates the converted objects in the cluster, existing objects are updated.
+// Experiments are applied first, so owner references of Trials and Suggestions are set
+// to UIDs of v1beta1 Experiments. Objects which fail are reported and skipped.
+// Client scheme must contain v1beta1 Katib APIs.
+func (m *Migration) Apply(c client.Client) {
+	for _, experiment := range m.Experiments {
+		status := experiment.Status.DeepCopy()
+		err := applyObject(c, experiment, &experimentsv1beta1.Experiment{}, func() {
+			experiment.Status = *status
+		})
+		m.fail(kindExperiment, experiment.Namespace, experiment.Name, err)
+	}
+	for _, trial := range m.Trials {
+		if err := setOwnerReferences(c, &trial.ObjectMeta); err != nil {
+			m.fail(kindTrial, trial.Namespace, trial.Name, err)
+			continue
+		}
+		status := trial.Status.DeepCopy()
+		err := applyObject(c, trial, &trialsv1beta1.Trial{}, func() {
+			trial.Status = *status
+		})
+		m.fail(kindTrial, trial.Namespace, trial.Name, err)
+	}
+	for _, suggestion := range m.Suggestions {
+		if err := setOwnerReferences(c, &suggestion.ObjectMeta); err != nil {
+			m.fail(kindSuggestion, suggestion.Namespace, suggestion.Name, err)
+			continue
+		}
+		status := suggestion.Status.DeepCopy()
+		err := applyObject(c, suggestion, &suggestionsv1beta1.Suggestion{}, func() {
+			suggestion.Status = *status
+		})
+		m.fail(kindSuggestion, suggestion.Namespace, suggestion.Name, err)
+	}
+}
+
+func (m *Migration) fail(kind, namespace, name string, err error) {
+	if err != nil {
+		m.Report.result(kind, namespace, name).Error = err.Error()
+	}
+}
+
+// setOwnerReferences sets UIDs of the owner Experiments which are changed when
+// Experiments are created again.
+func setOwnerReferences(c client.Client, objectMeta *metav1.ObjectMeta) error {
+	for i, ref := range objectMeta.OwnerReferences {
+		if ref.APIVersion != experimentsv1beta1.SchemeGroupVersion.String() || ref.Kind != kindExperiment {
+			continue
+		}
+		owner := &experimentsv1beta1.Experiment{}
+		if err := c.Get(context.TODO(), types.NamespacedName{Name: ref.Name, Namespace: objectMeta.Namespace}, owner); err != nil {
+			if errors.IsNotFound(err) {
+				return fmt.Errorf("Owner Experiment %v is not migrated", ref.Name)
+			}
+			return err
+		}
+		objectMeta.OwnerReferences[i].UID = owner.UID
+	}
+	return nil
+}
+
+// applyObject creates or updates the object and sets its status with the status subresource.
+// restoreStatus sets the status back after it is overwritten by the create or update response.
+func applyObject(c client.Client, obj, existing runtime.Object, restoreStatus func()) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	key := types.NamespacedName{Name: accessor.GetName(), Namespace: accessor.GetNamespace()}
+	err = c.Get(context.TODO(), key, existing)
+	switch {
+	case errors.IsNotFound(err):
+		accessor.SetUID("")
+		err = c.Create(context.TODO(), obj)
+	case err == nil:
+		// Objects are updated in place when the CRDs serve both versions
+		var existingAccessor metav1.Object
+		if existingAccessor, err = meta.Accessor(existing); err != nil {
+			return err
+		}
+		accessor.SetUID(existingAccessor.GetUID())
+		accessor.SetResourceVersion(existingAccessor.GetResourceVersion())
+		err = c.Update(context.TODO(), obj)
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to apply %v: %v", key, err)
+	}
+
+	restoreStatus()
+	if err := c.Status().Update(context.TODO(), obj); err != nil {
+		return fmt.Errorf("Failed to update status of %v: %v", key, err)
+	}
+	return nil
+}
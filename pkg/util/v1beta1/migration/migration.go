@@ -0,0 +1,249 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration converts v1alpha3 Experiments, Trials and Suggestions to v1beta1,
+// creates them in the cluster and copies observation logs of the Trials from
+// v1alpha3 Katib DB Manager to v1beta1 Katib DB Manager.
+// Everything which can't be migrated is collected in the Report.
+package migration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/katib/pkg/apis/controller/conversion"
+	experimentsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1alpha3"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	suggestionsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1alpha3"
+	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	trialsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1alpha3"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+const (
+	kindExperiment = "Experiment"
+	kindTrial      = "Trial"
+	kindSuggestion = "Suggestion"
+
+	bufferSize = 1024
+)
+
+// Objects are the v1alpha3 objects to migrate.
+type Objects struct {
+	Experiments []*experimentsv1alpha3.Experiment
+	Trials      []*trialsv1alpha3.Trial
+	Suggestions []*suggestionsv1alpha3.Suggestion
+}
+
+// Migration contains the converted v1beta1 objects and the migration report.
+type Migration struct {
+	Experiments []*experimentsv1beta1.Experiment
+	Trials      []*trialsv1beta1.Trial
+	Suggestions []*suggestionsv1beta1.Suggestion
+	Report      *Report
+}
+
+// ListObjects lists v1alpha3 objects in the namespace, all namespaces if namespace is empty.
+// Client scheme must contain v1alpha3 Katib APIs.
+func ListObjects(c client.Client, namespace string) (*Objects, error) {
+	experiments := &experimentsv1alpha3.ExperimentList{}
+	if err := c.List(context.TODO(), client.InNamespace(namespace), experiments); err != nil {
+		return nil, fmt.Errorf("Failed to list v1alpha3 Experiments: %v", err)
+	}
+	trials := &trialsv1alpha3.TrialList{}
+	if err := c.List(context.TODO(), client.InNamespace(namespace), trials); err != nil {
+		return nil, fmt.Errorf("Failed to list v1alpha3 Trials: %v", err)
+	}
+	suggestions := &suggestionsv1alpha3.SuggestionList{}
+	if err := c.List(context.TODO(), client.InNamespace(namespace), suggestions); err != nil {
+		return nil, fmt.Errorf("Failed to list v1alpha3 Suggestions: %v", err)
+	}
+
+	objects := &Objects{}
+	for i := range experiments.Items {
+		objects.Experiments = append(objects.Experiments, &experiments.Items[i])
+	}
+	for i := range trials.Items {
+		objects.Trials = append(objects.Trials, &trials.Items[i])
+	}
+	for i := range suggestions.Items {
+		objects.Suggestions = append(objects.Suggestions, &suggestions.Items[i])
+	}
+	return objects, nil
+}
+
+// DecodeObjects decodes v1alpha3 objects from YAML or JSON documents, e.g. the output of
+// kubectl get -o yaml. Lists are expanded. Documents which aren't v1alpha3 Experiments,
+// Trials or Suggestions are reported as failed.
+func DecodeObjects(r io.Reader, report *Report) (*Objects, error) {
+	objects := &Objects{}
+	decoder := k8syaml.NewYAMLOrJSONDecoder(r, bufferSize)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				return objects, nil
+			}
+			return nil, fmt.Errorf("Failed to decode objects: %v", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.IsList() {
+			list, err := obj.ToList()
+			if err != nil {
+				return nil, fmt.Errorf("Failed to decode %v: %v", obj.GetKind(), err)
+			}
+			for i := range list.Items {
+				objects.add(&list.Items[i], report)
+			}
+			continue
+		}
+		objects.add(obj, report)
+	}
+}
+
+func (o *Objects) add(obj *unstructured.Unstructured, report *Report) {
+	result := report.result(obj.GetKind(), obj.GetNamespace(), obj.GetName())
+	if obj.GetAPIVersion() != experimentsv1alpha3.SchemeGroupVersion.String() {
+		result.Error = fmt.Sprintf("API version %v is not supported", obj.GetAPIVersion())
+		return
+	}
+	var err error
+	switch obj.GetKind() {
+	case kindExperiment:
+		experiment := &experimentsv1alpha3.Experiment{}
+		if err = decodeUnstructured(obj, experiment, result); err == nil {
+			o.Experiments = append(o.Experiments, experiment)
+		}
+	case kindTrial:
+		trial := &trialsv1alpha3.Trial{}
+		if err = decodeUnstructured(obj, trial, result); err == nil {
+			o.Trials = append(o.Trials, trial)
+		}
+	case kindSuggestion:
+		suggestion := &suggestionsv1alpha3.Suggestion{}
+		if err = decodeUnstructured(obj, suggestion, result); err == nil {
+			o.Suggestions = append(o.Suggestions, suggestion)
+		}
+	default:
+		err = fmt.Errorf("Kind %v is not supported", obj.GetKind())
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+}
+
+// decodeUnstructured decodes the object into the v1alpha3 type.
+// Fields which are unknown in v1alpha3 are dropped with the warning.
+func decodeUnstructured(obj *unstructured.Unstructured, into interface{}, result *Result) error {
+	content, err := json.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	strictErr := decoder.Decode(into)
+	if strictErr == nil {
+		return nil
+	}
+	if err := json.Unmarshal(content, into); err != nil {
+		return err
+	}
+	result.warn("Unknown fields are dropped: %v", strictErr)
+	return nil
+}
+
+// Convert converts v1alpha3 objects to v1beta1. Objects which can't be converted are
+// reported as failed, objects which are converted with changes are reported with warnings.
+func Convert(objects *Objects, report *Report) *Migration {
+	m := &Migration{Report: report}
+	for _, in := range objects.Experiments {
+		result := report.result(kindExperiment, in.Namespace, in.Name)
+		out, err := conversion.ConvertExperimentToV1beta1(in)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		if in.Spec.TrialTemplate != nil && in.Spec.TrialTemplate.GoTemplate != nil && in.Spec.TrialTemplate.GoTemplate.TemplateSpec != nil {
+			templateSpec := in.Spec.TrialTemplate.GoTemplate.TemplateSpec
+			result.warn("Trial template in ConfigMap %v/%v is not converted, update it to v1beta1 format",
+				templateSpec.ConfigMapNamespace, templateSpec.ConfigMapName)
+		}
+		cleanObjectMeta(&out.ObjectMeta)
+		m.Experiments = append(m.Experiments, out)
+	}
+	for _, in := range objects.Trials {
+		result := report.result(kindTrial, in.Namespace, in.Name)
+		out, err := conversion.ConvertTrialToV1beta1(in)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		cleanObjectMeta(&out.ObjectMeta)
+		m.Trials = append(m.Trials, out)
+	}
+	for _, in := range objects.Suggestions {
+		result := report.result(kindSuggestion, in.Namespace, in.Name)
+		out, err := conversion.ConvertSuggestionToV1beta1(in)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		cleanObjectMeta(&out.ObjectMeta)
+		m.Suggestions = append(m.Suggestions, out)
+	}
+	return m
+}
+
+// cleanObjectMeta prepares metadata of the converted object to be created in v1beta1.
+// Original v1alpha3 data isn't needed after migration and owner references point to
+// v1beta1 Experiments. UID is kept to copy observation logs of the same Trial.
+func cleanObjectMeta(meta *metav1.ObjectMeta) {
+	delete(meta.Annotations, conversion.AnnotationV1alpha3Data)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+	meta.ResourceVersion = ""
+	meta.SelfLink = ""
+	for i := range meta.OwnerReferences {
+		if meta.OwnerReferences[i].APIVersion == experimentsv1alpha3.SchemeGroupVersion.String() {
+			meta.OwnerReferences[i].APIVersion = experimentsv1beta1.SchemeGroupVersion.String()
+		}
+	}
+}
+
+// Objects returns the converted objects in the order they should be created.
+func (m *Migration) Objects() []interface{} {
+	objects := make([]interface{}, 0, len(m.Experiments)+len(m.Trials)+len(m.Suggestions))
+	for _, experiment := range m.Experiments {
+		objects = append(objects, experiment)
+	}
+	for _, trial := range m.Trials {
+		objects = append(objects, trial)
+	}
+	for _, suggestion := range m.Suggestions {
+		objects = append(objects, suggestion)
+	}
+	return objects
+}
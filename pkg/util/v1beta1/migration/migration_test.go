@@ -0,0 +1,289 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubeflow/katib/pkg/apis/controller/conversion"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb_v1alpha3 "github.com/kubeflow/katib/pkg/apis/manager/v1alpha3"
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+const objectsYAML = `apiVersion: kubeflow.org/v1alpha3
+kind: Experiment
+metadata:
+  name: random-example
+  namespace: kubeflow
+  resourceVersion: "100"
+  uid: experiment-uid
+spec:
+  objective:
+    type: maximize
+    objectiveMetricName: Validation-accuracy
+  algorithm:
+    algorithmName: random
+  parameters:
+  - name: --lr
+    parameterType: double
+    feasibleSpace:
+      min: "0.01"
+      max: "0.03"
+  trialTemplate:
+    goTemplate:
+      rawTemplate: |-
+        apiVersion: batch/v1
+        kind: Job
+        metadata:
+          name: {{.Trial}}
+          namespace: {{.NameSpace}}
+        spec:
+          template:
+            spec:
+              containers:
+              - name: training-container
+                image: docker.io/kubeflowkatib/mxnet-mnist
+                command:
+                - "python3"
+                {{- with .HyperParameters}}
+                {{- range .}}
+                - "{{.Name}}={{.Value}}"
+                {{- end}}
+                {{- end}}
+---
+apiVersion: kubeflow.org/v1alpha3
+kind: Experiment
+metadata:
+  name: configmap-example
+  namespace: kubeflow
+spec:
+  objective:
+    type: minimize
+    objectiveMetricName: loss
+  parameters:
+  - name: lr
+    parameterType: double
+  trialTemplate:
+    goTemplate:
+      templateSpec:
+        configMapName: trial-template
+        configMapNamespace: kubeflow
+        templatePath: defaultTrialTemplate.yaml
+---
+apiVersion: kubeflow.org/v1alpha3
+kind: Experiment
+metadata:
+  name: unsupported-template
+  namespace: kubeflow
+spec:
+  trialTemplate:
+    goTemplate:
+      rawTemplate: "{{.Unknown}}"
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: kubeflow.org/v1alpha3
+  kind: Trial
+  metadata:
+    name: random-example-abc
+    namespace: kubeflow
+    uid: trial-uid
+    ownerReferences:
+    - apiVersion: kubeflow.org/v1alpha3
+      kind: Experiment
+      name: random-example
+      uid: experiment-uid
+      controller: true
+  spec:
+    objective:
+      type: maximize
+      objectiveMetricName: Validation-accuracy
+    parameterAssignments:
+    - name: --lr
+      value: "0.02"
+    runSpec: |-
+      apiVersion: batch/v1
+      kind: Job
+      metadata:
+        name: random-example-abc
+  status:
+    observation:
+      metrics:
+      - name: Validation-accuracy
+        value: 0.95
+- apiVersion: kubeflow.org/v1alpha3
+  kind: Suggestion
+  metadata:
+    name: random-example
+    namespace: kubeflow
+  spec:
+    algorithmName: random
+    requests: 3
+    unknownField: true
+---
+apiVersion: kubeflow.org/v1beta1
+kind: Experiment
+metadata:
+  name: v1beta1-example
+  namespace: kubeflow
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: job
+  namespace: kubeflow
+`
+
+func TestMigration(t *testing.T) {
+	report := NewReport()
+	objects, err := DecodeObjects(strings.NewReader(objectsYAML), report)
+	if err != nil {
+		t.Fatalf("DecodeObjects failed: %v", err)
+	}
+	if len(objects.Experiments) != 3 || len(objects.Trials) != 1 || len(objects.Suggestions) != 1 {
+		t.Fatalf("Expected 3 Experiments, 1 Trial and 1 Suggestion, got %+v", objects)
+	}
+	m := Convert(objects, report)
+	if len(m.Experiments) != 2 || len(m.Trials) != 1 || len(m.Suggestions) != 1 {
+		t.Fatalf("Expected 2 Experiments, 1 Trial and 1 Suggestion, got %+v", m)
+	}
+
+	experiment := m.Experiments[0]
+	if experiment.APIVersion != "kubeflow.org/v1beta1" || experiment.ResourceVersion != "" || experiment.UID != "experiment-uid" {
+		t.Errorf("Unexpected Experiment metadata %+v %+v", experiment.TypeMeta, experiment.ObjectMeta)
+	}
+	if _, ok := experiment.Annotations[conversion.AnnotationV1alpha3Data]; ok {
+		t.Errorf("Expected v1alpha3 data annotation to be removed, got %v", experiment.Annotations)
+	}
+	trialParameters := experiment.Spec.TrialTemplate.TrialParameters
+	if len(trialParameters) != 1 || trialParameters[0].Name != "lr" || trialParameters[0].Reference != "--lr" {
+		t.Errorf("Unexpected Trial parameters %+v", trialParameters)
+	}
+
+	trial := m.Trials[0]
+	if ref := trial.OwnerReferences[0]; ref.APIVersion != "kubeflow.org/v1beta1" || ref.UID != "experiment-uid" {
+		t.Errorf("Unexpected Trial owner reference %+v", ref)
+	}
+	if metric := trial.Status.Observation.Metrics[0]; metric.Max != "0.95" {
+		t.Errorf("Expected max value of the objective metric 0.95, got %+v", metric)
+	}
+
+	expectedResults := []struct {
+		kind     string
+		name     string
+		failed   bool
+		warnings int
+	}{
+		{kind: "Experiment", name: "random-example"},
+		{kind: "Experiment", name: "configmap-example", warnings: 1},
+		{kind: "Experiment", name: "unsupported-template", failed: true},
+		{kind: "Trial", name: "random-example-abc"},
+		{kind: "Suggestion", name: "random-example", warnings: 1},
+		{kind: "Experiment", name: "v1beta1-example", failed: true},
+		{kind: "Job", name: "job", failed: true},
+	}
+	if len(report.Results) != len(expectedResults) {
+		t.Fatalf("Expected %v results, got %v", len(expectedResults), len(report.Results))
+	}
+	for i, expected := range expectedResults {
+		result := report.Results[i]
+		if result.Kind != expected.kind || result.Name != expected.name || result.Failed() != expected.failed || len(result.Warnings) != expected.warnings {
+			t.Errorf("Unexpected result %+v, expected %+v", result, expected)
+		}
+	}
+	if !report.Failed() {
+		t.Errorf("Expected failed report")
+	}
+
+	out := &bytes.Buffer{}
+	if err := report.Print(out); err != nil {
+		t.Fatalf("Print failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "Warning: Trial template in ConfigMap kubeflow/trial-template is not converted") {
+		t.Errorf("Expected ConfigMap warning in the report, got\n%v", out.String())
+	}
+}
+
+type fakeV1alpha3DBManager struct {
+	api_pb_v1alpha3.ManagerClient
+	logs map[string]*api_pb_v1alpha3.ObservationLog
+}
+
+func (f *fakeV1alpha3DBManager) GetObservationLog(ctx context.Context, in *api_pb_v1alpha3.GetObservationLogRequest, opts ...grpc.CallOption) (*api_pb_v1alpha3.GetObservationLogReply, error) {
+	return &api_pb_v1alpha3.GetObservationLogReply{ObservationLog: f.logs[in.TrialName]}, nil
+}
+
+type fakeV1beta1DBManager struct {
+	api_pb_v1beta1.DBManagerClient
+	requests []*api_pb_v1beta1.ReportObservationLogRequest
+}
+
+func (f *fakeV1beta1DBManager) GetObservationLog(ctx context.Context, in *api_pb_v1beta1.GetObservationLogRequest, opts ...grpc.CallOption) (*api_pb_v1beta1.GetObservationLogReply, error) {
+	for _, request := range f.requests {
+		if request.TrialName == in.TrialName && request.Namespace == in.Namespace && request.TrialUid == in.TrialUid {
+			return &api_pb_v1beta1.GetObservationLogReply{ObservationLog: request.ObservationLog}, nil
+		}
+	}
+	return &api_pb_v1beta1.GetObservationLogReply{}, nil
+}
+
+func (f *fakeV1beta1DBManager) ReportObservationLog(ctx context.Context, in *api_pb_v1beta1.ReportObservationLogRequest, opts ...grpc.CallOption) (*api_pb_v1beta1.ReportObservationLogReply, error) {
+	f.requests = append(f.requests, in)
+	return &api_pb_v1beta1.ReportObservationLogReply{}, nil
+}
+
+func TestCopyObservationLogs(t *testing.T) {
+	src := &fakeV1alpha3DBManager{
+		logs: map[string]*api_pb_v1alpha3.ObservationLog{
+			"trial-1": {
+				MetricLogs: []*api_pb_v1alpha3.MetricLog{
+					{TimeStamp: "2020-01-01T00:00:00Z", Metric: &api_pb_v1alpha3.Metric{Name: "loss", Value: "0.5"}},
+					{TimeStamp: "2020-01-01T00:01:00Z", Metric: &api_pb_v1alpha3.Metric{Name: "loss", Value: "0.25"}},
+				},
+			},
+		},
+	}
+	dst := &fakeV1beta1DBManager{}
+	newTrial := func(name string) *trialsv1beta1.Trial {
+		return &trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kubeflow", UID: types.UID("uid-" + name)}}
+	}
+	m := &Migration{
+		Trials: []*trialsv1beta1.Trial{newTrial("trial-1"), newTrial("trial-2")},
+		Report: NewReport(),
+	}
+
+	m.CopyObservationLogs(src, dst)
+	if len(dst.requests) != 1 {
+		t.Fatalf("Expected 1 report request, got %v", len(dst.requests))
+	}
+	request := dst.requests[0]
+	if request.TrialName != "trial-1" || request.Namespace != "kubeflow" || request.TrialUid != "uid-trial-1" {
+		t.Errorf("Unexpected report request %+v", request)
+	}
+	if len(request.ObservationLog.MetricLogs) != 2 || request.ObservationLog.MetricLogs[1].Metric.Value != "0.25" {
+		t.Errorf("Unexpected observation log %+v", request.ObservationLog)
+	}
+	if result := m.Report.Results[0]; result.MetricLogs != 2 || result.Failed() {
+		t.Errorf("Unexpected result %+v", result)
+	}
+	if result := m.Report.Results[1]; result.MetricLogs != 0 || result.Failed() {
+		t.Errorf("Unexpected result %+v", result)
+	}
+
+	// Logs are not copied again
+	m.Report = NewReport()
+	m.CopyObservationLogs(src, dst)
+	if len(dst.requests) != 1 {
+		t.Errorf("Expected logs not to be copied again, got %v requests", len(dst.requests))
+	}
+	if result := m.Report.Results[0]; len(result.Warnings) != 1 {
+		t.Errorf("Expected warning for existing logs, got %+v", result)
+	}
+}
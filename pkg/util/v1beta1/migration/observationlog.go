@@ -0,0 +1,93 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb_v1alpha3 "github.com/kubeflow/katib/pkg/apis/manager/v1alpha3"
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// CopyObservationLogs copies observation logs of the migrated Trials from v1alpha3 Katib DB Manager
+// to v1beta1 Katib DB Manager. v1alpha3 logs are stored by the Trial name, v1beta1 logs are
+// reported with the namespace and UID of the v1beta1 Trial, so Apply should be called first
+// when Trials are created again. Trials which already have logs in v1beta1 are skipped.
+func (m *Migration) CopyObservationLogs(src api_pb_v1alpha3.ManagerClient, dst api_pb_v1beta1.DBManagerClient) {
+	for _, trial := range m.Trials {
+		result := m.Report.result(kindTrial, trial.Namespace, trial.Name)
+		if result.Failed() {
+			continue
+		}
+		count, err := copyObservationLog(src, dst, trial, result)
+		if err != nil {
+			result.Error = fmt.Sprintf("Failed to copy observation logs: %v", err)
+			continue
+		}
+		result.MetricLogs = count
+	}
+}
+
+func copyObservationLog(src api_pb_v1alpha3.ManagerClient, dst api_pb_v1beta1.DBManagerClient, trial *trialsv1beta1.Trial, result *Result) (int, error) {
+	srcReply, err := src.GetObservationLog(context.Background(), &api_pb_v1alpha3.GetObservationLogRequest{
+		TrialName: trial.Name,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if srcReply.ObservationLog == nil || len(srcReply.ObservationLog.MetricLogs) == 0 {
+		return 0, nil
+	}
+
+	dstReply, err := dst.GetObservationLog(context.Background(), &api_pb_v1beta1.GetObservationLogRequest{
+		TrialName: trial.Name,
+		Namespace: trial.Namespace,
+		TrialUid:  string(trial.UID),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if dstReply.ObservationLog != nil && len(dstReply.ObservationLog.MetricLogs) > 0 {
+		result.warn("Observation logs already exist in v1beta1 Katib DB, they are not copied")
+		return 0, nil
+	}
+
+	observationLog := &api_pb_v1beta1.ObservationLog{}
+	for _, metricLog := range srcReply.ObservationLog.MetricLogs {
+		if metricLog == nil || metricLog.Metric == nil {
+			continue
+		}
+		observationLog.MetricLogs = append(observationLog.MetricLogs, &api_pb_v1beta1.MetricLog{
+			TimeStamp: metricLog.TimeStamp,
+			Metric: &api_pb_v1beta1.Metric{
+				Name:  metricLog.Metric.Name,
+				Value: metricLog.Metric.Value,
+			},
+		})
+	}
+	_, err = dst.ReportObservationLog(context.Background(), &api_pb_v1beta1.ReportObservationLogRequest{
+		TrialName:      trial.Name,
+		Namespace:      trial.Namespace,
+		TrialUid:       string(trial.UID),
+		ObservationLog: observationLog,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(observationLog.MetricLogs), nil
+}
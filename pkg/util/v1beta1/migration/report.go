@@ -0,0 +1,95 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// Result is the migration result of the object.
+type Result struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Error is set if the object isn't migrated.
+	Error string `json:"error,omitempty"`
+	// Warnings describe what isn't migrated exactly.
+	Warnings []string `json:"warnings,omitempty"`
+	// MetricLogs is the number of observation log entries copied for the Trial.
+	MetricLogs int `json:"metricLogs,omitempty"`
+}
+
+// Failed returns true if the object isn't migrated.
+func (r *Result) Failed() bool {
+	return r.Error != ""
+}
+
+func (r *Result) warn(format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// Report contains migration results of all objects in the order they are processed.
+type Report struct {
+	Results []*Result `json:"results"`
+}
+
+// NewReport creates an empty Report.
+func NewReport() *Report {
+	return &Report{}
+}
+
+// result returns the result of the object, new result is added if it doesn't exist.
+func (r *Report) result(kind, namespace, name string) *Result {
+	for _, result := range r.Results {
+		if result.Kind == kind && result.Namespace == namespace && result.Name == name {
+			return result
+		}
+	}
+	result := &Result{Kind: kind, Namespace: namespace, Name: name}
+	r.Results = append(r.Results, result)
+	return result
+}
+
+// Failed returns true if any object isn't migrated.
+func (r *Report) Failed() bool {
+	for _, result := range r.Results {
+		if result.Failed() {
+			return true
+		}
+	}
+	return false
+}
+
+// Print writes the report as a table, warnings are printed in separate rows.
+func (r *Report) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tNAMESPACE\tNAME\tRESULT")
+	for _, result := range r.Results {
+		status := "Migrated"
+		if result.Failed() {
+			status = "Failed: " + result.Error
+		} else if result.MetricLogs > 0 {
+			status = fmt.Sprintf("Migrated, %v metric logs copied", result.MetricLogs)
+		}
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\n", result.Kind, result.Namespace, result.Name, status)
+		for _, warning := range result.Warnings {
+			fmt.Fprintf(tw, "\t\t\tWarning: %v\n", warning)
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,199 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package promotion renders the Trial template of the Experiment with the
+// parameter assignments of the best Trial and creates it as a standalone job.
+// The job isn't owned by the Experiment, so it survives Experiment deletion.
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonapiv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/manifest"
+	jobv1beta1 "github.com/kubeflow/katib/pkg/job/v1beta1"
+)
+
+// JobNameSuffix is appended to the Experiment name to get the default job name.
+const JobNameSuffix = "-best"
+
+// Promoter is the interface to create jobs from the best Trial.
+type Promoter interface {
+	RenderBestTrialJob(experiment *experimentsv1beta1.Experiment, spec *experimentsv1beta1.PromotionSpec) (*unstructured.Unstructured, error)
+	PromoteBestTrial(experiment *experimentsv1beta1.Experiment, spec *experimentsv1beta1.PromotionSpec) (*unstructured.Unstructured, error)
+}
+
+// DefaultPromoter renders jobs using manifest.Generator and creates them with the Kubernetes client.
+type DefaultPromoter struct {
+	client client.Client
+	manifest.Generator
+}
+
+// New creates a new Promoter.
+func New(c client.Client, generator manifest.Generator) Promoter {
+	return &DefaultPromoter{
+		client:    c,
+		Generator: generator,
+	}
+}
+
+// RenderBestTrialJob renders the Trial template with the best Trial assignments overridden
+// by spec.ParameterOverrides and applies spec.RunSpecPatch to the result.
+// The job is created in the Experiment namespace, spec can be nil.
+func (p *DefaultPromoter) RenderBestTrialJob(experiment *experimentsv1beta1.Experiment, spec *experimentsv1beta1.PromotionSpec) (*unstructured.Unstructured, error) {
+	bestTrial := experiment.Status.CurrentOptimalTrial
+	if bestTrial.BestTrialName == "" {
+		return nil, fmt.Errorf("Experiment %v doesn't have the best trial", experiment.Name)
+	}
+	if spec == nil {
+		spec = &experimentsv1beta1.PromotionSpec{}
+	}
+	name := JobName(experiment, spec)
+
+	assignments := OverrideAssignments(bestTrial.ParameterAssignments, spec.ParameterOverrides)
+	runSpec, err := p.GetRunSpecWithHyperParameters(experiment, name, experiment.Namespace, assignments)
+	if err != nil {
+		return nil, err
+	}
+	if spec.RunSpecPatch != "" {
+		if runSpec, err = PatchRunSpec(runSpec, spec.RunSpecPatch); err != nil {
+			return nil, err
+		}
+	}
+
+	labels := runSpec.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[consts.LabelPromotedExperimentName] = experiment.Name
+	labels[consts.LabelPromotedTrialName] = bestTrial.BestTrialName
+	runSpec.SetLabels(labels)
+	return runSpec, nil
+}
+
+// PromoteBestTrial renders the job from the best Trial and creates it.
+func (p *DefaultPromoter) PromoteBestTrial(experiment *experimentsv1beta1.Experiment, spec *experimentsv1beta1.PromotionSpec) (*unstructured.Unstructured, error) {
+	runSpec, err := p.RenderBestTrialJob(experiment, spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.client.Create(context.TODO(), runSpec); err != nil {
+		return nil, err
+	}
+	return runSpec, nil
+}
+
+// ParseRunSpecPatch parses the JSON merge patch of the job.
+// Patch can't change apiVersion, kind and metadata, so the job is created
+// with the kind of the Trial template and can't be moved to another name or namespace.
+func ParseRunSpecPatch(runSpecPatch string) (map[string]interface{}, error) {
+	patch := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(runSpecPatch), &patch); err != nil {
+		return nil, fmt.Errorf("Invalid run spec patch: %v", err)
+	}
+	for _, field := range []string{"apiVersion", "kind", "metadata"} {
+		if _, ok := patch[field]; ok {
+			return nil, fmt.Errorf("Run spec patch can't change %v", field)
+		}
+	}
+	return patch, nil
+}
+
+// PatchRunSpec applies the run spec patch to the copy of the job and validates the result
+// if the job is of the supported kind.
+func PatchRunSpec(runSpec *unstructured.Unstructured, runSpecPatch string) (*unstructured.Unstructured, error) {
+	patch, err := ParseRunSpecPatch(runSpecPatch)
+	if err != nil {
+		return nil, err
+	}
+	patched := runSpec.DeepCopy()
+	patched.Object = MergePatch(patched.Object, patch)
+	if err := jobv1beta1.ValidateSupportedJob(patched); err != nil {
+		return nil, fmt.Errorf("Invalid patched job: %v", err)
+	}
+	return patched, nil
+}
+
+// JobName returns the name of the job created from the best Trial.
+func JobName(experiment *experimentsv1beta1.Experiment, spec *experimentsv1beta1.PromotionSpec) string {
+	if spec != nil && spec.Name != "" {
+		return spec.Name
+	}
+	return experiment.Name + JobNameSuffix
+}
+
+// ObjectReference returns the reference to the created job.
+func ObjectReference(job *unstructured.Unstructured) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion: job.GetAPIVersion(),
+		Kind:       job.GetKind(),
+		Name:       job.GetName(),
+		Namespace:  job.GetNamespace(),
+		UID:        job.GetUID(),
+	}
+}
+
+// OverrideAssignments replaces values of the assignments with the overrides of the same name.
+// Overrides of the unknown parameters are appended.
+func OverrideAssignments(assignments, overrides []commonapiv1beta1.ParameterAssignment) []commonapiv1beta1.ParameterAssignment {
+	result := make([]commonapiv1beta1.ParameterAssignment, 0, len(assignments)+len(overrides))
+	overridden := make(map[string]bool)
+	for _, a := range assignments {
+		for _, o := range overrides {
+			if o.Name == a.Name {
+				a.Value = o.Value
+				overridden[o.Name] = true
+			}
+		}
+		result = append(result, a)
+	}
+	for _, o := range overrides {
+		if !overridden[o.Name] {
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// MergePatch applies the JSON merge patch (RFC 7386) to the object.
+// Null values in the patch delete the fields, nested objects are merged recursively
+// and all other values, including lists, replace the fields.
+func MergePatch(object, patch map[string]interface{}) map[string]interface{} {
+	if object == nil {
+		object = map[string]interface{}{}
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(object, key)
+			continue
+		}
+		patchObject, ok := value.(map[string]interface{})
+		if !ok {
+			object[key] = value
+			continue
+		}
+		originalObject, _ := object[key].(map[string]interface{})
+		object[key] = MergePatch(originalObject, patchObject)
+	}
+	return object
+}
@@ -0,0 +1,173 @@
+package promotion
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	commonapiv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+func TestOverrideAssignments(t *testing.T) {
+	assignments := []commonapiv1beta1.ParameterAssignment{
+		{Name: "lr", Value: "0.01"},
+		{Name: "momentum", Value: "0.9"},
+	}
+
+	tcs := []struct {
+		overrides       []commonapiv1beta1.ParameterAssignment
+		expected        []commonapiv1beta1.ParameterAssignment
+		testDescription string
+	}{
+		{
+			overrides:       nil,
+			expected:        assignments,
+			testDescription: "No overrides",
+		},
+		{
+			overrides: []commonapiv1beta1.ParameterAssignment{{Name: "lr", Value: "0.02"}},
+			expected: []commonapiv1beta1.ParameterAssignment{
+				{Name: "lr", Value: "0.02"},
+				{Name: "momentum", Value: "0.9"},
+			},
+			testDescription: "Override assignment of the best trial",
+		},
+		{
+			overrides: []commonapiv1beta1.ParameterAssignment{{Name: "epochs", Value: "100"}},
+			expected: []commonapiv1beta1.ParameterAssignment{
+				{Name: "lr", Value: "0.01"},
+				{Name: "momentum", Value: "0.9"},
+				{Name: "epochs", Value: "100"},
+			},
+			testDescription: "Append unknown parameter",
+		},
+	}
+
+	for _, tc := range tcs {
+		actual := OverrideAssignments(assignments, tc.overrides)
+		if !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("Case: %v failed. Expected %v, got %v", tc.testDescription, tc.expected, actual)
+		}
+	}
+	if assignments[0].Value != "0.01" {
+		t.Errorf("OverrideAssignments() must not modify the best trial assignments, got %v", assignments)
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	tcs := []struct {
+		object          map[string]interface{}
+		patch           map[string]interface{}
+		expected        map[string]interface{}
+		testDescription string
+	}{
+		{
+			object: map[string]interface{}{
+				"spec": map[string]interface{}{"backoffLimit": 1.0, "parallelism": 1.0},
+			},
+			patch: map[string]interface{}{
+				"spec": map[string]interface{}{"backoffLimit": 3.0},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"backoffLimit": 3.0, "parallelism": 1.0},
+			},
+			testDescription: "Nested objects are merged",
+		},
+		{
+			object: map[string]interface{}{
+				"spec": map[string]interface{}{"backoffLimit": 1.0, "parallelism": 1.0},
+			},
+			patch: map[string]interface{}{
+				"spec": map[string]interface{}{"parallelism": nil},
+			},
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"backoffLimit": 1.0},
+			},
+			testDescription: "Null value deletes field",
+		},
+		{
+			object: map[string]interface{}{
+				"args": []interface{}{"--lr=0.01"},
+			},
+			patch: map[string]interface{}{
+				"args":   []interface{}{"--lr=0.02", "--epochs=100"},
+				"labels": map[string]interface{}{"stage": "production"},
+			},
+			expected: map[string]interface{}{
+				"args":   []interface{}{"--lr=0.02", "--epochs=100"},
+				"labels": map[string]interface{}{"stage": "production"},
+			},
+			testDescription: "Lists are replaced and missing objects are created",
+		},
+	}
+
+	for _, tc := range tcs {
+		actual := MergePatch(tc.object, tc.patch)
+		if !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("Case: %v failed. Expected %v, got %v", tc.testDescription, tc.expected, actual)
+		}
+	}
+}
+
+func TestPatchRunSpec(t *testing.T) {
+	runSpec := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   map[string]interface{}{"name": "best-job", "namespace": "kubeflow"},
+		"spec":       map[string]interface{}{"backoffLimit": int64(1)},
+	}}
+
+	tcs := []struct {
+		patch           string
+		err             bool
+		testDescription string
+	}{
+		{
+			patch:           `{"spec": {"backoffLimit": 3}}`,
+			err:             false,
+			testDescription: "Valid patch of the job spec",
+		},
+		{
+			patch:           `{"apiVersion": "v1", "kind": "Pod"}`,
+			err:             true,
+			testDescription: "Patch changes the job kind",
+		},
+		{
+			patch:           `{"metadata": {"namespace": "kube-system"}}`,
+			err:             true,
+			testDescription: "Patch changes the job metadata",
+		},
+		{
+			patch:           `{"spec": {"invalidField": true}}`,
+			err:             true,
+			testDescription: "Patched job can't be converted to the supported kind",
+		},
+	}
+
+	for _, tc := range tcs {
+		patched, err := PatchRunSpec(runSpec, tc.patch)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil: %v", tc.testDescription, patched.Object)
+		}
+	}
+	if backoffLimit, _, _ := unstructured.NestedInt64(runSpec.Object, "spec", "backoffLimit"); backoffLimit != 1 {
+		t.Errorf("Expected original job not to be changed, got backoffLimit %v", backoffLimit)
+	}
+}
+
+func TestJobName(t *testing.T) {
+	experiment := &experimentsv1beta1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "random-experiment"},
+	}
+	if name := JobName(experiment, nil); name != "random-experiment-best" {
+		t.Errorf("Expected default job name random-experiment-best, got %v", name)
+	}
+	if name := JobName(experiment, &experimentsv1beta1.PromotionSpec{Name: "best-job"}); name != "best-job" {
+		t.Errorf("Expected job name best-job, got %v", name)
+	}
+}
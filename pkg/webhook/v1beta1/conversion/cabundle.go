@@ -0,0 +1,116 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// caCertName is the name of the CA certificate which is written by the webhook server cert provisioner.
+	caCertName = "ca-cert.pem"
+
+	caBundlePollInterval = 5 * time.Second
+)
+
+// CRDNames are the names of the CRDs which are served in v1alpha3 and v1beta1 versions.
+var CRDNames = []string{
+	"experiments.kubeflow.org",
+	"trials.kubeflow.org",
+	"suggestions.kubeflow.org",
+}
+
+var crdGVK = schema.GroupVersionKind{
+	Group:   "apiextensions.k8s.io",
+	Version: "v1beta1",
+	Kind:    "CustomResourceDefinition",
+}
+
+// caBundleInjector sets the CA certificate of the webhook server to the conversion webhook
+// client config of the CRDs, so the API server can verify the webhook server.
+type caBundleInjector struct {
+	client  client.Client
+	certDir string
+}
+
+// NewCABundleInjector returns the runnable which injects the CA bundle from the cert dir
+// into the CRDs with the Webhook conversion strategy.
+func NewCABundleInjector(c client.Client, certDir string) manager.Runnable {
+	return &caBundleInjector{
+		client:  c,
+		certDir: certDir,
+	}
+}
+
+func (i *caBundleInjector) Start(stop <-chan struct{}) error {
+	// Certificate is provisioned by the webhook server, wait until it is written
+	err := wait.PollImmediateUntil(caBundlePollInterval, func() (bool, error) {
+		caBundle, err := ioutil.ReadFile(filepath.Join(i.certDir, caCertName))
+		if err != nil {
+			if !os.IsNotExist(err) {
+				log.Error(err, "Unable to read CA certificate")
+			}
+			return false, nil
+		}
+		for _, name := range CRDNames {
+			if err := i.inject(name, caBundle); err != nil {
+				log.Error(err, "Unable to inject CA bundle", "CRD", name)
+				return false, nil
+			}
+		}
+		return true, nil
+	}, stop)
+	if err == wait.ErrWaitTimeout {
+		return nil
+	}
+	return err
+}
+
+func (i *caBundleInjector) inject(name string, caBundle []byte) error {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGVK)
+	if err := i.client.Get(context.TODO(), types.NamespacedName{Name: name}, crd); err != nil {
+		return err
+	}
+
+	// CRD is served only in one version
+	strategy, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "strategy")
+	if strategy != "Webhook" {
+		return nil
+	}
+	encoded := base64.StdEncoding.EncodeToString(caBundle)
+	current, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "webhookClientConfig", "caBundle")
+	if current == encoded {
+		return nil
+	}
+	if err := unstructured.SetNestedField(crd.Object, encoded, "spec", "conversion", "webhookClientConfig", "caBundle"); err != nil {
+		return err
+	}
+	log.Info("Injecting CA bundle into conversion webhook", "CRD", name)
+	return i.client.Update(context.TODO(), crd)
+}
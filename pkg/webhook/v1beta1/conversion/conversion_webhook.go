@@ -0,0 +1,203 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+
+	"github.com/kubeflow/katib/pkg/apis/controller/conversion"
+	experimentsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1alpha3"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	suggestionsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1alpha3"
+	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	trialsv1alpha3 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1alpha3"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+// WebhookPath is the path of the CRD conversion webhook in the admission server.
+const WebhookPath = "/convert"
+
+var log = logf.Log.WithName("conversion-webhook")
+
+// ConversionReview is the request and the response of the CRD conversion webhook.
+// It is compatible with ConversionReview of apiextensions.k8s.io/v1beta1 and v1 API.
+type ConversionReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *ConversionRequest  `json:"request,omitempty"`
+	Response        *ConversionResponse `json:"response,omitempty"`
+}
+
+// ConversionRequest describes the conversion request parameters.
+type ConversionRequest struct {
+	// UID is an identifier for the individual request/response.
+	UID types.UID `json:"uid"`
+	// DesiredAPIVersion is the version to convert given objects to. e.g. "kubeflow.org/v1beta1"
+	DesiredAPIVersion string `json:"desiredAPIVersion"`
+	// Objects is the list of custom resource objects to be converted.
+	Objects []runtime.RawExtension `json:"objects"`
+}
+
+// ConversionResponse describes a conversion response.
+type ConversionResponse struct {
+	// UID is an identifier for the individual request/response. It is copied from the request.
+	UID types.UID `json:"uid"`
+	// ConvertedObjects is the list of converted objects in the same order as the request objects.
+	ConvertedObjects []runtime.RawExtension `json:"convertedObjects"`
+	// Result contains the result of conversion with extra details if the conversion failed.
+	Result metav1.Status `json:"result"`
+}
+
+type conversionHandler struct{}
+
+// NewConversionHandler returns the handler of the CRD conversion webhook for Experiments, Trials
+// and Suggestions between v1alpha3 and v1beta1 versions.
+func NewConversionHandler() http.Handler {
+	return &conversionHandler{}
+}
+
+func (h *conversionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	review := &ConversionReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+		log.Error(err, "Unable to decode ConversionReview")
+		http.Error(w, fmt.Sprintf("Unable to decode ConversionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "ConversionReview request must be specified", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = convertReview(review.Request)
+	review.Request = nil
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		log.Error(err, "Unable to encode ConversionReview")
+	}
+}
+
+func convertReview(request *ConversionRequest) *ConversionResponse {
+	response := &ConversionResponse{
+		UID:              request.UID,
+		ConvertedObjects: make([]runtime.RawExtension, 0, len(request.Objects)),
+	}
+	for _, obj := range request.Objects {
+		converted, err := ConvertObject(obj.Raw, request.DesiredAPIVersion)
+		if err != nil {
+			log.Error(err, "Conversion failed", "desiredAPIVersion", request.DesiredAPIVersion)
+			response.ConvertedObjects = nil
+			response.Result = metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: err.Error(),
+			}
+			return response
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+	response.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return response
+}
+
+// ConvertObject converts the serialized Experiment, Trial or Suggestion to the desired API version.
+func ConvertObject(raw []byte, desiredAPIVersion string) ([]byte, error) {
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(raw, typeMeta); err != nil {
+		return nil, fmt.Errorf("Unable to decode object: %v", err)
+	}
+	if typeMeta.APIVersion == desiredAPIVersion {
+		return raw, nil
+	}
+
+	var converted interface{}
+	var err error
+	switch {
+	case typeMeta.APIVersion == experimentsv1alpha3.SchemeGroupVersion.String() && desiredAPIVersion == experimentsv1beta1.SchemeGroupVersion.String():
+		converted, err = convertObject(raw, typeMeta.Kind, toV1beta1Converters)
+	case typeMeta.APIVersion == experimentsv1beta1.SchemeGroupVersion.String() && desiredAPIVersion == experimentsv1alpha3.SchemeGroupVersion.String():
+		converted, err = convertObject(raw, typeMeta.Kind, toV1alpha3Converters)
+	default:
+		err = fmt.Errorf("Conversion from %v to %v is not supported", typeMeta.APIVersion, desiredAPIVersion)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(converted)
+}
+
+// converter decodes the object of the source version and converts it to the target version.
+type converter func(raw []byte) (interface{}, error)
+
+var toV1beta1Converters = map[string]converter{
+	"Experiment": func(raw []byte) (interface{}, error) {
+		in := &experimentsv1alpha3.Experiment{}
+		if err := json.Unmarshal(raw, in); err != nil {
+			return nil, err
+		}
+		return conversion.ConvertExperimentToV1beta1(in)
+	},
+	"Trial": func(raw []byte) (interface{}, error) {
+		in := &trialsv1alpha3.Trial{}
+		if err := json.Unmarshal(raw, in); err != nil {
+			return nil, err
+		}
+		return conversion.ConvertTrialToV1beta1(in)
+	},
+	"Suggestion": func(raw []byte) (interface{}, error) {
+		in := &suggestionsv1alpha3.Suggestion{}
+		if err := json.Unmarshal(raw, in); err != nil {
+			return nil, err
+		}
+		return conversion.ConvertSuggestionToV1beta1(in)
+	},
+}
+
+var toV1alpha3Converters = map[string]converter{
+	"Experiment": func(raw []byte) (interface{}, error) {
+		in := &experimentsv1beta1.Experiment{}
+		if err := json.Unmarshal(raw, in); err != nil {
+			return nil, err
+		}
+		return conversion.ConvertExperimentToV1alpha3(in)
+	},
+	"Trial": func(raw []byte) (interface{}, error) {
+		in := &trialsv1beta1.Trial{}
+		if err := json.Unmarshal(raw, in); err != nil {
+			return nil, err
+		}
+		return conversion.ConvertTrialToV1alpha3(in)
+	},
+	"Suggestion": func(raw []byte) (interface{}, error) {
+		in := &suggestionsv1beta1.Suggestion{}
+		if err := json.Unmarshal(raw, in); err != nil {
+			return nil, err
+		}
+		return conversion.ConvertSuggestionToV1alpha3(in)
+	},
+}
+
+func convertObject(raw []byte, kind string, converters map[string]converter) (interface{}, error) {
+	convert, ok := converters[kind]
+	if !ok {
+		return nil, fmt.Errorf("Conversion of %v is not supported", kind)
+	}
+	return convert(raw)
+}
@@ -0,0 +1,156 @@
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const v1alpha3Experiment = `{
+  "apiVersion": "kubeflow.org/v1alpha3",
+  "kind": "Experiment",
+  "metadata": {"name": "random-example", "namespace": "kubeflow"},
+  "spec": {
+    "objective": {"type": "maximize", "objectiveMetricName": "Validation-accuracy"},
+    "algorithm": {"algorithmName": "random"},
+    "parameters": [{"name": "--lr", "parameterType": "double", "feasibleSpace": {"min": "0.01", "max": "0.03"}}],
+    "trialTemplate": {
+      "goTemplate": {
+        "rawTemplate": "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: {{.Trial}}\nspec:\n  template:\n    spec:\n      containers:\n      - name: training\n        command:\n        {{- range .HyperParameters}}\n        - \"{{.Name}}={{.Value}}\"\n        {{- end}}\n"
+      }
+    }
+  }
+}`
+
+const v1beta1Trial = `{
+  "apiVersion": "kubeflow.org/v1beta1",
+  "kind": "Trial",
+  "metadata": {"name": "random-example-abc", "namespace": "kubeflow"},
+  "spec": {
+    "objective": {"type": "maximize", "objectiveMetricName": "Validation-accuracy"},
+    "parameterAssignments": [{"name": "--lr", "value": "0.02"}],
+    "runSpec": {"apiVersion": "batch/v1", "kind": "Job", "metadata": {"name": "random-example-abc"}}
+  },
+  "status": {"observation": {"metrics": [{"name": "Validation-accuracy", "min": "0.5", "max": "0.9", "latest": "0.8"}]}}
+}`
+
+func doConversionReview(t *testing.T, desiredAPIVersion string, objects ...string) *ConversionResponse {
+	request := &ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+		Request: &ConversionRequest{
+			UID:               "test-uid",
+			DesiredAPIVersion: desiredAPIVersion,
+		},
+	}
+	for _, obj := range objects {
+		request.Request.Objects = append(request.Request.Objects, runtime.RawExtension{Raw: []byte(obj)})
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	NewConversionHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, WebhookPath, bytes.NewReader(body)))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %v: %v", recorder.Code, recorder.Body.String())
+	}
+	review := &ConversionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), review); err != nil {
+		t.Fatal(err)
+	}
+	if review.APIVersion != "apiextensions.k8s.io/v1" || review.Kind != "ConversionReview" || review.Request != nil {
+		t.Errorf("Unexpected ConversionReview %+v", review)
+	}
+	if review.Response == nil || review.Response.UID != "test-uid" {
+		t.Fatalf("Expected response with the request UID, got %+v", review.Response)
+	}
+	return review.Response
+}
+
+func TestConversionHandler(t *testing.T) {
+	response := doConversionReview(t, "kubeflow.org/v1beta1", v1alpha3Experiment, v1beta1Trial)
+	if response.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("Expected success, got %+v", response.Result)
+	}
+	if len(response.ConvertedObjects) != 2 {
+		t.Fatalf("Expected 2 converted objects, got %v", len(response.ConvertedObjects))
+	}
+	experiment := map[string]interface{}{}
+	if err := json.Unmarshal(response.ConvertedObjects[0].Raw, &experiment); err != nil {
+		t.Fatal(err)
+	}
+	if experiment["apiVersion"] != "kubeflow.org/v1beta1" || experiment["kind"] != "Experiment" {
+		t.Errorf("Expected v1beta1 Experiment, got %v", experiment)
+	}
+	trial := &bytes.Buffer{}
+	if err := json.Compact(trial, []byte(v1beta1Trial)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(response.ConvertedObjects[1].Raw, trial.Bytes()) {
+		t.Errorf("Object in the desired version must not be changed, got %s", response.ConvertedObjects[1].Raw)
+	}
+
+	// Convert the Experiment back to v1alpha3
+	response = doConversionReview(t, "kubeflow.org/v1alpha3", string(response.ConvertedObjects[0].Raw))
+	if response.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("Expected success, got %+v", response.Result)
+	}
+	var expected, actual map[string]interface{}
+	json.Unmarshal([]byte(v1alpha3Experiment), &expected)
+	json.Unmarshal(response.ConvertedObjects[0].Raw, &actual)
+	if !jsonEqual(actual["spec"], expected["spec"]) {
+		t.Errorf("Round-trip conversion changed the Experiment spec\nexpected: %v\ngot:      %v", expected["spec"], actual["spec"])
+	}
+	if annotations, ok := actual["metadata"].(map[string]interface{})["annotations"]; ok {
+		t.Errorf("Expected no annotations after the round-trip conversion, got %v", annotations)
+	}
+}
+
+func TestConversionHandlerFailure(t *testing.T) {
+	for _, tc := range []struct {
+		desiredAPIVersion string
+		object            string
+		testDescription   string
+	}{
+		{
+			desiredAPIVersion: "kubeflow.org/v1beta1",
+			object:            `{"apiVersion": "kubeflow.org/v1alpha3", "kind": "Experiment", "spec": {"trialTemplate": {"goTemplate": {"rawTemplate": "{{.Unknown}}"}}}}`,
+			testDescription:   "Unsupported Trial template",
+		},
+		{
+			desiredAPIVersion: "kubeflow.org/v1beta1",
+			object:            `{"apiVersion": "kubeflow.org/v1alpha3", "kind": "PyTorchJob"}`,
+			testDescription:   "Unsupported kind",
+		},
+		{
+			desiredAPIVersion: "kubeflow.org/v1",
+			object:            v1beta1Trial,
+			testDescription:   "Unsupported version",
+		},
+	} {
+		response := doConversionReview(t, tc.desiredAPIVersion, tc.object)
+		if response.Result.Status != metav1.StatusFailure || response.Result.Message == "" || response.ConvertedObjects != nil {
+			t.Errorf("Case: %v failed. Expected failure, got %+v", tc.testDescription, response)
+		}
+	}
+}
+
+func TestConversionHandlerBadRequest(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	NewConversionHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, WebhookPath, bytes.NewReader([]byte(`{}`))))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for review without request, got %v", recorder.Code)
+	}
+}
+
+func jsonEqual(a, b interface{}) bool {
+	aBytes, _ := json.Marshal(a)
+	bBytes, _ := json.Marshal(b)
+	return bytes.Equal(aBytes, bBytes)
+}
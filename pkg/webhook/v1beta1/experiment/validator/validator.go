@@ -1,20 +1,16 @@
 package validator
 
 import (
-	"encoding/json"
 	"fmt"
+	"net/url"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
 
-	pytorchv1 "github.com/kubeflow/pytorch-operator/pkg/apis/pytorch/v1"
-	tfv1 "github.com/kubeflow/tf-operator/pkg/apis/tensorflow/v1"
-	jsonPatch "github.com/mattbaird/jsonpatch"
-	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 
@@ -22,10 +18,14 @@ import (
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/manifest"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/notification"
 	experimentutil "github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
 	util "github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	jobv1beta1 "github.com/kubeflow/katib/pkg/job/v1beta1"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	suggestiongoptuna "github.com/kubeflow/katib/pkg/suggestion/v1beta1/goptuna"
+	"github.com/kubeflow/katib/pkg/suggestion/v1beta1/inprocess"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/promotion"
 )
 
 var log = logf.Log.WithName("experiment-validating-webhook")
@@ -61,6 +61,9 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 	if instance.Spec.ParallelTrialCount != nil && *instance.Spec.ParallelTrialCount <= 0 {
 		return fmt.Errorf("spec.parallelTrialCount must be greater than 0")
 	}
+	if err := g.validateRepetitions(instance); err != nil {
+		return err
+	}
 	if oldInst != nil {
 		// We should validate restart only if appropriate fields are changed.
 		// Otherwise check below is triggered when experiment is deleted.
@@ -98,6 +101,15 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 	if err := g.validateResumePolicy(instance.Spec.ResumePolicy); err != nil {
 		return err
 	}
+	if err := g.validateSharedAlgorithmService(instance); err != nil {
+		return err
+	}
+	if err := g.validateNotifications(instance); err != nil {
+		return err
+	}
+	if err := g.validatePromoteBestTrial(instance.Spec.PromoteBestTrial); err != nil {
+		return err
+	}
 
 	if err := g.validateTrialTemplate(instance); err != nil {
 		return err
@@ -112,7 +124,7 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 	}
 
 	if len(instance.Spec.Parameters) > 0 {
-		if err := g.validateParameters(instance.Spec.Parameters); err != nil {
+		if err := g.validateParameters(instance.Spec.Algorithm.AlgorithmName, instance.Spec.Parameters); err != nil {
 			return err
 		}
 	}
@@ -133,6 +145,32 @@ func (g *DefaultValidator) validateObjective(obj *commonapiv1beta1.ObjectiveSpec
 	if obj.ObjectiveMetricName == "" {
 		return fmt.Errorf("No spec.objective.objectiveMetricName specified.")
 	}
+	return g.validateMetricStrategies(obj.MetricStrategies)
+}
+
+func (g *DefaultValidator) validateMetricStrategies(strategies []commonapiv1beta1.MetricStrategy) error {
+	for i, strategy := range strategies {
+		switch strategy.Value {
+		case commonapiv1beta1.ExtractByMin, commonapiv1beta1.ExtractByMax, commonapiv1beta1.ExtractByLatest,
+			commonapiv1beta1.ExtractByAvg, commonapiv1beta1.ExtractByMedian:
+		case commonapiv1beta1.ExtractByLastNAvg:
+			if strategy.LastN <= 0 {
+				return fmt.Errorf("spec.objective.metricStrategies[%v].lastN must be greater than 0 for %v strategy", i, strategy.Value)
+			}
+		case commonapiv1beta1.ExtractByPercentile:
+			if strategy.Percentile == nil || *strategy.Percentile < 0 || *strategy.Percentile > 100 {
+				return fmt.Errorf("spec.objective.metricStrategies[%v].percentile must be between 0 and 100 for %v strategy", i, strategy.Value)
+			}
+		default:
+			return fmt.Errorf("Invalid spec.objective.metricStrategies[%v].value: %v", i, strategy.Value)
+		}
+		if strategy.Value != commonapiv1beta1.ExtractByLastNAvg && strategy.LastN != 0 {
+			return fmt.Errorf("spec.objective.metricStrategies[%v].lastN can be set only for %v strategy", i, commonapiv1beta1.ExtractByLastNAvg)
+		}
+		if strategy.Value != commonapiv1beta1.ExtractByPercentile && strategy.Percentile != nil {
+			return fmt.Errorf("spec.objective.metricStrategies[%v].percentile can be set only for %v strategy", i, commonapiv1beta1.ExtractByPercentile)
+		}
+	}
 	return nil
 }
 
@@ -144,9 +182,13 @@ func (g *DefaultValidator) validateAlgorithm(ag *commonapiv1beta1.AlgorithmSpec)
 		return fmt.Errorf("No spec.algorithm.name specified.")
 	}
 
-	if _, err := g.GetSuggestionConfigData(ag.AlgorithmName); err != nil {
+	suggestionConfigData, err := g.GetSuggestionConfigData(ag.AlgorithmName)
+	if err != nil {
 		return fmt.Errorf("Don't support algorithm %s: %v.", ag.AlgorithmName, err)
 	}
+	if suggestionConfigData.InProcess && !inprocess.IsRegistered(ag.AlgorithmName) {
+		return fmt.Errorf("Algorithm %s can't run in-process.", ag.AlgorithmName)
+	}
 
 	return nil
 }
@@ -164,7 +206,139 @@ func (g *DefaultValidator) validateResumePolicy(resume experimentsv1beta1.Resume
 	return nil
 }
 
-func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.ParameterSpec) error {
+// multiTenantAlgorithms are algorithms which services keep the state for each Experiment,
+// so they can be shared between Experiments.
+var multiTenantAlgorithms = map[string]bool{
+	suggestiongoptuna.AlgorithmCMAES:  true,
+	suggestiongoptuna.AlgorithmTPE:    true,
+	suggestiongoptuna.AlgorithmRandom: true,
+}
+
+// validateSharedAlgorithmService validates that Experiment can use the shared or in-process algorithm service.
+// Only services of multi-tenant algorithms can be shared. Shared and in-process algorithm services
+// have no Suggestion volume, so they can't be resumed from volume.
+func (g *DefaultValidator) validateSharedAlgorithmService(instance *experimentsv1beta1.Experiment) error {
+	suggestionConfigData, err := g.GetSuggestionConfigData(instance.Spec.Algorithm.AlgorithmName)
+	if err != nil {
+		return err
+	}
+	if suggestionConfigData.Endpoint != "" && !multiTenantAlgorithms[instance.Spec.Algorithm.AlgorithmName] {
+		return fmt.Errorf("Shared algorithm service endpoint is not supported for algorithm %v", instance.Spec.Algorithm.AlgorithmName)
+	}
+	if instance.Spec.ResumePolicy != experimentsv1beta1.FromVolume {
+		return nil
+	}
+	if suggestionConfigData.Endpoint != "" || suggestionConfigData.InProcess {
+		return fmt.Errorf("spec.resumePolicy: %v is not supported for algorithm %v with shared algorithm service",
+			experimentsv1beta1.FromVolume, instance.Spec.Algorithm.AlgorithmName)
+	}
+	return nil
+}
+
+// validateNotifications validates webhook URLs, events and payload templates of the notifications.
+// Payload template is rendered with the created Experiment to check that the result is valid JSON.
+func (g *DefaultValidator) validateNotifications(instance *experimentsv1beta1.Experiment) error {
+	validEvents := map[experimentsv1beta1.NotificationEventType]bool{
+		experimentsv1beta1.NotificationEventSucceeded:    true,
+		experimentsv1beta1.NotificationEventFailed:       true,
+		experimentsv1beta1.NotificationEventNewBestTrial: true,
+	}
+	for i, n := range instance.Spec.Notifications {
+		u, err := url.Parse(n.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("spec.notifications[%v].url: %v must be a valid HTTP or HTTPS URL", i, n.URL)
+		}
+		for _, event := range n.Events {
+			if !validEvents[event] {
+				return fmt.Errorf("spec.notifications[%v].events: invalid event %v", i, event)
+			}
+		}
+		if n.MaxRetries != nil && *n.MaxRetries < 0 {
+			return fmt.Errorf("spec.notifications[%v].maxRetries should not be less than 0", i)
+		}
+		if n.AuthSecretRef != nil && (n.AuthSecretRef.Name == "" || n.AuthSecretRef.Key == "") {
+			return fmt.Errorf("spec.notifications[%v].authSecretRef: name and key must be specified", i)
+		}
+		for _, event := range notification.GetNotificationEvents(n) {
+			payload := notification.NewSamplePayload(instance, event)
+			if _, err := notification.RenderPayload(n.PayloadTemplate, payload); err != nil {
+				return fmt.Errorf("spec.notifications[%v].payloadTemplate for %v event: %v", i, event, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRepetitions validates that trial counts are multiples of the repetitions count,
+// so every suggested parameter assignment is run in the complete group of trials.
+func (g *DefaultValidator) validateRepetitions(instance *experimentsv1beta1.Experiment) error {
+	repetitions := instance.Spec.Repetitions
+	if repetitions == nil {
+		return nil
+	}
+	if repetitions.Count < 0 {
+		return fmt.Errorf("spec.repetitions.count should not be less than 0")
+	}
+	switch repetitions.Aggregation {
+	case "", experimentsv1beta1.AggregationMean, experimentsv1beta1.AggregationMedian, experimentsv1beta1.AggregationStd:
+	default:
+		return fmt.Errorf("spec.repetitions.aggregation must be %v, %v or %v", experimentsv1beta1.AggregationMean,
+			experimentsv1beta1.AggregationMedian, experimentsv1beta1.AggregationStd)
+	}
+	if strings.ContainsAny(repetitions.SeedParameterName, "{}") {
+		return fmt.Errorf("Invalid spec.repetitions.seedParameterName: %v", repetitions.SeedParameterName)
+	}
+
+	count := util.GetRepetitionCount(instance)
+	if count <= 1 {
+		return nil
+	}
+	parallelTrialCount := int32(experimentsv1beta1.DefaultTrialParallelCount)
+	if instance.Spec.ParallelTrialCount != nil {
+		parallelTrialCount = *instance.Spec.ParallelTrialCount
+	}
+	if parallelTrialCount%count != 0 {
+		return fmt.Errorf("spec.parallelTrialCount: %v must be a multiple of spec.repetitions.count: %v", parallelTrialCount, count)
+	}
+	if instance.Spec.MaxTrialCount != nil && *instance.Spec.MaxTrialCount%count != 0 {
+		return fmt.Errorf("spec.maxTrialCount: %v must be a multiple of spec.repetitions.count: %v", *instance.Spec.MaxTrialCount, count)
+	}
+	// Trials are labeled with the suggested assignment name which is <experiment name>-<8 random characters>
+	// and with the trial name which is <assignment name>-<seed>
+	if errs := validation.IsValidLabelValue(util.RepetitionTrialName(instance.Name+"-xxxxxxxx", count-1)); len(errs) != 0 {
+		return fmt.Errorf("metadata.name: %v is too long for the repeated trials: %v", instance.Name, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// validatePromoteBestTrial validates the job name, parameter overrides and run spec patch of the promotion.
+func (g *DefaultValidator) validatePromoteBestTrial(spec *experimentsv1beta1.PromotionSpec) error {
+	if spec == nil {
+		return nil
+	}
+	if spec.Name != "" {
+		if errs := validation.IsDNS1123Subdomain(spec.Name); len(errs) != 0 {
+			return fmt.Errorf("spec.promoteBestTrial.name: %v is invalid: %v", spec.Name, strings.Join(errs, ", "))
+		}
+	}
+	for i, o := range spec.ParameterOverrides {
+		if o.Name == "" {
+			return fmt.Errorf("spec.promoteBestTrial.parameterOverrides[%v].name must be specified", i)
+		}
+	}
+	if spec.RunSpecPatch != "" {
+		if _, err := promotion.ParseRunSpecPatch(spec.RunSpecPatch); err != nil {
+			return fmt.Errorf("spec.promoteBestTrial.runSpecPatch is invalid: %v", err)
+		}
+	}
+	return nil
+}
+
+func (g *DefaultValidator) validateParameters(algorithmName string, parameters []experimentsv1beta1.ParameterSpec) error {
+	suggestionConfigData, err := g.GetSuggestionConfigData(algorithmName)
+	if err != nil {
+		return err
+	}
 	for i, param := range parameters {
 
 		if param.ParameterType != experimentsv1beta1.ParameterTypeInt &&
@@ -186,10 +360,17 @@ func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.Pa
 			if param.FeasibleSpace.Max == "" && param.FeasibleSpace.Min == "" {
 				return fmt.Errorf("feasibleSpace.max or feasibleSpace.min must be specified for parameterType: %v in spec.parameters[%v]: %v", param.ParameterType, i, param)
 			}
+			if err := validateDistribution(param.FeasibleSpace); err != nil {
+				return fmt.Errorf("invalid feasibleSpace.distribution in spec.parameters[%v]: %v", i, err)
+			}
+			if err := validateAlgorithmDistribution(algorithmName, suggestionConfigData.InProcess, param); err != nil {
+				return fmt.Errorf("invalid feasibleSpace.distribution in spec.parameters[%v]: %v", i, err)
+			}
 
 		} else if param.ParameterType == experimentsv1beta1.ParameterTypeCategorical || param.ParameterType == experimentsv1beta1.ParameterTypeDiscrete {
-			if param.FeasibleSpace.Max != "" || param.FeasibleSpace.Min != "" || param.FeasibleSpace.Step != "" {
-				return fmt.Errorf("feasibleSpace .max, .min and .step is not supported for parameterType: %v in spec.parameters[%v]: %v", param.ParameterType, i, param)
+			if param.FeasibleSpace.Max != "" || param.FeasibleSpace.Min != "" || param.FeasibleSpace.Step != "" ||
+				param.FeasibleSpace.Distribution != "" {
+				return fmt.Errorf("feasibleSpace .max, .min, .step and .distribution is not supported for parameterType: %v in spec.parameters[%v]: %v", param.ParameterType, i, param)
 			}
 		}
 	}
@@ -197,6 +378,73 @@ func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.Pa
 	return nil
 }
 
+func validateDistribution(fs experimentsv1beta1.FeasibleSpace) error {
+	switch fs.Distribution {
+	case "", experimentsv1beta1.DistributionUniform:
+		return nil
+	case experimentsv1beta1.DistributionLogUniform, experimentsv1beta1.DistributionQLogUniform:
+		min, err := strconv.ParseFloat(fs.Min, 64)
+		if err != nil {
+			return fmt.Errorf("feasibleSpace.min must be specified for %v distribution: %v", fs.Distribution, err)
+		}
+		if min <= 0 {
+			return fmt.Errorf("feasibleSpace.min must be positive for %v distribution", fs.Distribution)
+		}
+		if fs.Distribution == experimentsv1beta1.DistributionLogUniform && fs.Step != "" {
+			return fmt.Errorf("feasibleSpace.step is not supported for %v distribution, use %v distribution to quantize values",
+				fs.Distribution, experimentsv1beta1.DistributionQLogUniform)
+		}
+		if fs.Distribution == experimentsv1beta1.DistributionQLogUniform && fs.Step == "" {
+			return fmt.Errorf("feasibleSpace.step must be specified for %v distribution", fs.Distribution)
+		}
+		return nil
+	case experimentsv1beta1.DistributionNormal:
+		min, err := strconv.ParseFloat(fs.Min, 64)
+		if err != nil {
+			return fmt.Errorf("feasibleSpace.min must be specified for %v distribution: %v", fs.Distribution, err)
+		}
+		max, err := strconv.ParseFloat(fs.Max, 64)
+		if err != nil {
+			return fmt.Errorf("feasibleSpace.max must be specified for %v distribution: %v", fs.Distribution, err)
+		}
+		if min >= max {
+			return fmt.Errorf("feasibleSpace.min must be less than feasibleSpace.max for %v distribution", fs.Distribution)
+		}
+		return nil
+	default:
+		return fmt.Errorf("distribution %v is not supported", fs.Distribution)
+	}
+}
+
+// hyperoptAlgorithms are algorithms of the Hyperopt suggestion service, which supports all distributions.
+var hyperoptAlgorithms = map[string]bool{
+	"random": true,
+	"tpe":    true,
+}
+
+// validateAlgorithmDistribution validates that the suggestion service of the algorithm can sample
+// the parameter from its distribution. Goptuna, which also runs in-process algorithms, supports
+// uniform and log uniform distributions. Other suggestion services support only uniform distribution.
+func validateAlgorithmDistribution(algorithmName string, inProcess bool, param experimentsv1beta1.ParameterSpec) error {
+	distribution := param.FeasibleSpace.Distribution
+	if distribution == "" || distribution == experimentsv1beta1.DistributionUniform {
+		return nil
+	}
+	switch {
+	case inProcess || algorithmName == suggestiongoptuna.AlgorithmCMAES:
+		if distribution != experimentsv1beta1.DistributionLogUniform {
+			return fmt.Errorf("%v distribution is not supported by algorithm %v, supported distributions are %v and %v",
+				distribution, algorithmName, experimentsv1beta1.DistributionUniform, experimentsv1beta1.DistributionLogUniform)
+		}
+		return nil
+	case hyperoptAlgorithms[algorithmName]:
+		return nil
+	default:
+		return fmt.Errorf("%v distribution is not supported by algorithm %v, supported distribution is %v",
+			distribution, algorithmName, experimentsv1beta1.DistributionUniform)
+	}
+}
+
 func (g *DefaultValidator) validateTrialTemplate(instance *experimentsv1beta1.Experiment) error {
 
 	trialTemplate := instance.Spec.TrialTemplate
@@ -250,20 +498,65 @@ func (g *DefaultValidator) validateTrialTemplate(instance *experimentsv1beta1.Ex
 		}
 		trialParametersNames[parameter.Name] = true
 		trialParametersRefs[parameter.Reference] = true
+	}
 
-		// Check if trialParameters contains all substitution for Trial template
-		if strings.Index(trialTemplateStr, fmt.Sprintf(consts.TrialTemplateParamReplaceFormat, parameter.Name)) == -1 {
-			return fmt.Errorf("Parameter name: %v in spec.trialParameters not found in spec.trialTemplate: %v", parameter.Name, trialTemplateStr)
+	// Seed of the repeated Trial can be used as the trial parameter
+	if seedParameterName := util.GetRepetitionSeedParameterName(instance); seedParameterName != "" {
+		if _, ok := trialParametersNames[seedParameterName]; ok {
+			return fmt.Errorf("Parameter name %v in spec.trialTemplate.trialParameters is reserved for spec.repetitions.seedParameterName", seedParameterName)
 		}
-
-		trialTemplateStr = strings.Replace(trialTemplateStr, fmt.Sprintf(consts.TrialTemplateParamReplaceFormat, parameter.Name), "test-value", -1)
+		trialParametersNames[seedParameterName] = true
+	}
+
+	// Built-in variables can be used only in expressions
+	exprBuiltinVariables := map[string]bool{
+		consts.TrialTemplateExprKeyOfExperimentName:      true,
+		consts.TrialTemplateExprKeyOfExperimentNamespace: true,
+		consts.TrialTemplateExprKeyOfTrialName:           true,
+		consts.TrialTemplateExprKeyOfTrialNamespace:      true,
+	}
+
+	// Check if Trial template substitutions are trialParameters names or valid expressions of them
+	var expressionErr error
+	usedParametersNames := make(map[string]bool)
+	notFoundParams := []string{}
+	substitutionRegex := regexp.MustCompile(consts.TrialTemplateParamParseFormatRegex)
+	trialTemplateStr = substitutionRegex.ReplaceAllStringFunc(trialTemplateStr, func(placeHolder string) string {
+		content := substitutionRegex.FindStringSubmatch(placeHolder)[1]
+		if _, ok := trialParametersNames[content]; ok {
+			usedParametersNames[content] = true
+			return "test-value"
+		}
+		expr, err := manifest.ParseExpression(content)
+		if err != nil {
+			if expressionErr == nil {
+				expressionErr = fmt.Errorf("Invalid expression %v in spec.trialTemplate: %v", placeHolder, err)
+			}
+			return "test-value"
+		}
+		for _, identifier := range expr.Identifiers() {
+			if _, ok := trialParametersNames[identifier]; ok {
+				usedParametersNames[identifier] = true
+			} else if _, ok := exprBuiltinVariables[identifier]; !ok {
+				notFoundParams = append(notFoundParams, placeHolder)
+			}
+		}
+		return "test-value"
+	})
+	if expressionErr != nil {
+		return expressionErr
 	}
 
 	// Check if Trial template contains all substitution for trialParameters
-	substitutionRegex := regexp.MustCompile(consts.TrialTemplateParamReplaceFormatRegex)
-	notReplacedParams := substitutionRegex.FindAllString(trialTemplateStr, -1)
-	if len(notReplacedParams) != 0 {
-		return fmt.Errorf("Parameters: %v in spec.trialTemplate not found in spec.trialParameters: %v", notReplacedParams, trialTemplate.TrialParameters)
+	if len(notFoundParams) != 0 {
+		return fmt.Errorf("Parameters: %v in spec.trialTemplate not found in spec.trialParameters: %v", notFoundParams, trialTemplate.TrialParameters)
+	}
+
+	// Check if trialParameters contains all substitution for Trial template
+	for _, parameter := range trialTemplate.TrialParameters {
+		if _, ok := usedParametersNames[parameter.Name]; !ok {
+			return fmt.Errorf("Parameter name: %v in spec.trialParameters not found in spec.trialTemplate: %v", parameter.Name, trialTemplateStr)
+		}
 	}
 
 	// Check if Trial template can be converted to unstructured
@@ -283,86 +576,24 @@ func (g *DefaultValidator) validateTrialTemplate(instance *experimentsv1beta1.Ex
 	}
 
 	// Check if Job is supported
-	// Check if Job can be converted to Batch Job/TFJob/PyTorchJob
+	// Check if Job can be converted to Batch Job/TFJob/PyTorchJob/MPIJob/XGBoostJob
 	// Other jobs are not validated
 	if err := g.validateSupportedJob(runSpec); err != nil {
 		return fmt.Errorf("Invalid spec.trialTemplate: %v", err)
 	}
 
-	return nil
-}
-
-func (g *DefaultValidator) validateSupportedJob(runSpec *unstructured.Unstructured) error {
-	gvk := runSpec.GroupVersionKind()
-	supportedJobs := jobv1beta1.SupportedJobList
-	for _, sJob := range supportedJobs {
-		if gvk == sJob {
-			switch gvk.Kind {
-			case consts.JobKindJob:
-				batchJob := batchv1.Job{}
-
-				// Validate that RunSpec can be converted to Batch Job
-				err := runtime.DefaultUnstructuredConverter.FromUnstructured(runSpec.Object, &batchJob)
-				if err != nil {
-					return fmt.Errorf("Unable to convert spec.TrialTemplate: %v to %v: %v", runSpec.Object, gvk.Kind, err)
-				}
-
-				err = validatePatchJob(runSpec, batchJob, gvk.Kind)
-				if err != nil {
-					return err
-				}
-			case consts.JobKindTF:
-				tfJob := &tfv1.TFJob{}
-				err := runtime.DefaultUnstructuredConverter.FromUnstructured(runSpec.Object, &tfJob)
-				if err != nil {
-					return fmt.Errorf("Unable to convert spec.TrialTemplate to %v: %v", gvk.Kind, err)
-				}
-				err = validatePatchJob(runSpec, tfJob, gvk.Kind)
-				if err != nil {
-					return err
-				}
-			case consts.JobKindPyTorch:
-				pytorchJob := &pytorchv1.PyTorchJob{}
-				err := runtime.DefaultUnstructuredConverter.FromUnstructured(runSpec.Object, &pytorchJob)
-				if err != nil {
-					return fmt.Errorf("Unable to convert spec.TrialTemplate to %v: %v", gvk.Kind, err)
-				}
-				err = validatePatchJob(runSpec, pytorchJob, gvk.Kind)
-				if err != nil {
-					return err
-				}
-
-			}
-			return nil
+	// Promoted job is the Trial template with the run spec patch, so it must be supported as well
+	if promote := instance.Spec.PromoteBestTrial; promote != nil && promote.RunSpecPatch != "" {
+		if _, err := promotion.PatchRunSpec(runSpec, promote.RunSpecPatch); err != nil {
+			return fmt.Errorf("spec.promoteBestTrial.runSpecPatch is invalid: %v", err)
 		}
 	}
+
 	return nil
 }
 
-func validatePatchJob(runSpec *unstructured.Unstructured, job interface{}, jobType string) error {
-
-	// Not necessary to check error runSpec.Object must be valid JSON
-	runSpecBefore, _ := json.Marshal(runSpec.Object)
-
-	// Not necessary to check error job must be valid JSON
-	runSpecAfter, _ := json.Marshal(job)
-
-	// Create Patch on tranformed Job (e.g: Job, TFJob) using unstructured JSON
-	runSpecPatchOperations, err := jsonPatch.CreatePatch(runSpecAfter, runSpecBefore)
-	if err != nil {
-		return fmt.Errorf("Create patch error: %v", err)
-	}
-
-	for _, operation := range runSpecPatchOperations {
-		// If operation != "remove" some values from trialTemplate were not converted
-		// We can't validate /resources/limits/ because CRDs can have custom k8s resources using defice plugin
-		// ref https://kubernetes.io/docs/concepts/extend-kubernetes/compute-storage-net/device-plugins/
-		if operation.Operation != "remove" && !strings.Contains(operation.Path, "/resources/limits/") && !strings.Contains(operation.Path, "/resources/requests/") {
-			return fmt.Errorf("Unable to convert: %v - %v to %v, converted template: %v", operation.Path, operation.Value, jobType, string(runSpecAfter))
-		}
-	}
-
-	return nil
+func (g *DefaultValidator) validateSupportedJob(runSpec *unstructured.Unstructured) error {
+	return jobv1beta1.ValidateSupportedJob(runSpec)
 }
 
 func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Experiment) error {
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -245,7 +246,11 @@ func TestValidateParameters(t *testing.T) {
 	p := manifestmock.NewMockGenerator(mockCtrl)
 	g := New(p)
 
+	p.EXPECT().GetSuggestionConfigData("tpe-in-process").Return(katibconfig.SuggestionConfig{InProcess: true}, nil).AnyTimes()
+	p.EXPECT().GetSuggestionConfigData(gomock.Any()).Return(katibconfig.SuggestionConfig{}, nil).AnyTimes()
+
 	tcs := []struct {
+		algorithmName   string
 		parameters      []experimentsv1beta1.ParameterSpec
 		err             bool
 		testDescription string
@@ -299,10 +304,164 @@ func TestValidateParameters(t *testing.T) {
 			err:             true,
 			testDescription: "Not empty max for categorical parameter type",
 		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				return ps
+			}(),
+			err:             false,
+			testDescription: "Log uniform distribution for int parameter type",
+		},
+		{
+			algorithmName: "cmaes",
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				return ps
+			}(),
+			err:             false,
+			testDescription: "Log uniform distribution for int parameter type is supported by Goptuna",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace.Step = "0.5"
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Log uniform distribution with step",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace.Step = "0.5"
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionQLogUniform
+				return ps
+			}(),
+			err:             false,
+			testDescription: "Quantized log uniform distribution is supported by Hyperopt",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionQLogUniform
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Empty step for quantized log uniform distribution",
+		},
+		{
+			algorithmName: "tpe-in-process",
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Step = "2"
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionQLogUniform
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Quantized log uniform distribution is not supported by in-process algorithm",
+		},
+		{
+			algorithmName: "cmaes",
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				return ps
+			}(),
+			err:             false,
+			testDescription: "Log uniform distribution for double parameter type is supported by Goptuna",
+		},
+		{
+			algorithmName: "cmaes",
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].ParameterType = experimentsv1beta1.ParameterTypeDouble
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionNormal
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Normal distribution is not supported by Goptuna",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionNormal
+				return ps
+			}(),
+			err:             false,
+			testDescription: "Normal distribution is supported by Hyperopt",
+		},
+		{
+			algorithmName: "grid",
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Log uniform distribution is not supported by grid algorithm",
+		},
+		{
+			algorithmName: "grid",
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionUniform
+				return ps
+			}(),
+			err:             false,
+			testDescription: "Uniform distribution is supported by all algorithms",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Min = "0"
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionLogUniform
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Not positive min for log uniform distribution",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Max = ""
+				ps[0].FeasibleSpace.Distribution = experimentsv1beta1.DistributionNormal
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Empty max for normal distribution",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].FeasibleSpace.Distribution = "invalid-distribution"
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Invalid distribution",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[1].FeasibleSpace.Distribution = experimentsv1beta1.DistributionUniform
+				return ps
+			}(),
+			err:             true,
+			testDescription: "Distribution for categorical parameter type",
+		},
 	}
 
 	for _, tc := range tcs {
-		err := g.(*DefaultValidator).validateParameters(tc.parameters)
+		algorithmName := tc.algorithmName
+		if algorithmName == "" {
+			algorithmName = "tpe"
+		}
+		err := g.(*DefaultValidator).validateParameters(algorithmName, tc.parameters)
 		if !tc.err && err != nil {
 			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
 		} else if tc.err && err == nil {
@@ -359,6 +518,22 @@ spec:
 	customJobType.TypeMeta.Kind = "CustomKind"
 	customJobTypeStr := convertBatchJobToString(customJobType)
 
+	expressionJob := newFakeBatchJob()
+	expressionJob.Spec.Template.Spec.Containers[0].Command = []string{
+		"--lr=${trialParameters.learningRate * 10}",
+		"--num-layers=${trialParameters.format('%d', numberLayers + 1)}",
+		"--output=${trialParameters.join('/', experiment.name, trial.name)}",
+	}
+	expressionJobStr := convertBatchJobToString(expressionJob)
+
+	invalidExpressionJob := newFakeBatchJob()
+	invalidExpressionJob.Spec.Template.Spec.Containers[0].Command[3] = "--num-layers=${trialParameters.numberLayers *}"
+	invalidExpressionJobStr := convertBatchJobToString(invalidExpressionJob)
+
+	unknownVariableExpressionJob := newFakeBatchJob()
+	unknownVariableExpressionJob.Spec.Template.Spec.Containers[0].Command[3] = "--num-layers=${trialParameters.numberLayers * extraParameter}"
+	unknownVariableExpressionJobStr := convertBatchJobToString(unknownVariableExpressionJob)
+
 	emptyConfigMap := p.EXPECT().GetTrialTemplate(gomock.Any()).Return("", errors.New(string(metav1.StatusReasonNotFound)))
 
 	validTemplate1 := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(validJobStr, nil)
@@ -372,6 +547,9 @@ spec:
 	notEmptyMetadataTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(notEmptyMetadataStr, nil)
 	emptyAPIVersionTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(emptyAPIVersionStr, nil)
 	customJobTypeTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(customJobTypeStr, nil)
+	expressionTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(expressionJobStr, nil)
+	invalidExpressionTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(invalidExpressionJobStr, nil)
+	unknownVariableExpressionTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(unknownVariableExpressionJobStr, nil)
 
 	gomock.InOrder(
 		emptyConfigMap,
@@ -385,6 +563,9 @@ spec:
 		notEmptyMetadataTemplate,
 		emptyAPIVersionTemplate,
 		customJobTypeTemplate,
+		expressionTemplate,
+		invalidExpressionTemplate,
+		unknownVariableExpressionTemplate,
 	)
 
 	tcs := []struct {
@@ -560,6 +741,36 @@ spec:
 			Err:             false,
 			testDescription: "Trial template has custom Kind",
 		},
+		// Trial Template contains valid expressions
+		// expressionTemplate case
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Trial template contains valid expressions",
+		},
+		// Trial Template contains expression with syntax error
+		// invalidExpressionTemplate case
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Trial template contains invalid expression",
+		},
+		// Trial Template contains expression with unknown variable
+		// unknownVariableExpressionTemplate case
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Trial template contains expression with unknown variable",
+		},
 	}
 	for _, tc := range tcs {
 		err := g.(*DefaultValidator).validateTrialTemplate(tc.Instance)
@@ -659,6 +870,88 @@ spec:
 		t.Errorf("ConvertStringToUnstructured failed: %v", err)
 	}
 
+	validMPIJob := `apiVersion: kubeflow.org/v1
+kind: MPIJob
+spec:
+  slotsPerWorker: 1
+  cleanPodPolicy: Running
+  mpiReplicaSpecs:
+    Launcher:
+      replicas: 1
+      template:
+        spec:
+          containers:
+            - name: mpi-launcher
+              image: mpi-image
+              command:
+                - mpirun
+    Worker:
+      replicas: 2
+      template:
+        spec:
+          containers:
+            - name: mpi-worker
+              image: mpi-image`
+
+	validMPIJobUnstr, err := util.ConvertStringToUnstructured(validMPIJob)
+	if err != nil {
+		t.Errorf("ConvertStringToUnstructured failed: %v", err)
+	}
+
+	invalidStructureMPIJob := `apiVersion: kubeflow.org/v1
+kind: MPIJob
+spec:
+  slotsPerWorker: 1
+  mpiReplicaSpecs:
+    Launcher:
+      invalidTemplate:
+        spec:
+          containers:
+            - name: mpi-launcher`
+
+	invalidStructureMPIJobUnstr, err := util.ConvertStringToUnstructured(invalidStructureMPIJob)
+	if err != nil {
+		t.Errorf("ConvertStringToUnstructured failed: %v", err)
+	}
+
+	validXGBoostJob := `apiVersion: xgboostjob.kubeflow.org/v1
+kind: XGBoostJob
+spec:
+  backoffLimit: 3
+  xgbReplicaSpecs:
+    Master:
+      replicas: 1
+      restartPolicy: Never
+      template:
+        spec:
+          containers:
+            - name: xgboostjob
+              image: xgboost-image
+    Worker:
+      replicas: 2
+      restartPolicy: Never
+      template:
+        spec:
+          containers:
+            - name: xgboostjob
+              image: xgboost-image`
+
+	validXGBoostJobUnstr, err := util.ConvertStringToUnstructured(validXGBoostJob)
+	if err != nil {
+		t.Errorf("ConvertStringToUnstructured failed: %v", err)
+	}
+
+	invalidFieldXGBoostJob := `apiVersion: xgboostjob.kubeflow.org/v1
+kind: XGBoostJob
+spec:
+  xgbReplicaSpecs:
+    Master: InvalidMaster`
+
+	invalidFieldXGBoostJobUnstr, err := util.ConvertStringToUnstructured(invalidFieldXGBoostJob)
+	if err != nil {
+		t.Errorf("ConvertStringToUnstructured failed: %v", err)
+	}
+
 	notDefaultResourceBatchJob := `apiVersion: batch/v1
 kind: Job
 spec:
@@ -720,6 +1013,30 @@ spec:
 			Err:             true,
 			testDescription: "Trial template has invalid PyTorch Job structure",
 		},
+		// Valid MPI Job
+		{
+			RunSpec:         validMPIJobUnstr,
+			Err:             false,
+			testDescription: "Valid MPI Job in Trial template",
+		},
+		// Invalid Structure MPI Job
+		{
+			RunSpec:         invalidStructureMPIJobUnstr,
+			Err:             true,
+			testDescription: "Trial template has invalid MPI Job structure",
+		},
+		// Valid XGBoost Job
+		{
+			RunSpec:         validXGBoostJobUnstr,
+			Err:             false,
+			testDescription: "Valid XGBoost Job in Trial template",
+		},
+		// Invalid Field XGBoost Job
+		{
+			RunSpec:         invalidFieldXGBoostJobUnstr,
+			Err:             true,
+			testDescription: "Trial template has invalid XGBoost Job parameter",
+		},
 		// Valid case with not default Kubernetes resource (nvidia.com/gpu: 1)
 		{
 			RunSpec:         notDefaultResourceBatchUnstr,
@@ -981,7 +1298,7 @@ func TestValidateConfigData(t *testing.T) {
 	suggestionConfigData := katibconfig.SuggestionConfig{}
 	suggestionConfigData.Image = "algorithmImage"
 
-	validConfigCall := p.EXPECT().GetSuggestionConfigData(gomock.Any()).Return(suggestionConfigData, nil)
+	validConfigCall := p.EXPECT().GetSuggestionConfigData(gomock.Any()).Return(suggestionConfigData, nil).AnyTimes()
 	invalidConfigCall := p.EXPECT().GetSuggestionConfigData(gomock.Any()).Return(katibconfig.SuggestionConfig{}, errors.New("GetSuggestionConfigData failed"))
 
 	gomock.InOrder(
@@ -1016,6 +1333,349 @@ func TestValidateConfigData(t *testing.T) {
 	}
 }
 
+func TestValidateSharedAlgorithmService(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	p := manifestmock.NewMockGenerator(mockCtrl)
+	g := New(p)
+
+	suggestionConfigData := katibconfig.SuggestionConfig{}
+	suggestionConfigData.Endpoint = "katib-suggestion-test.kubeflow:6789"
+
+	p.EXPECT().GetSuggestionConfigData(gomock.Any()).Return(suggestionConfigData, nil).AnyTimes()
+
+	tcs := []struct {
+		Instance        *experimentsv1beta1.Experiment
+		Err             bool
+		testDescription string
+	}{
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "tpe"
+				return i
+			}(),
+			Err:             false,
+			testDescription: "Shared algorithm service with default resume policy",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "tpe"
+				i.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Shared algorithm service with FromVolume resume policy",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "hyperband"
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Shared algorithm service for algorithm which is not multi-tenant",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := g.(*DefaultValidator).validateSharedAlgorithmService(tc.Instance)
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
+func TestValidateInProcessAlgorithmService(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	p := manifestmock.NewMockGenerator(mockCtrl)
+	g := New(p)
+
+	suggestionConfigData := katibconfig.SuggestionConfig{}
+	suggestionConfigData.InProcess = true
+
+	p.EXPECT().GetSuggestionConfigData(gomock.Any()).Return(suggestionConfigData, nil).AnyTimes()
+
+	tcs := []struct {
+		Instance        *experimentsv1beta1.Experiment
+		Err             bool
+		testDescription string
+	}{
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "tpe"
+				return i
+			}(),
+			Err:             false,
+			testDescription: "In-process algorithm service with registered algorithm",
+		},
+		{
+			Instance:        newFakeInstance(),
+			Err:             true,
+			testDescription: "In-process algorithm service with unregistered algorithm",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "tpe"
+				i.Spec.ResumePolicy = experimentsv1beta1.FromVolume
+				return i
+			}(),
+			Err:             true,
+			testDescription: "In-process algorithm service with FromVolume resume policy",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := g.(*DefaultValidator).validateAlgorithm(tc.Instance.Spec.Algorithm)
+		if err == nil {
+			err = g.(*DefaultValidator).validateSharedAlgorithmService(tc.Instance)
+		}
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
+func TestValidateNotifications(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	p := manifestmock.NewMockGenerator(mockCtrl)
+	g := New(p)
+
+	newNotificationInstance := func(n experimentsv1beta1.NotificationSpec) *experimentsv1beta1.Experiment {
+		i := newFakeInstance()
+		i.Spec.Notifications = []experimentsv1beta1.NotificationSpec{n}
+		return i
+	}
+	invalidMaxRetries := int32(-1)
+
+	tcs := []struct {
+		Instance        *experimentsv1beta1.Experiment
+		Err             bool
+		testDescription string
+	}{
+		{
+			Instance: newNotificationInstance(experimentsv1beta1.NotificationSpec{
+				URL:             "https://hooks.example.com/katib",
+				Events:          []experimentsv1beta1.NotificationEventType{experimentsv1beta1.NotificationEventNewBestTrial},
+				PayloadTemplate: `{"text": "{{.Experiment.Name}} {{.Event}}", "best": {{json .BestTrial}}}`,
+			}),
+			Err:             false,
+			testDescription: "Valid notification",
+		},
+		{
+			Instance: newNotificationInstance(experimentsv1beta1.NotificationSpec{
+				URL:             "https://hooks.example.com/katib",
+				PayloadTemplate: `{"text": "{{.Experiment.Name}} {{.Event}}: {{.Condition.Reason}}", "lr": {{json (index .BestTrial.ParameterAssignments 0).Value}}}`,
+			}),
+			Err:             false,
+			testDescription: "Valid notification with condition and best trial parameters",
+		},
+		{
+			Instance: newNotificationInstance(experimentsv1beta1.NotificationSpec{
+				URL:             "https://hooks.example.com/katib",
+				Events:          []experimentsv1beta1.NotificationEventType{experimentsv1beta1.NotificationEventNewBestTrial},
+				PayloadTemplate: `{"text": "{{.Condition.Reason}}"}`,
+			}),
+			Err:             true,
+			testDescription: "Condition is not set for new best trial event",
+		},
+		{
+			Instance: newNotificationInstance(experimentsv1beta1.NotificationSpec{
+				URL: "hooks.example.com/katib",
+			}),
+			Err:             true,
+			testDescription: "URL without scheme",
+		},
+		{
+			Instance: newNotificationInstance(experimentsv1beta1.NotificationSpec{
+				URL:    "https://hooks.example.com/katib",
+				Events: []experimentsv1beta1.NotificationEventType{"Created"},
+			}),
+			Err:             true,
+			testDescription: "Invalid event",
+		},
+		{
+			Instance: newNotificationInstance(experimentsv1beta1.NotificationSpec{
+				URL:        "https://hooks.example.com/katib",
+				MaxRetries: &invalidMaxRetries,
+			}),
+			Err:             true,
+			testDescription: "Negative max retries",
+		},
+		{
+			Instance: newNotificationInstance(experimentsv1beta1.NotificationSpec{
+				URL:             "https://hooks.example.com/katib",
+				PayloadTemplate: `{"text": "{{.Experiment.Name}}"`,
+			}),
+			Err:             true,
+			testDescription: "Payload template result is not valid JSON",
+		},
+		{
+			Instance: newNotificationInstance(experimentsv1beta1.NotificationSpec{
+				URL:             "https://hooks.example.com/katib",
+				PayloadTemplate: `{"text": "{{.Experiment.Name}"}`,
+			}),
+			Err:             true,
+			testDescription: "Invalid payload template",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := g.(*DefaultValidator).validateNotifications(tc.Instance)
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
+func TestValidateRepetitions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	p := manifestmock.NewMockGenerator(mockCtrl)
+	g := New(p)
+
+	newRepetitionsInstance := func(repetitions *experimentsv1beta1.RepetitionSpec, parallelTrialCount int32) *experimentsv1beta1.Experiment {
+		i := newFakeInstance()
+		i.Spec.Repetitions = repetitions
+		i.Spec.ParallelTrialCount = &parallelTrialCount
+		return i
+	}
+
+	tcs := []struct {
+		Instance        *experimentsv1beta1.Experiment
+		Err             bool
+		testDescription string
+	}{
+		{
+			Instance:        newRepetitionsInstance(&experimentsv1beta1.RepetitionSpec{Count: 3, Aggregation: experimentsv1beta1.AggregationMedian}, 3),
+			Err:             false,
+			testDescription: "Valid repetitions",
+		},
+		{
+			Instance:        newRepetitionsInstance(&experimentsv1beta1.RepetitionSpec{Count: -1}, 3),
+			Err:             true,
+			testDescription: "Negative repetitions count",
+		},
+		{
+			Instance:        newRepetitionsInstance(&experimentsv1beta1.RepetitionSpec{Count: 2, Aggregation: "max"}, 2),
+			Err:             true,
+			testDescription: "Invalid aggregation",
+		},
+		{
+			Instance:        newRepetitionsInstance(&experimentsv1beta1.RepetitionSpec{Count: 2}, 3),
+			Err:             true,
+			testDescription: "Parallel trial count is not multiple of repetitions count",
+		},
+		{
+			Instance:        newRepetitionsInstance(&experimentsv1beta1.RepetitionSpec{Count: 4}, 4),
+			Err:             true,
+			testDescription: "Max trial count is not multiple of repetitions count",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newRepetitionsInstance(&experimentsv1beta1.RepetitionSpec{Count: 2}, 2)
+				i.Name = strings.Repeat("a", 60)
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Experiment name is too long for repetition labels",
+		},
+		{
+			Instance: func() *experimentsv1beta1.Experiment {
+				i := newRepetitionsInstance(&experimentsv1beta1.RepetitionSpec{Count: 2}, 2)
+				i.Name = strings.Repeat("a", 53)
+				return i
+			}(),
+			Err:             true,
+			testDescription: "Experiment name is too long for repeated trial name labels",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := g.(*DefaultValidator).validateRepetitions(tc.Instance)
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
+func TestValidatePromoteBestTrial(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	p := manifestmock.NewMockGenerator(mockCtrl)
+	g := New(p)
+
+	tcs := []struct {
+		Spec            *experimentsv1beta1.PromotionSpec
+		Err             bool
+		testDescription string
+	}{
+		{
+			Spec:            nil,
+			Err:             false,
+			testDescription: "Promotion is not specified",
+		},
+		{
+			Spec: &experimentsv1beta1.PromotionSpec{
+				Name:               "best-job",
+				ParameterOverrides: []commonv1beta1.ParameterAssignment{{Name: "epochs", Value: "100"}},
+				RunSpecPatch:       `{"spec": {"backoffLimit": 3}}`,
+			},
+			Err:             false,
+			testDescription: "Valid promotion",
+		},
+		{
+			Spec:            &experimentsv1beta1.PromotionSpec{Name: "Best_Job"},
+			Err:             true,
+			testDescription: "Invalid job name",
+		},
+		{
+			Spec: &experimentsv1beta1.PromotionSpec{
+				ParameterOverrides: []commonv1beta1.ParameterAssignment{{Value: "100"}},
+			},
+			Err:             true,
+			testDescription: "Parameter override without name",
+		},
+		{
+			Spec:            &experimentsv1beta1.PromotionSpec{RunSpecPatch: `["spec"]`},
+			Err:             true,
+			testDescription: "Run spec patch is not JSON object",
+		},
+		{
+			Spec:            &experimentsv1beta1.PromotionSpec{RunSpecPatch: `{"apiVersion": "v1", "kind": "Pod"}`},
+			Err:             true,
+			testDescription: "Run spec patch changes the job kind",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := g.(*DefaultValidator).validatePromoteBestTrial(tc.Spec)
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
+
 func newFakeInstance() *experimentsv1beta1.Experiment {
 	goal := 0.11
 	var maxTrialCount int32 = 6
@@ -1138,3 +1798,60 @@ func convertBatchJobToString(batchJob *batchv1.Job) string {
 
 	return batchJobStr
 }
+
+func TestValidateMetricStrategies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	p := manifestmock.NewMockGenerator(mockCtrl)
+	g := New(p)
+
+	percentile := 90.0
+	invalidPercentile := 101.0
+
+	tcs := []struct {
+		Strategies      []commonv1beta1.MetricStrategy
+		Err             bool
+		testDescription string
+	}{
+		{
+			Strategies: []commonv1beta1.MetricStrategy{
+				{Name: "loss", Value: commonv1beta1.ExtractByMin},
+				{Name: "accuracy", Value: commonv1beta1.ExtractByLastNAvg, LastN: 5},
+				{Name: "f1", Value: commonv1beta1.ExtractByPercentile, Percentile: &percentile},
+				{Name: "recall", Value: commonv1beta1.ExtractByMedian},
+			},
+			Err:             false,
+			testDescription: "Valid metric strategies",
+		},
+		{
+			Strategies:      []commonv1beta1.MetricStrategy{{Name: "loss", Value: "first"}},
+			Err:             true,
+			testDescription: "Invalid metric strategy value",
+		},
+		{
+			Strategies:      []commonv1beta1.MetricStrategy{{Name: "loss", Value: commonv1beta1.ExtractByLastNAvg}},
+			Err:             true,
+			testDescription: "LastN is not set for lastNAvg strategy",
+		},
+		{
+			Strategies:      []commonv1beta1.MetricStrategy{{Name: "loss", Value: commonv1beta1.ExtractByPercentile, Percentile: &invalidPercentile}},
+			Err:             true,
+			testDescription: "Percentile is out of range",
+		},
+		{
+			Strategies:      []commonv1beta1.MetricStrategy{{Name: "loss", Value: commonv1beta1.ExtractByAvg, LastN: 3}},
+			Err:             true,
+			testDescription: "LastN is set for avg strategy",
+		},
+	}
+
+	for _, tc := range tcs {
+		err := g.(*DefaultValidator).validateMetricStrategies(tc.Strategies)
+		if !tc.Err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.Err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+	}
+}
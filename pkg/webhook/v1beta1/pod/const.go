@@ -27,6 +27,24 @@ const (
 	TrialKind = "Trial"
 	// TrialAPIVersion is the name of Trial API Version
 	TrialAPIVersion = "kubeflow.org/v1beta1"
+
+	// LauncherContainerName is the name of init container which copies the launcher to the shared volume
+	LauncherContainerName = "katib-launcher"
+	// LauncherVolume is the name of the shared volume with the launcher binary
+	LauncherVolume = "katib-launcher"
+	// LauncherMountPath is the directory of the shared volume with the launcher binary
+	LauncherMountPath = "/katib/bin"
+	// LauncherBinaryName is the name of the launcher binary in the shared volume
+	LauncherBinaryName = "katib-launcher"
+	// LauncherDockerConfigVolume is the name of the volume with the image pull Secret
+	LauncherDockerConfigVolume = "katib-launcher-docker-config"
+	// LauncherDockerConfigMountPath is the directory of the image pull Secret in the primary container
+	LauncherDockerConfigMountPath = "/katib/docker"
+	// KubeletLogsVolume is the name of the volume with the container logs on the node
+	KubeletLogsVolume = "katib-kubelet-logs"
+	// ImageCommandErrorAnnotation is the pod annotation with the error why the image command
+	// is not resolved by the webhook and the launcher gets it from the registry
+	ImageCommandErrorAnnotation = "katib.kubeflow.org/image-command-error"
 )
 
 var (
@@ -0,0 +1,301 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/authn/k8schain"
+	"github.com/google/go-containerregistry/pkg/name"
+	crv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
+)
+
+// imageCacheEntry is ENTRYPOINT and CMD of the image.
+type imageCacheEntry struct {
+	Image      string    `json:"image"`
+	Digest     string    `json:"digest"`
+	Entrypoint []string  `json:"entrypoint,omitempty"`
+	Cmd        []string  `json:"cmd,omitempty"`
+	Expires    time.Time `json:"expires"`
+}
+
+// imageLookup is the registry lookup of the image which is shared by concurrent requests.
+type imageLookup struct {
+	done  chan struct{}
+	entry *imageCacheEntry
+	err   error
+}
+
+// imageConfigCache caches ENTRYPOINT and CMD of the images, so the webhook doesn't get
+// the image config from the registry for every Trial pod.
+// Images are resolved to digests which expire after TTL, configs are cached by digest.
+type imageConfigCache struct {
+	mu      sync.Mutex
+	entries map[string]*imageCacheEntry
+	digests map[string]*imageCacheEntry
+	lookups map[string]*imageLookup
+
+	// getDigest and getConfig access the registry, they are replaced in unit tests.
+	getDigest func(ref name.Reference, keychain authn.Keychain) (string, error)
+	getConfig func(ref name.Reference, keychain authn.Keychain) (*crv1.Config, error)
+}
+
+func newImageConfigCache() *imageConfigCache {
+	return &imageConfigCache{
+		entries:   map[string]*imageCacheEntry{},
+		digests:   map[string]*imageCacheEntry{},
+		lookups:   map[string]*imageLookup{},
+		getDigest: getRemoteImageDigest,
+		getConfig: getRemoteImageConfig,
+	}
+}
+
+// get returns ENTRYPOINT and CMD of the image from the cache or from the registry.
+// Concurrent requests for the same image wait for one registry lookup.
+func (c *imageConfigCache) get(image string, keychain func() (authn.Keychain, error),
+	cacheConfig katibconfig.ImageCacheConfig, kubeClient client.Client) (*imageCacheEntry, error) {
+	c.mu.Lock()
+	if entry, ok := c.entries[image]; ok && time.Now().Before(entry.Expires) {
+		c.mu.Unlock()
+		return entry, nil
+	}
+	lookup, ok := c.lookups[image]
+	if !ok {
+		lookup = &imageLookup{done: make(chan struct{})}
+		c.lookups[image] = lookup
+		go func() {
+			lookup.entry, lookup.err = c.lookup(image, keychain, cacheConfig, kubeClient)
+			c.mu.Lock()
+			delete(c.lookups, image)
+			if lookup.err == nil {
+				c.pruneExpired(time.Now())
+				c.entries[image] = lookup.entry
+				c.digests[lookup.entry.Digest] = lookup.entry
+			}
+			c.mu.Unlock()
+			close(lookup.done)
+		}()
+	}
+	c.mu.Unlock()
+
+	// Lookup is not cancelled after the timeout, the result is cached for the next pods
+	select {
+	case <-lookup.done:
+		return lookup.entry, lookup.err
+	case <-time.After(cacheConfig.Timeout.Duration):
+		return nil, fmt.Errorf("Timed out after %v while getting ENTRYPOINT and CMD of image %q from the registry",
+			cacheConfig.Timeout.Duration, image)
+	}
+}
+
+// pruneExpired removes expired entries to keep the cache small, c.mu must be held.
+func (c *imageConfigCache) pruneExpired(now time.Time) {
+	for image, entry := range c.entries {
+		if now.After(entry.Expires) {
+			delete(c.entries, image)
+		}
+	}
+	for digest, entry := range c.digests {
+		if now.After(entry.Expires) {
+			delete(c.digests, digest)
+		}
+	}
+}
+
+func (c *imageConfigCache) lookup(image string, keychain func() (authn.Keychain, error),
+	cacheConfig katibconfig.ImageCacheConfig, kubeClient client.Client) (*imageCacheEntry, error) {
+	if kubeClient != nil && cacheConfig.ConfigMapName != "" {
+		entry, err := loadImageCacheEntry(kubeClient, cacheConfig.ConfigMapName, image)
+		if err != nil {
+			log.Error(err, "Failed to load image cache", "ConfigMap", cacheConfig.ConfigMapName)
+		} else if entry != nil && time.Now().Before(entry.Expires) {
+			return entry, nil
+		}
+	}
+
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse image %q: %v", image, err)
+	}
+	kc, err := keychain()
+	if err != nil {
+		return nil, err
+	}
+	digest, err := c.getDigest(ref, kc)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get digest of image %q from the registry: %v", image, err)
+	}
+
+	entry := &imageCacheEntry{
+		Image:   image,
+		Digest:  digest,
+		Expires: time.Now().Add(cacheConfig.TTL.Duration),
+	}
+	c.mu.Lock()
+	cached, ok := c.digests[digest]
+	c.mu.Unlock()
+	if ok {
+		entry.Entrypoint, entry.Cmd = cached.Entrypoint, cached.Cmd
+	} else {
+		digestRef, err := name.NewDigest(fmt.Sprintf("%s@%s", ref.Context().Name(), digest), name.WeakValidation)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse digest %q of image %q: %v", digest, image, err)
+		}
+		cfg, err := c.getConfig(digestRef, kc)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get config of image %q from the registry: %v", image, err)
+		}
+		entry.Entrypoint, entry.Cmd = cfg.Entrypoint, cfg.Cmd
+	}
+
+	if kubeClient != nil && cacheConfig.ConfigMapName != "" {
+		if err := saveImageCacheEntry(kubeClient, cacheConfig.ConfigMapName, entry); err != nil {
+			log.Error(err, "Failed to save image cache", "ConfigMap", cacheConfig.ConfigMapName)
+		}
+	}
+	return entry, nil
+}
+
+func getRemoteImageDigest(ref name.Reference, keychain authn.Keychain) (string, error) {
+	desc, err := remote.Get(ref, remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return "", err
+	}
+	return desc.Digest.String(), nil
+}
+
+func getRemoteImageConfig(ref name.Reference, keychain authn.Keychain) (*crv1.Config, error) {
+	img, err := remote.Image(ref, remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	return &cfg.Config, nil
+}
+
+// getPodKeychain returns the registry credentials of the pod service account and image pull Secrets.
+func getPodKeychain(pod *v1.Pod, namespace string) func() (authn.Keychain, error) {
+	return func() (authn.Keychain, error) {
+		imagePullSecrets := []string{}
+		for _, s := range pod.Spec.ImagePullSecrets {
+			imagePullSecrets = append(imagePullSecrets, s.Name)
+		}
+		kc, err := k8schain.NewInCluster(k8schain.Options{
+			Namespace:          namespace,
+			ServiceAccountName: pod.Spec.ServiceAccountName,
+			ImagePullSecrets:   imagePullSecrets,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create k8schain: %v", err)
+		}
+		return kc, nil
+	}
+}
+
+// getImageCacheKey returns the ConfigMap key of the image.
+func getImageCacheKey(image string) string {
+	hash := sha256.Sum256([]byte(image))
+	return hex.EncodeToString(hash[:])
+}
+
+func loadImageCacheEntry(kubeClient client.Client, configMapName, image string) (*imageCacheEntry, error) {
+	configMap := &v1.ConfigMap{}
+	err := kubeClient.Get(context.TODO(), apitypes.NamespacedName{Name: configMapName, Namespace: consts.DefaultKatibNamespace}, configMap)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	data, ok := configMap.Data[getImageCacheKey(image)]
+	if !ok {
+		return nil, nil
+	}
+	entry := &imageCacheEntry{}
+	if err := json.Unmarshal([]byte(data), entry); err != nil {
+		return nil, err
+	}
+	// Hash collision
+	if entry.Image != image {
+		return nil, nil
+	}
+	return entry, nil
+}
+
+func saveImageCacheEntry(kubeClient client.Client, configMapName string, entry *imageCacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	configMap := &v1.ConfigMap{}
+	err = kubeClient.Get(context.TODO(), apitypes.NamespacedName{Name: configMapName, Namespace: consts.DefaultKatibNamespace}, configMap)
+	if apierrors.IsNotFound(err) {
+		configMap = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      configMapName,
+				Namespace: consts.DefaultKatibNamespace,
+			},
+			Data: map[string]string{
+				getImageCacheKey(entry.Image): string(data),
+			},
+		}
+		return kubeClient.Create(context.TODO(), configMap)
+	} else if err != nil {
+		return err
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	// Remove expired entries to keep ConfigMap small
+	for key, value := range configMap.Data {
+		cached := &imageCacheEntry{}
+		if err := json.Unmarshal([]byte(value), cached); err != nil || time.Now().After(cached.Expires) {
+			delete(configMap.Data, key)
+		}
+	}
+	configMap.Data[getImageCacheKey(entry.Image)] = string(data)
+	return kubeClient.Update(context.TODO(), configMap)
+}
+
+// getImageCommand returns ENTRYPOINT of the image and CMD if the container doesn't set args.
+// https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#notes
+func getImageCommand(entry *imageCacheEntry, args []string) []string {
+	command := append([]string{}, entry.Entrypoint...)
+	if len(args) != 0 {
+		return append(command, args...)
+	}
+	return append(command, entry.Cmd...)
+}
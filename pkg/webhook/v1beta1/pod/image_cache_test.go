@@ -0,0 +1,247 @@
+package pod
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	crv1 "github.com/google/go-containerregistry/pkg/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
+)
+
+func newTestImageConfigCache(digestDelay time.Duration, digestCalls, configCalls *int32) *imageConfigCache {
+	cache := newImageConfigCache()
+	cache.getDigest = func(ref name.Reference, keychain authn.Keychain) (string, error) {
+		atomic.AddInt32(digestCalls, 1)
+		time.Sleep(digestDelay)
+		if strings.Contains(ref.Name(), "unknown") {
+			return "", errors.New("image not found")
+		}
+		return "sha256:1111111111111111111111111111111111111111111111111111111111111111", nil
+	}
+	cache.getConfig = func(ref name.Reference, keychain authn.Keychain) (*crv1.Config, error) {
+		atomic.AddInt32(configCalls, 1)
+		return &crv1.Config{
+			Entrypoint: []string{"python", "main.py"},
+			Cmd:        []string{"--lr", "0.1"},
+		}, nil
+	}
+	return cache
+}
+
+func testKeychain() (authn.Keychain, error) {
+	return authn.DefaultKeychain, nil
+}
+
+func TestImageConfigCache(t *testing.T) {
+	cacheConfig := katibconfig.ImageCacheConfig{
+		TTL:     metav1.Duration{Duration: time.Hour},
+		Timeout: metav1.Duration{Duration: time.Second},
+	}
+	expiredConfig := katibconfig.ImageCacheConfig{
+		TTL:     metav1.Duration{Duration: -time.Second},
+		Timeout: metav1.Duration{Duration: time.Second},
+	}
+
+	tcs := []struct {
+		images              []string
+		cacheConfig         katibconfig.ImageCacheConfig
+		expectedDigestCalls int32
+		expectedConfigCalls int32
+		err                 bool
+		testDescription     string
+	}{
+		{
+			images:              []string{"training-image:v1", "training-image:v1"},
+			cacheConfig:         cacheConfig,
+			expectedDigestCalls: 1,
+			expectedConfigCalls: 1,
+			testDescription:     "Image is cached",
+		},
+		{
+			images:              []string{"training-image:v1", "training-image:v1"},
+			cacheConfig:         expiredConfig,
+			expectedDigestCalls: 2,
+			expectedConfigCalls: 1,
+			testDescription:     "Expired image is resolved again, config is cached by digest",
+		},
+		{
+			images:              []string{"training-image:v1", "training-image:latest"},
+			cacheConfig:         cacheConfig,
+			expectedDigestCalls: 2,
+			expectedConfigCalls: 1,
+			testDescription:     "Tags with the same digest share config",
+		},
+		{
+			images:              []string{"unknown-image:v1"},
+			cacheConfig:         cacheConfig,
+			expectedDigestCalls: 1,
+			err:                 true,
+			testDescription:     "Image is not found",
+		},
+	}
+
+	for _, tc := range tcs {
+		var digestCalls, configCalls int32
+		cache := newTestImageConfigCache(0, &digestCalls, &configCalls)
+		var err error
+		for _, image := range tc.images {
+			var entry *imageCacheEntry
+			entry, err = cache.get(image, testKeychain, tc.cacheConfig, nil)
+			if err == nil && !reflect.DeepEqual(entry.Entrypoint, []string{"python", "main.py"}) {
+				t.Errorf("Case: %v failed. Unexpected ENTRYPOINT: %v", tc.testDescription, entry.Entrypoint)
+			}
+		}
+		if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected error, got nil", tc.testDescription)
+		} else if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got error: %v", tc.testDescription, err)
+		}
+		if digestCalls != tc.expectedDigestCalls || configCalls != tc.expectedConfigCalls {
+			t.Errorf("Case: %v failed. Expected %v digest and %v config lookups, got %v and %v",
+				tc.testDescription, tc.expectedDigestCalls, tc.expectedConfigCalls, digestCalls, configCalls)
+		}
+	}
+}
+
+func TestImageConfigCacheConcurrentLookups(t *testing.T) {
+	var digestCalls, configCalls int32
+	cache := newTestImageConfigCache(50*time.Millisecond, &digestCalls, &configCalls)
+	cacheConfig := katibconfig.ImageCacheConfig{
+		TTL:     metav1.Duration{Duration: time.Hour},
+		Timeout: metav1.Duration{Duration: time.Second},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.get("training-image:v1", testKeychain, cacheConfig, nil); err != nil {
+				t.Errorf("Expected nil, got error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if digestCalls != 1 || configCalls != 1 {
+		t.Errorf("Expected one registry lookup for concurrent requests, got %v digest and %v config lookups", digestCalls, configCalls)
+	}
+}
+
+func TestImageConfigCacheTimeout(t *testing.T) {
+	var digestCalls, configCalls int32
+	cache := newTestImageConfigCache(100*time.Millisecond, &digestCalls, &configCalls)
+	cacheConfig := katibconfig.ImageCacheConfig{
+		TTL:     metav1.Duration{Duration: time.Hour},
+		Timeout: metav1.Duration{Duration: 10 * time.Millisecond},
+	}
+
+	_, err := cache.get("training-image:v1", testKeychain, cacheConfig, nil)
+	if err == nil || !strings.Contains(err.Error(), "Timed out") {
+		t.Errorf("Expected timeout error, got: %v", err)
+	}
+
+	// Lookup is finished in background and the result is cached
+	time.Sleep(200 * time.Millisecond)
+	if _, err := cache.get("training-image:v1", testKeychain, cacheConfig, nil); err != nil {
+		t.Errorf("Expected cached image after timeout, got error: %v", err)
+	}
+	if digestCalls != 1 {
+		t.Errorf("Expected one digest lookup, got %v", digestCalls)
+	}
+}
+
+func TestImageConfigCachePruneExpired(t *testing.T) {
+	var digestCalls, configCalls int32
+	cache := newTestImageConfigCache(0, &digestCalls, &configCalls)
+	expiredConfig := katibconfig.ImageCacheConfig{
+		TTL:     metav1.Duration{Duration: -time.Second},
+		Timeout: metav1.Duration{Duration: time.Second},
+	}
+
+	for _, image := range []string{"training-image:v1", "training-image:v2"} {
+		if _, err := cache.get(image, testKeychain, expiredConfig, nil); err != nil {
+			t.Fatalf("Expected nil, got error: %v", err)
+		}
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if _, ok := cache.entries["training-image:v1"]; ok || len(cache.entries) != 1 {
+		t.Errorf("Expected expired image to be removed from the cache, got %v", cache.entries)
+	}
+}
+
+func TestGetImageCommand(t *testing.T) {
+	entry := &imageCacheEntry{
+		Entrypoint: []string{"python", "main.py"},
+		Cmd:        []string{"--lr", "0.1"},
+	}
+
+	tcs := []struct {
+		args            []string
+		expectedCommand []string
+		testDescription string
+	}{
+		{
+			expectedCommand: []string{"python", "main.py", "--lr", "0.1"},
+			testDescription: "Container args are not set",
+		},
+		{
+			args:            []string{"--lr", "0.01"},
+			expectedCommand: []string{"python", "main.py", "--lr", "0.01"},
+			testDescription: "Container args replace image CMD",
+		},
+	}
+
+	for _, tc := range tcs {
+		command := getImageCommand(entry, tc.args)
+		if !reflect.DeepEqual(command, tc.expectedCommand) {
+			t.Errorf("Case: %v failed. Expected command: %v, got: %v", tc.testDescription, tc.expectedCommand, command)
+		}
+	}
+}
+
+func TestResolveImageCommandError(t *testing.T) {
+	var digestCalls, configCalls int32
+	s := &sidecarInjector{imageCache: newTestImageConfigCache(0, &digestCalls, &configCalls)}
+	s.imageCache.getDigest = func(ref name.Reference, keychain authn.Keychain) (string, error) {
+		return "", errors.New("image not found")
+	}
+	trial := &trialsv1beta1.Trial{
+		Spec: trialsv1beta1.TrialSpec{
+			PrimaryContainerName: "training",
+		},
+	}
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "training",
+					Image: "training-image:v1",
+				},
+			},
+		},
+	}
+	cacheConfig := katibconfig.ImageCacheConfig{
+		TTL:     metav1.Duration{Duration: time.Hour},
+		Timeout: metav1.Duration{Duration: time.Second},
+	}
+
+	s.resolveImageCommand(pod, "test-namespace", "", trial, cacheConfig)
+	if len(pod.Spec.Containers[0].Command) != 0 {
+		t.Errorf("Expected command not to be set, got %v", pod.Spec.Containers[0].Command)
+	}
+	if pod.Annotations[ImageCommandErrorAnnotation] == "" {
+		t.Errorf("Expected error in pod annotation %v, got %v", ImageCommandErrorAnnotation, pod.Annotations)
+	}
+}
@@ -37,6 +37,8 @@ import (
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	jobv1beta1 "github.com/kubeflow/katib/pkg/job/v1beta1"
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
@@ -50,6 +52,9 @@ type sidecarInjector struct {
 	// injectSecurityContext indicates if we should inject the security
 	// context into the metrics collector sidecar.
 	injectSecurityContext bool
+
+	// imageCache caches ENTRYPOINT and CMD of the training images.
+	imageCache *imageConfigCache
 }
 
 var _ admission.Handler = &sidecarInjector{}
@@ -80,7 +85,9 @@ func (s *sidecarInjector) Handle(ctx context.Context, req types.Request) types.R
 		return admission.ErrorResponse(http.StatusBadRequest, err)
 	}
 
-	return admission.PatchResponse(pod, mutatedPod)
+	resp := admission.PatchResponse(pod, mutatedPod)
+	resp.Patches = append(resp.Patches, kubeletLogsSubPathPatch(mutatedPod)...)
+	return resp
 }
 
 var _ inject.Client = &sidecarInjector{}
@@ -102,6 +109,7 @@ func NewSidecarInjector(c client.Client) *sidecarInjector {
 	return &sidecarInjector{
 		injectSecurityContext: viper.GetBool(consts.ConfigInjectSecurityContext),
 		client:                c,
+		imageCache:            newImageConfigCache(),
 	}
 }
 
@@ -159,22 +167,60 @@ func (s *sidecarInjector) Mutate(pod *v1.Pod, namespace string) (*v1.Pod, error)
 		return nil, err
 	}
 
+	// Trial controller finds the primary pod by this label to store the failed Trial logs
+	if mutatedPod.Labels == nil {
+		mutatedPod.Labels = map[string]string{}
+	}
+	mutatedPod.Labels[consts.LabelTrialName] = trial.Name
+
 	injectContainer, err := s.getMetricsCollectorContainer(trial, pod)
 	if err != nil {
 		return nil, err
 	}
+	// Mount gRPC client TLS Secret to Katib metrics collector to report metrics to DB Manager over TLS
+	if trial.Spec.MetricsCollector.Collector.Kind != common.CustomCollector {
+		grpcTLSConfigData, err := katibconfig.GetGRPCTLSConfigData(s.client)
+		if err != nil {
+			return nil, err
+		}
+		util.AppendGRPCTLSClientVolume(&mutatedPod.Spec, injectContainer, grpcTLSConfigData)
+	}
+	// Metrics collector reads the primary container logs without changing the primary container
+	stdOutMode, err := s.getStdOutMode(trial.Spec.MetricsCollector)
+	if err != nil {
+		return nil, err
+	}
+	if stdOutMode != mccommon.StdOutModeLauncher {
+		if err = mutateContainerLogsSource(mutatedPod, injectContainer, jobKind, trial, stdOutMode); err != nil {
+			return nil, err
+		}
+	}
+	mountPath, pathKind := getMountPath(trial.Spec.MetricsCollector)
+	// Training container writes the artifacts file to the metrics volume which is shared with metrics collector
+	if needArtifactsFile(trial.Spec.MetricsCollector) && stdOutMode == mccommon.StdOutModeLauncher {
+		if err = mutateArtifactsFile(mutatedPod, injectContainer, jobKind, mountPath, trial); err != nil {
+			return nil, err
+		}
+	}
 	mutatedPod.Spec.Containers = append(mutatedPod.Spec.Containers, *injectContainer)
 
 	mutatedPod.Spec.ShareProcessNamespace = pointer.BoolPtr(true)
 
-	mountPath, pathKind := getMountPath(trial.Spec.MetricsCollector)
-	if mountPath != "" {
-		if err = mutateVolume(mutatedPod, jobKind, mountPath, injectContainer.Name, trial.Spec.PrimaryContainerName, pathKind); err != nil {
+	if mountPath != "" && stdOutMode == mccommon.StdOutModeLauncher {
+		if err = mutateVolume(mutatedPod, jobKind, mountPath, injectContainer.Name, trial.Spec.PrimaryContainerName,
+			jobv1beta1.MainContainerName(trial.Spec.RunSpec), pathKind); err != nil {
 			return nil, err
 		}
 	}
-	if needWrapWorkerContainer(trial.Spec.MetricsCollector) {
-		if err = wrapWorkerContainer(mutatedPod, namespace, jobKind, mountPath, pathKind, trial); err != nil {
+	if needWrapWorkerContainer(trial.Spec.MetricsCollector) && stdOutMode == mccommon.StdOutModeLauncher {
+		launcherConfigData, err := katibconfig.GetLauncherConfigData(s.client)
+		if err != nil {
+			return nil, err
+		}
+		if launcherConfigData.ImageCache != nil {
+			s.resolveImageCommand(mutatedPod, namespace, jobKind, trial, *launcherConfigData.ImageCache)
+		}
+		if err = wrapWorkerContainer(mutatedPod, jobKind, mountPath, pathKind, trial, launcherConfigData); err != nil {
 			return nil, err
 		}
 	}
@@ -202,7 +248,7 @@ func (s *sidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Tria
 	if err != nil {
 		return nil, err
 	}
-	args := getMetricsCollectorArgs(trial.Name, metricName, mc)
+	args := getMetricsCollectorArgs(trial, metricName, mc)
 	sidecarContainerName := getSidecarContainerName(trial.Spec.MetricsCollector.Collector.Kind)
 
 	injectContainer := v1.Container{
@@ -224,6 +270,43 @@ func (s *sidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Tria
 	return &injectContainer, nil
 }
 
+// resolveImageCommand sets ENTRYPOINT and CMD of the image from the cache to the primary container
+// if the container doesn't set the command.
+// If the image config is not available, the launcher gets it from the registry inside the pod and
+// the error is set in the pod annotation, so it can be found without the webhook logs.
+func (s *sidecarInjector) resolveImageCommand(pod *v1.Pod, namespace, jobKind string, trial *trialsv1beta1.Trial, cacheConfig katibconfig.ImageCacheConfig) {
+	index, err := getPrimaryContainerIndex(pod, jobKind, trial)
+	if err != nil || len(pod.Spec.Containers[index].Command) != 0 {
+		return
+	}
+	c := &pod.Spec.Containers[index]
+	entry, err := s.imageCache.get(c.Image, getPodKeychain(pod, namespace), cacheConfig, s.client)
+	if err != nil {
+		log.Error(err, "Image command is resolved by the launcher in the pod", "Trial", trial.Name)
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[ImageCommandErrorAnnotation] = err.Error()
+		return
+	}
+	if command := getImageCommand(entry, c.Args); len(command) != 0 {
+		c.Command = command
+		c.Args = nil
+	}
+}
+
+// getStdOutMode returns the way how StdOut metrics collector gets the training container output.
+func (s *sidecarInjector) getStdOutMode(mc common.MetricsCollectorSpec) (mccommon.StdOutMode, error) {
+	if mc.Collector.Kind != common.StdOutCollector {
+		return mccommon.StdOutModeLauncher, nil
+	}
+	metricsCollectorConfigData, err := katibconfig.GetMetricsCollectorConfigData(mc.Collector.Kind, s.client)
+	if err != nil {
+		return "", err
+	}
+	return metricsCollectorConfigData.StdOutMode, nil
+}
+
 func (s *sidecarInjector) getKatibJob(object *unstructured.Unstructured, namespace string) (string, string, error) {
 	owners := object.GetOwnerReferences()
 	// jobKind and jobName points to the object kind and name that Trial is created
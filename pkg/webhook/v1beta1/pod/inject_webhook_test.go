@@ -9,6 +9,7 @@ import (
 	"time"
 
 	tfv1 "github.com/kubeflow/tf-operator/pkg/apis/tensorflow/v1"
+	"github.com/mattbaird/jsonpatch"
 	"github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -16,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -24,12 +26,35 @@ import (
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
 func TestWrapWorkerContainer(t *testing.T) {
+	launcherConfig := katibconfig.LauncherConfig{
+		Image:           "katib-launcher-image",
+		ImagePullPolicy: v1.PullIfNotPresent,
+	}
+	launcherPath := filepath.Join(LauncherMountPath, LauncherBinaryName)
+	launcherMount := v1.VolumeMount{
+		Name:      LauncherVolume,
+		MountPath: LauncherMountPath,
+	}
+	launcherVolume := v1.Volume{
+		Name: LauncherVolume,
+		VolumeSource: v1.VolumeSource{
+			EmptyDir: &v1.EmptyDirVolumeSource{},
+		},
+	}
+	launcherContainer := v1.Container{
+		Name:            LauncherContainerName,
+		Image:           "katib-launcher-image",
+		Args:            []string{"-copy-to", launcherPath},
+		ImagePullPolicy: v1.PullIfNotPresent,
+		VolumeMounts:    []v1.VolumeMount{launcherMount},
+	}
+
 	testCases := []struct {
 		Pod         *v1.Pod
-		Namespace   string
 		JobKind     string
 		MetricsFile string
 		PathKind    common.FileSystemKind
@@ -43,17 +68,15 @@ func TestWrapWorkerContainer(t *testing.T) {
 				Spec: v1.PodSpec{
 					Containers: []v1.Container{
 						{
-							Name: "tensorflow",
-							Command: []string{
-								"python main.py",
-							},
+							Name:    "tensorflow",
+							Command: []string{"python", "main.py"},
+							Args:    []string{"--message", "hello \"katib\" world"},
 						},
 					},
 				},
 			},
-			Namespace:   "nohere",
 			JobKind:     "TFJob",
-			MetricsFile: "testfile",
+			MetricsFile: "/var/log/katib/metrics.log",
 			PathKind:    common.FileKind,
 			Trial: &trialsv1beta1.Trial{
 				Spec: trialsv1beta1.TrialSpec{
@@ -66,21 +89,25 @@ func TestWrapWorkerContainer(t *testing.T) {
 			},
 			Expected: &v1.Pod{
 				Spec: v1.PodSpec{
+					InitContainers: []v1.Container{launcherContainer},
 					Containers: []v1.Container{
 						{
-							Name: "tensorflow",
-							Command: []string{
-								"sh", "-c",
-							},
+							Name:    "tensorflow",
+							Command: []string{launcherPath},
 							Args: []string{
-								"python main.py 1>testfile 2>&1 && echo completed > $$$$.pid",
+								"-metrics-file", "/var/log/katib/metrics.log",
+								"-completed-dir", "/var/log/katib",
+								"--",
+								"python", "main.py", "--message", "hello \"katib\" world",
 							},
+							VolumeMounts: []v1.VolumeMount{launcherMount},
 						},
 					},
+					Volumes: []v1.Volume{launcherVolume},
 				},
 			},
 			Err:  false,
-			Name: "tensorflow container without sh -c",
+			Name: "tensorflow container with arguments with spaces and quotes",
 		},
 		{
 			Pod: &v1.Pod{
@@ -96,9 +123,8 @@ func TestWrapWorkerContainer(t *testing.T) {
 					},
 				},
 			},
-			Namespace:   "nohere",
 			JobKind:     "TFJob",
-			MetricsFile: "testfile",
+			MetricsFile: "/var/log/katib/metrics.log",
 			PathKind:    common.FileKind,
 			Trial: &trialsv1beta1.Trial{
 				Spec: trialsv1beta1.TrialSpec{
@@ -111,17 +137,21 @@ func TestWrapWorkerContainer(t *testing.T) {
 			},
 			Expected: &v1.Pod{
 				Spec: v1.PodSpec{
+					InitContainers: []v1.Container{launcherContainer},
 					Containers: []v1.Container{
 						{
-							Name: "tensorflow",
-							Command: []string{
-								"sh", "-c",
-							},
+							Name:    "tensorflow",
+							Command: []string{launcherPath},
 							Args: []string{
-								"python main.py 1>testfile 2>&1 && echo completed > $$$$.pid",
+								"-metrics-file", "/var/log/katib/metrics.log",
+								"-completed-dir", "/var/log/katib",
+								"--",
+								"sh", "-c", "python main.py",
 							},
+							VolumeMounts: []v1.VolumeMount{launcherMount},
 						},
 					},
+					Volumes: []v1.Volume{launcherVolume},
 				},
 			},
 			Err:  false,
@@ -134,57 +164,163 @@ func TestWrapWorkerContainer(t *testing.T) {
 						{
 							Name: "primary-container",
 							Command: []string{
-								"sh", "-c",
-								"python main.py",
+								"python", "main.py",
 							},
 						},
 						{
 							Name: "not-primary-container",
 							Command: []string{
-								"sh", "-c",
-								"python main.py",
+								"python", "main.py",
 							},
 						},
 					},
 				},
 			},
-			MetricsFile: "testfile",
-			PathKind:    common.FileKind,
+			MetricsFile: "/log",
+			PathKind:    common.DirectoryKind,
 			Trial: &trialsv1beta1.Trial{
 				Spec: trialsv1beta1.TrialSpec{
 					PrimaryContainerName: "primary-container",
 					MetricsCollector: common.MetricsCollectorSpec{
 						Collector: &common.CollectorSpec{
-							Kind: common.StdOutCollector,
+							Kind: common.TfEventCollector,
 						},
 					},
 				},
 			},
 			Expected: &v1.Pod{
 				Spec: v1.PodSpec{
+					InitContainers: []v1.Container{launcherContainer},
 					Containers: []v1.Container{
 						{
-							Name: "primary-container",
-							Command: []string{
-								"sh", "-c",
-							},
+							Name:    "primary-container",
+							Command: []string{launcherPath},
 							Args: []string{
-								"python main.py 1>testfile 2>&1 && echo completed > $$$$.pid",
+								"-completed-dir", "/log",
+								"--",
+								"python", "main.py",
 							},
+							VolumeMounts: []v1.VolumeMount{launcherMount},
 						},
 						{
 							Name: "not-primary-container",
 							Command: []string{
-								"sh", "-c",
-								"python main.py",
+								"python", "main.py",
 							},
 						},
 					},
+					Volumes: []v1.Volume{launcherVolume},
 				},
 			},
 			Err:  false,
 			Name: "Primary container name is set for training pod",
 		},
+		{
+			Pod: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  "primary-container",
+							Image: "private-registry/training-image",
+							Args:  []string{"--lr", "0.01"},
+						},
+					},
+					ImagePullSecrets: []v1.LocalObjectReference{
+						{
+							Name: "registry-secret",
+						},
+						{
+							Name: "sa-registry-secret",
+						},
+					},
+				},
+			},
+			MetricsFile: "/var/log/katib/metrics.log",
+			PathKind:    common.FileKind,
+			Trial: &trialsv1beta1.Trial{
+				Spec: trialsv1beta1.TrialSpec{
+					PrimaryContainerName: "primary-container",
+					MetricsCollector: common.MetricsCollectorSpec{
+						Collector: &common.CollectorSpec{
+							Kind: common.FileCollector,
+						},
+					},
+				},
+			},
+			Expected: &v1.Pod{
+				Spec: v1.PodSpec{
+					InitContainers: []v1.Container{launcherContainer},
+					Containers: []v1.Container{
+						{
+							Name:    "primary-container",
+							Image:   "private-registry/training-image",
+							Command: []string{launcherPath},
+							Args: []string{
+								"-completed-dir", "/var/log/katib",
+								"-image", "private-registry/training-image",
+								"-docker-config", LauncherDockerConfigMountPath + "/0," + LauncherDockerConfigMountPath + "/1",
+								"--",
+								"--lr", "0.01",
+							},
+							VolumeMounts: []v1.VolumeMount{
+								launcherMount,
+								{
+									Name:      LauncherDockerConfigVolume,
+									MountPath: LauncherDockerConfigMountPath,
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+					ImagePullSecrets: []v1.LocalObjectReference{
+						{
+							Name: "registry-secret",
+						},
+						{
+							Name: "sa-registry-secret",
+						},
+					},
+					Volumes: []v1.Volume{
+						launcherVolume,
+						{
+							Name: LauncherDockerConfigVolume,
+							VolumeSource: v1.VolumeSource{
+								Projected: &v1.ProjectedVolumeSource{
+									Sources: []v1.VolumeProjection{
+										{
+											Secret: &v1.SecretProjection{
+												LocalObjectReference: v1.LocalObjectReference{Name: "registry-secret"},
+												Items: []v1.KeyToPath{
+													{
+														Key:  v1.DockerConfigJsonKey,
+														Path: "0/config.json",
+													},
+												},
+												Optional: pointer.BoolPtr(true),
+											},
+										},
+										{
+											Secret: &v1.SecretProjection{
+												LocalObjectReference: v1.LocalObjectReference{Name: "sa-registry-secret"},
+												Items: []v1.KeyToPath{
+													{
+														Key:  v1.DockerConfigJsonKey,
+														Path: "1/config.json",
+													},
+												},
+												Optional: pointer.BoolPtr(true),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Err:  false,
+			Name: "Primary container without command resolves image entrypoint in the pod",
+		},
 		{
 			Pod: &v1.Pod{
 				Spec: v1.PodSpec{
@@ -207,15 +343,15 @@ func TestWrapWorkerContainer(t *testing.T) {
 	}
 
 	for _, c := range testCases {
-		err := wrapWorkerContainer(c.Pod, c.Namespace, c.JobKind, c.MetricsFile, c.PathKind, c.Trial)
+		err := wrapWorkerContainer(c.Pod, c.JobKind, c.MetricsFile, c.PathKind, c.Trial, launcherConfig)
 		if c.Err && err == nil {
 			t.Errorf("Case %s failed. Expected error, got nil", c.Name)
 		} else if !c.Err {
 			if err != nil {
 				t.Errorf("Case %s failed. Expected nil, got error: %v", c.Name, err)
-			} else if !equality.Semantic.DeepEqual(c.Pod.Spec.Containers, c.Expected.Spec.Containers) {
+			} else if !equality.Semantic.DeepEqual(c.Pod.Spec, c.Expected.Spec) {
 				t.Errorf("Case %s failed. Expected pod: %v, got: %v",
-					c.Name, c.Expected.Spec.Containers, c.Pod.Spec.Containers)
+					c.Name, c.Expected.Spec, c.Pod.Spec)
 			}
 		}
 
@@ -223,19 +359,25 @@ func TestWrapWorkerContainer(t *testing.T) {
 }
 
 func TestGetMetricsCollectorArgs(t *testing.T) {
-	testTrialName := "test-trial"
+	testTrial := &trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-trial",
+			Namespace: "test-namespace",
+			UID:       "test-uid",
+		},
+	}
 	testMetricName := "accuracy"
 	katibDBAddress := "katib-db-manager.kubeflow:6789"
 	testPath := "/test/path"
 	testCases := []struct {
-		TrialName    string
+		Trial        *trialsv1beta1.Trial
 		MetricName   string
 		MCSpec       common.MetricsCollectorSpec
 		ExpectedArgs []string
 		Name         string
 	}{
 		{
-			TrialName:  testTrialName,
+			Trial:      testTrial,
 			MetricName: testMetricName,
 			MCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
@@ -243,7 +385,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				},
 			},
 			ExpectedArgs: []string{
-				"-t", testTrialName,
+				"-t", testTrial.Name,
+				"-n", testTrial.Namespace,
+				"-u", string(testTrial.UID),
 				"-m", testMetricName,
 				"-s", katibDBAddress,
 				"-path", common.DefaultFilePath,
@@ -251,7 +395,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			Name: "StdOut MC",
 		},
 		{
-			TrialName:  testTrialName,
+			Trial:      testTrial,
 			MetricName: testMetricName,
 			MCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
@@ -270,7 +414,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				},
 			},
 			ExpectedArgs: []string{
-				"-t", testTrialName,
+				"-t", testTrial.Name,
+				"-n", testTrial.Namespace,
+				"-u", string(testTrial.UID),
 				"-m", testMetricName,
 				"-s", katibDBAddress,
 				"-path", testPath,
@@ -279,7 +425,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			Name: "File MC with Filter",
 		},
 		{
-			TrialName:  testTrialName,
+			Trial:      testTrial,
 			MetricName: testMetricName,
 			MCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
@@ -292,7 +438,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				},
 			},
 			ExpectedArgs: []string{
-				"-t", testTrialName,
+				"-t", testTrial.Name,
+				"-n", testTrial.Namespace,
+				"-u", string(testTrial.UID),
 				"-m", testMetricName,
 				"-s", katibDBAddress,
 				"-path", testPath,
@@ -300,7 +448,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			Name: "Tf Event MC",
 		},
 		{
-			TrialName:  testTrialName,
+			Trial:      testTrial,
 			MetricName: testMetricName,
 			MCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
@@ -308,14 +456,16 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				},
 			},
 			ExpectedArgs: []string{
-				"-t", testTrialName,
+				"-t", testTrial.Name,
+				"-n", testTrial.Namespace,
+				"-u", string(testTrial.UID),
 				"-m", testMetricName,
 				"-s", katibDBAddress,
 			},
 			Name: "Custom MC without Path",
 		},
 		{
-			TrialName:  testTrialName,
+			Trial:      testTrial,
 			MetricName: testMetricName,
 			MCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
@@ -328,7 +478,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				},
 			},
 			ExpectedArgs: []string{
-				"-t", testTrialName,
+				"-t", testTrial.Name,
+				"-n", testTrial.Namespace,
+				"-u", string(testTrial.UID),
 				"-m", testMetricName,
 				"-s", katibDBAddress,
 				"-path", testPath,
@@ -336,7 +488,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			Name: "Custom MC with Path",
 		},
 		{
-			TrialName:  testTrialName,
+			Trial:      testTrial,
 			MetricName: testMetricName,
 			MCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
@@ -344,7 +496,9 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				},
 			},
 			ExpectedArgs: []string{
-				"-t", testTrialName,
+				"-t", testTrial.Name,
+				"-n", testTrial.Namespace,
+				"-u", string(testTrial.UID),
 				"-m", testMetricName,
 				"-s", katibDBAddress,
 			},
@@ -353,13 +507,172 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		args := getMetricsCollectorArgs(tc.TrialName, tc.MetricName, tc.MCSpec)
+		args := getMetricsCollectorArgs(tc.Trial, tc.MetricName, tc.MCSpec)
 		if !reflect.DeepEqual(tc.ExpectedArgs, args) {
 			t.Errorf("Case %v failed. ExpectedArgs: %v, got %v", tc.Name, tc.ExpectedArgs, args)
 		}
 	}
 }
 
+func TestMutateContainerLogsSource(t *testing.T) {
+	trial := &trialsv1beta1.Trial{
+		Spec: trialsv1beta1.TrialSpec{
+			PrimaryContainerName: "training",
+		},
+	}
+	podEnv := []v1.EnvVar{
+		{
+			Name:      mccommon.PodNameEnvName,
+			ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"}},
+		},
+		{
+			Name:      mccommon.PodNamespaceEnvName,
+			ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.namespace"}},
+		},
+		{
+			Name:      mccommon.PodUIDEnvName,
+			ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.uid"}},
+		},
+	}
+
+	tcs := []struct {
+		stdOutMode      mccommon.StdOutMode
+		expectedSidecar v1.Container
+		expectedVolumes []v1.Volume
+		testDescription string
+	}{
+		{
+			stdOutMode: mccommon.StdOutModePodLogs,
+			expectedSidecar: v1.Container{
+				Name: "metrics-logger-and-collector",
+				Args: []string{"-t", "test-trial", "-stdout-mode", "PodLogs", "-container", "training"},
+				Env:  podEnv,
+			},
+			testDescription: "Metrics collector streams pod logs",
+		},
+		{
+			stdOutMode: mccommon.StdOutModeKubeletLogs,
+			expectedSidecar: v1.Container{
+				Name: "metrics-logger-and-collector",
+				Args: []string{"-t", "test-trial", "-stdout-mode", "KubeletLogs", "-container", "training"},
+				Env:  podEnv,
+				VolumeMounts: []v1.VolumeMount{
+					{
+						Name:      KubeletLogsVolume,
+						MountPath: mccommon.KubeletPodLogDir,
+						ReadOnly:  true,
+					},
+				},
+			},
+			expectedVolumes: []v1.Volume{
+				{
+					Name: KubeletLogsVolume,
+					VolumeSource: v1.VolumeSource{
+						HostPath: &v1.HostPathVolumeSource{
+							Path: mccommon.DefaultKubeletLogDir,
+						},
+					},
+				},
+			},
+			testDescription: "Metrics collector reads kubelet log files",
+		},
+	}
+
+	for _, tc := range tcs {
+		primaryContainer := v1.Container{
+			Name:    "training",
+			Command: []string{"python", "main.py"},
+		}
+		pod := &v1.Pod{
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{primaryContainer},
+			},
+		}
+		sidecar := &v1.Container{
+			Name: "metrics-logger-and-collector",
+			Args: []string{"-t", "test-trial"},
+		}
+		if err := mutateContainerLogsSource(pod, sidecar, "", trial, tc.stdOutMode); err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got error: %v", tc.testDescription, err)
+		} else if !equality.Semantic.DeepEqual(*sidecar, tc.expectedSidecar) {
+			t.Errorf("Case: %v failed. Expected sidecar: %v, got: %v", tc.testDescription, tc.expectedSidecar, *sidecar)
+		} else if !equality.Semantic.DeepEqual(pod.Spec.Volumes, tc.expectedVolumes) {
+			t.Errorf("Case: %v failed. Expected volumes: %v, got: %v", tc.testDescription, tc.expectedVolumes, pod.Spec.Volumes)
+		} else if !equality.Semantic.DeepEqual(pod.Spec.Containers[0], primaryContainer) {
+			t.Errorf("Case: %v failed. Primary container must not be changed, got: %v", tc.testDescription, pod.Spec.Containers[0])
+		}
+	}
+}
+
+func TestKubeletLogsSubPathPatch(t *testing.T) {
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "training"},
+				{
+					Name: "metrics-logger-and-collector",
+					VolumeMounts: []v1.VolumeMount{
+						{Name: "metrics-volume"},
+						{Name: KubeletLogsVolume},
+					},
+				},
+			},
+		},
+	}
+	expected := []jsonpatch.JsonPatchOperation{
+		{
+			Operation: "add",
+			Path:      "/spec/containers/1/volumeMounts/1/subPathExpr",
+			Value:     "$(KATIB_POD_NAMESPACE)_$(KATIB_POD_NAME)_$(KATIB_POD_UID)",
+		},
+	}
+	if patch := kubeletLogsSubPathPatch(pod); !reflect.DeepEqual(patch, expected) {
+		t.Errorf("Expected patch %v, got %v", expected, patch)
+	}
+
+	pod.Spec.Containers[1].VolumeMounts = pod.Spec.Containers[1].VolumeMounts[:1]
+	if patch := kubeletLogsSubPathPatch(pod); len(patch) != 0 {
+		t.Errorf("Expected no patch without kubelet logs volume, got %v", patch)
+	}
+}
+
+func TestMutateArtifactsFile(t *testing.T) {
+	trial := &trialsv1beta1.Trial{
+		Spec: trialsv1beta1.TrialSpec{
+			PrimaryContainerName: "training",
+		},
+	}
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:    "training",
+					Command: []string{"python", "main.py"},
+				},
+			},
+		},
+	}
+	sidecar := &v1.Container{
+		Name: "metrics-logger-and-collector",
+		Args: []string{"-t", "test-trial"},
+	}
+	expectedArgs := []string{"-t", "test-trial", "-artifacts-path", "/var/log/katib/katib-artifacts.json"}
+	expectedEnv := []v1.EnvVar{
+		{
+			Name:  mccommon.ArtifactsFileEnvName,
+			Value: "/var/log/katib/katib-artifacts.json",
+		},
+	}
+
+	if err := mutateArtifactsFile(pod, sidecar, "", "/var/log/katib/metrics.log", trial); err != nil {
+		t.Errorf("Expected nil, got error: %v", err)
+	} else if !equality.Semantic.DeepEqual(sidecar.Args, expectedArgs) {
+		t.Errorf("Expected sidecar args: %v, got: %v", expectedArgs, sidecar.Args)
+	} else if !equality.Semantic.DeepEqual(pod.Spec.Containers[0].Env, expectedEnv) {
+		t.Errorf("Expected primary container env: %v, got: %v", expectedEnv, pod.Spec.Containers[0].Env)
+	}
+}
+
 func TestNeedWrapWorkerContainer(t *testing.T) {
 	testCases := []struct {
 		MCSpec   common.MetricsCollectorSpec
@@ -465,6 +778,7 @@ func TestMutateVolume(t *testing.T) {
 		tc.MountPath,
 		tc.SidecarContainerName,
 		tc.PrimaryContainerName,
+		"",
 		tc.PathKind)
 	if err != nil {
 		t.Errorf("mutateVolume failed: %v", err)
@@ -473,6 +787,62 @@ func TestMutateVolume(t *testing.T) {
 	}
 }
 
+func TestGetPrimaryContainerIndex(t *testing.T) {
+	newMPIJobTrial := func(mainContainer string) *trialsv1beta1.Trial {
+		mpiJob := `apiVersion: kubeflow.org/v1
+kind: MPIJob
+spec:
+  mpiReplicaSpecs: {}
+`
+		if mainContainer != "" {
+			mpiJob += "  mainContainer: " + mainContainer + "\n"
+		}
+		runSpec, err := util.ConvertStringToUnstructured(mpiJob)
+		if err != nil {
+			t.Fatalf("ConvertStringToUnstructured() returns error: %v", err)
+		}
+		return &trialsv1beta1.Trial{
+			Spec: trialsv1beta1.TrialSpec{
+				RunSpec: runSpec,
+			},
+		}
+	}
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "sidecar"},
+				{Name: "mpi-launcher"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		trial           *trialsv1beta1.Trial
+		expectedIndex   int
+		testDescription string
+	}{
+		{
+			trial:           newMPIJobTrial("mpi-launcher"),
+			expectedIndex:   1,
+			testDescription: "MPIJob with main container",
+		},
+		{
+			trial:           newMPIJobTrial(""),
+			expectedIndex:   0,
+			testDescription: "MPIJob without main container",
+		},
+	}
+
+	for _, tc := range testCases {
+		index, err := getPrimaryContainerIndex(pod, consts.JobKindMPI, tc.trial)
+		if err != nil {
+			t.Errorf("Case: %v. getPrimaryContainerIndex() returns error: %v", tc.testDescription, err)
+		} else if index != tc.expectedIndex {
+			t.Errorf("Case: %v. Expected index %v, got %v", tc.testDescription, tc.expectedIndex, index)
+		}
+	}
+}
+
 func TestGetSidecarContainerName(t *testing.T) {
 	testCases := []struct {
 		CollectorKind         common.CollectorKind
@@ -759,6 +1129,10 @@ func TestIsMasterRole(t *testing.T) {
 	masterRoleLabel[consts.JobRole] = MasterRole
 	invalidLabel := make(map[string]string)
 	invalidLabel["invalid-label"] = "invalid"
+	mpiLauncherLabel := make(map[string]string)
+	mpiLauncherLabel[consts.JobRoleMPI] = consts.JobRoleMPILauncher
+	mpiWorkerLabel := make(map[string]string)
+	mpiWorkerLabel[consts.JobRoleMPI] = "worker"
 	testCases := []struct {
 		Pod      v1.Pod
 		JobKind  string
@@ -790,6 +1164,36 @@ func TestIsMasterRole(t *testing.T) {
 			IsMaster: false,
 			Name:     "Pytorch Pod with invalid label",
 		},
+		{
+			Pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: masterRoleLabel,
+				},
+			},
+			JobKind:  "XGBoostJob",
+			IsMaster: true,
+			Name:     "XGBoost Master Pod",
+		},
+		{
+			Pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: mpiLauncherLabel,
+				},
+			},
+			JobKind:  "MPIJob",
+			IsMaster: true,
+			Name:     "MPI Launcher Pod",
+		},
+		{
+			Pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: mpiWorkerLabel,
+				},
+			},
+			JobKind:  "MPIJob",
+			IsMaster: false,
+			Name:     "MPI Worker Pod",
+		},
 	}
 
 	for _, tc := range testCases {
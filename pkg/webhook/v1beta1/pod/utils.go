@@ -20,20 +20,19 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 
-	"github.com/google/go-containerregistry/pkg/authn"
-	"github.com/google/go-containerregistry/pkg/authn/k8schain"
-	"github.com/google/go-containerregistry/pkg/name"
-	crv1 "github.com/google/go-containerregistry/pkg/v1"
-	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/mattbaird/jsonpatch"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
 
 	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
 	jobv1beta1 "github.com/kubeflow/katib/pkg/job/v1beta1"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
 func isPrimaryPod(podLabels, primaryLabels map[string]string) bool {
@@ -55,9 +54,13 @@ func isMasterRole(pod *v1.Pod, jobKind string) bool {
 		if len(labels) == 0 {
 			return true
 		}
+		masterRole := MasterRole
+		if role, ok := jobv1beta1.JobMasterRoleMap[jobKind]; ok {
+			masterRole = role
+		}
 		for _, label := range labels {
 			if v, err := getLabel(pod, label); err == nil {
-				if v == MasterRole {
+				if v == masterRole {
 					return true
 				}
 			}
@@ -76,69 +79,8 @@ func getLabel(pod *v1.Pod, targetLabel string) (string, error) {
 	return "", errors.New("Label " + targetLabel + " not found.")
 }
 
-func getRemoteImage(pod *v1.Pod, namespace string, containerIndex int) (crv1.Image, error) {
-	// verify the image name, then download the remote config file
-	c := pod.Spec.Containers[containerIndex]
-	ref, err := name.ParseReference(c.Image, name.WeakValidation)
-	if err != nil {
-		return nil, fmt.Errorf("Failed to parse image %q: %v", c.Image, err)
-	}
-	imagePullSecrets := []string{}
-	for _, s := range pod.Spec.ImagePullSecrets {
-		imagePullSecrets = append(imagePullSecrets, s.Name)
-	}
-	kc, err := k8schain.NewInCluster(k8schain.Options{
-		Namespace:          namespace,
-		ServiceAccountName: pod.Spec.ServiceAccountName,
-		ImagePullSecrets:   imagePullSecrets,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("Failed to create k8schain: %v", err)
-	}
-
-	mkc := authn.NewMultiKeychain(kc)
-	img, err := remote.Image(ref, remote.WithAuthFromKeychain(mkc))
-	if err != nil {
-		return nil, fmt.Errorf("Failed to get container image %q info from registry: %v", c.Image, err)
-	}
-
-	return img, nil
-}
-
-func getContainerCommand(pod *v1.Pod, namespace string, containerIndex int) ([]string, error) {
-	// https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#notes
-	var err error
-	var img crv1.Image
-	var cfg *crv1.ConfigFile
-	args := []string{}
-	c := pod.Spec.Containers[containerIndex]
-	if len(c.Command) != 0 {
-		args = append(args, c.Command...)
-	} else {
-		img, err = getRemoteImage(pod, namespace, containerIndex)
-		if err != nil {
-			return nil, err
-		}
-		cfg, err = img.ConfigFile()
-		if err != nil {
-			return nil, fmt.Errorf("Failed to get config for image %q: %v", c.Image, err)
-		}
-		if len(cfg.Config.Entrypoint) != 0 {
-			args = append(args, cfg.Config.Entrypoint...)
-		}
-	}
-	if len(c.Args) != 0 {
-		args = append(args, c.Args...)
-	} else {
-		if cfg != nil && len(cfg.Config.Cmd) != 0 {
-			args = append(args, cfg.Config.Cmd...)
-		}
-	}
-	return args, nil
-}
-
-func getMetricsCollectorArgs(trialName, metricName string, mc common.MetricsCollectorSpec) []string {
-	args := []string{"-t", trialName, "-m", metricName, "-s", katibmanagerv1beta1.GetDBManagerAddr()}
+func getMetricsCollectorArgs(trial *trialsv1beta1.Trial, metricName string, mc common.MetricsCollectorSpec) []string {
+	args := []string{"-t", trial.Name, "-n", trial.Namespace, "-u", string(trial.UID), "-m", metricName, "-s", katibmanagerv1beta1.GetDBManagerAddr()}
 	if mountPath, _ := getMountPath(mc); mountPath != "" {
 		args = append(args, "-path", mountPath)
 	}
@@ -175,68 +117,206 @@ func needWrapWorkerContainer(mc common.MetricsCollectorSpec) bool {
 	return false
 }
 
-func wrapWorkerContainer(
-	pod *v1.Pod, namespace, jobKind, metricsFile string,
-	pathKind common.FileSystemKind,
-	trial *trialsv1beta1.Trial) error {
-	index := -1
+func getPrimaryContainerIndex(pod *v1.Pod, jobKind string, trial *trialsv1beta1.Trial) (int, error) {
 	for i, c := range pod.Spec.Containers {
 		if trial.Spec.PrimaryContainerName != "" && c.Name == trial.Spec.PrimaryContainerName {
-			index = i
-			break
+			return i, nil
 			// TODO (andreyvelich): This can be deleted after switch to custom CRD
 		} else if trial.Spec.PrimaryContainerName == "" {
 			jobProvider, err := jobv1beta1.New(jobKind)
 			if err != nil {
-				return err
+				return -1, err
 			}
-			if jobProvider.IsTrainingContainer(i, c) {
-				index = i
-				break
+			if jobProvider.IsTrainingContainer(i, c, jobv1beta1.MainContainerName(trial.Spec.RunSpec)) {
+				return i, nil
 			}
 		}
 	}
-	if index >= 0 {
-		command := []string{"sh", "-c"}
-		args, err := getContainerCommand(pod, namespace, index)
-		if err != nil {
-			return err
-		}
-		// If the first two commands are sh -c, we do not inject command.
-		if args[0] == "sh" || args[0] == "bash" {
-			if args[1] == "-c" {
-				command = args[0:2]
-				args = args[2:]
+	return -1, fmt.Errorf("Unable to find primary container %v in mutated pod containers %v",
+		trial.Spec.PrimaryContainerName, pod.Spec.Containers)
+}
+
+func wrapWorkerContainer(
+	pod *v1.Pod, jobKind, metricsFile string,
+	pathKind common.FileSystemKind,
+	trial *trialsv1beta1.Trial,
+	launcherConfig katibconfig.LauncherConfig) error {
+	index, err := getPrimaryContainerIndex(pod, jobKind, trial)
+	if err != nil {
+		return err
+	}
+
+	launcherPath := filepath.Join(LauncherMountPath, LauncherBinaryName)
+	launcherMount := v1.VolumeMount{
+		Name:      LauncherVolume,
+		MountPath: LauncherMountPath,
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: LauncherVolume,
+		VolumeSource: v1.VolumeSource{
+			EmptyDir: &v1.EmptyDirVolumeSource{},
+		},
+	})
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, v1.Container{
+		Name:            LauncherContainerName,
+		Image:           launcherConfig.Image,
+		Args:            []string{"-copy-to", launcherPath},
+		ImagePullPolicy: launcherConfig.ImagePullPolicy,
+		Resources:       launcherConfig.Resource,
+		VolumeMounts:    []v1.VolumeMount{launcherMount},
+	})
+
+	c := &pod.Spec.Containers[index]
+	c.VolumeMounts = append(c.VolumeMounts, launcherMount)
+	args := []string{}
+	if trial.Spec.MetricsCollector.Collector.Kind == common.StdOutCollector {
+		args = append(args, "-metrics-file", metricsFile)
+	}
+	args = append(args, mccommon.LauncherCompletedDirFlag, getCompletedMarkedDir(metricsFile, pathKind))
+	// https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#notes
+	// If the command is not set, launcher resolves ENTRYPOINT of the image inside the pod
+	if len(c.Command) == 0 {
+		args = append(args, "-image", c.Image)
+		// Launcher tries all image pull Secrets in order to get the image config like kubelet.
+		// Image pull Secrets of the service account are already added to the pod if the pod doesn't set them.
+		if len(pod.Spec.ImagePullSecrets) != 0 {
+			sources := []v1.VolumeProjection{}
+			dirs := []string{}
+			for i, secret := range pod.Spec.ImagePullSecrets {
+				sources = append(sources, v1.VolumeProjection{
+					Secret: &v1.SecretProjection{
+						LocalObjectReference: v1.LocalObjectReference{Name: secret.Name},
+						Items: []v1.KeyToPath{
+							{
+								Key:  v1.DockerConfigJsonKey,
+								Path: filepath.Join(strconv.Itoa(i), "config.json"),
+							},
+						},
+						Optional: pointer.BoolPtr(true),
+					},
+				})
+				dirs = append(dirs, filepath.Join(LauncherDockerConfigMountPath, strconv.Itoa(i)))
 			}
+			pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+				Name: LauncherDockerConfigVolume,
+				VolumeSource: v1.VolumeSource{
+					Projected: &v1.ProjectedVolumeSource{
+						Sources: sources,
+					},
+				},
+			})
+			c.VolumeMounts = append(c.VolumeMounts, v1.VolumeMount{
+				Name:      LauncherDockerConfigVolume,
+				MountPath: LauncherDockerConfigMountPath,
+				ReadOnly:  true,
+			})
+			args = append(args, "-docker-config", strings.Join(dirs, ","))
 		}
-		mc := trial.Spec.MetricsCollector
-		if mc.Collector.Kind == common.StdOutCollector {
-			redirectStr := fmt.Sprintf("1>%s 2>&1", metricsFile)
-			args = append(args, redirectStr)
+	}
+	args = append(args, "--")
+	args = append(args, c.Command...)
+	args = append(args, c.Args...)
+	c.Command = []string{launcherPath}
+	c.Args = args
+	return nil
+}
+
+// mutateContainerLogsSource configures the metrics collector to read the primary container logs,
+// the primary container is not changed.
+func mutateContainerLogsSource(pod *v1.Pod, sidecar *v1.Container, jobKind string, trial *trialsv1beta1.Trial, stdOutMode mccommon.StdOutMode) error {
+	index, err := getPrimaryContainerIndex(pod, jobKind, trial)
+	if err != nil {
+		return err
+	}
+	sidecar.Args = append(sidecar.Args, "-stdout-mode", string(stdOutMode), "-container", pod.Spec.Containers[index].Name)
+	// Job pods have only generate name, so the pod is resolved in the metrics collector
+	sidecar.Env = append(sidecar.Env,
+		getFieldRefEnvVar(mccommon.PodNameEnvName, "metadata.name"),
+		getFieldRefEnvVar(mccommon.PodNamespaceEnvName, "metadata.namespace"),
+		getFieldRefEnvVar(mccommon.PodUIDEnvName, "metadata.uid"),
+	)
+	if stdOutMode == mccommon.StdOutModeKubeletLogs {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+			Name: KubeletLogsVolume,
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: mccommon.DefaultKubeletLogDir,
+				},
+			},
+		})
+		// Sub path with the pod directory is set by kubeletLogsSubPathPatch
+		sidecar.VolumeMounts = append(sidecar.VolumeMounts, v1.VolumeMount{
+			Name:      KubeletLogsVolume,
+			MountPath: mccommon.KubeletPodLogDir,
+			ReadOnly:  true,
+		})
+	}
+	return nil
+}
+
+// kubeletLogsSubPathPatch returns the JSON patch which mounts only the log directory of the Trial pod
+// from the kubelet logs volume, so the metrics collector can't read logs of other pods on the node.
+// Pod name and UID are not known during admission, so the directory is set with VolumeMount.subPathExpr,
+// which is added to the patch since the vendored Kubernetes API doesn't have it.
+func kubeletLogsSubPathPatch(pod *v1.Pod) []jsonpatch.JsonPatchOperation {
+	for i, c := range pod.Spec.Containers {
+		for j, m := range c.VolumeMounts {
+			if m.Name == KubeletLogsVolume {
+				return []jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      fmt.Sprintf("/spec/containers/%d/volumeMounts/%d/subPathExpr", i, j),
+						Value: fmt.Sprintf("$(%s)_$(%s)_$(%s)",
+							mccommon.PodNamespaceEnvName, mccommon.PodNameEnvName, mccommon.PodUIDEnvName),
+					},
+				}
+			}
 		}
-		args = append(args, "&&", getMarkCompletedCommand(metricsFile, pathKind))
-		argsStr := strings.Join(args, " ")
-		c := &pod.Spec.Containers[index]
-		c.Command = command
-		c.Args = []string{argsStr}
-	} else {
-		return fmt.Errorf("Unable to find primary container %v in mutated pod containers %v",
-			trial.Spec.PrimaryContainerName, pod.Spec.Containers)
 	}
 	return nil
 }
 
-func getMarkCompletedCommand(mountPath string, pathKind common.FileSystemKind) string {
-	dir := mountPath
+// mutateArtifactsFile configures the primary container to write the artifacts file to the metrics volume
+// and the metrics collector to report the artifacts from this file.
+func mutateArtifactsFile(pod *v1.Pod, sidecar *v1.Container, jobKind, metricsFile string, trial *trialsv1beta1.Trial) error {
+	index, err := getPrimaryContainerIndex(pod, jobKind, trial)
+	if err != nil {
+		return err
+	}
+	artifactsFile := filepath.Join(filepath.Dir(metricsFile), mccommon.ArtifactsFileName)
+	sidecar.Args = append(sidecar.Args, "-artifacts-path", artifactsFile)
+	c := &pod.Spec.Containers[index]
+	c.Env = append(c.Env, v1.EnvVar{
+		Name:  mccommon.ArtifactsFileEnvName,
+		Value: artifactsFile,
+	})
+	return nil
+}
+
+// needArtifactsFile returns true if the metrics collector reports the artifacts file of the training container.
+func needArtifactsFile(mc common.MetricsCollectorSpec) bool {
+	return mc.Collector.Kind == common.StdOutCollector || mc.Collector.Kind == common.FileCollector
+}
+
+func getFieldRefEnvVar(name, fieldPath string) v1.EnvVar {
+	return v1.EnvVar{
+		Name: name,
+		ValueFrom: &v1.EnvVarSource{
+			FieldRef: &v1.ObjectFieldSelector{
+				FieldPath: fieldPath,
+			},
+		},
+	}
+}
+
+func getCompletedMarkedDir(mountPath string, pathKind common.FileSystemKind) string {
 	if pathKind == common.FileKind {
-		dir = filepath.Dir(mountPath)
+		return filepath.Dir(mountPath)
 	}
-	// $$ is process id in shell
-	pidFile := filepath.Join(dir, "$$$$.pid")
-	return fmt.Sprintf("echo %s > %s", mccommon.TrainingCompleted, pidFile)
+	return mountPath
 }
 
-func mutateVolume(pod *v1.Pod, jobKind, mountPath, sidecarContainerName, primaryContainerName string, pathKind common.FileSystemKind) error {
+func mutateVolume(pod *v1.Pod, jobKind, mountPath, sidecarContainerName, primaryContainerName, mainContainerName string, pathKind common.FileSystemKind) error {
 	metricsVol := v1.Volume{
 		Name: common.MetricsVolume,
 		VolumeSource: v1.VolumeSource{
@@ -265,7 +345,7 @@ func mutateVolume(pod *v1.Pod, jobKind, mountPath, sidecarContainerName, primary
 				if err != nil {
 					return err
 				}
-				shouldMount = jobProvider.IsTrainingContainer(i, c)
+				shouldMount = jobProvider.IsTrainingContainer(i, c, mainContainerName)
 			}
 		}
 		if shouldMount {
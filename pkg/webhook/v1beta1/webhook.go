@@ -28,6 +28,7 @@ import (
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/webhook/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/webhook/v1beta1/conversion"
 	"github.com/kubeflow/katib/pkg/webhook/v1beta1/experiment"
 	"github.com/kubeflow/katib/pkg/webhook/v1beta1/pod"
 )
@@ -67,6 +68,12 @@ func AddToManager(m manager.Manager, port int32, serviceName string) error {
 		return err
 	}
 
+	// CRD conversion webhook is served by the admission server
+	server.Handle(conversion.WebhookPath, conversion.NewConversionHandler())
+	if err := m.Add(conversion.NewCABundleInjector(m.GetClient(), so.CertDir)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
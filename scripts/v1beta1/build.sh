@@ -61,6 +61,9 @@ docker build -t ${REGISTRY}/${PREFIX}/katib-ui:${TAG} -f ${CMD_PREFIX}/ui/v1beta
 echo "Building file metrics collector image..."
 docker build -t ${REGISTRY}/${PREFIX}/file-metrics-collector:${TAG} -f ${CMD_PREFIX}/metricscollector/v1beta1/file-metricscollector/Dockerfile .
 
+echo "Building training container launcher image..."
+docker build -t ${REGISTRY}/${PREFIX}/katib-launcher:${TAG} -f ${CMD_PREFIX}/launcher/v1beta1/Dockerfile .
+
 echo "Building TF Event metrics collector image..."
 if [ $MACHINE_ARCH == "aarch64" ]; then
     docker build -t ${REGISTRY}/${PREFIX}/tfevent-metrics-collector:${TAG} -f ${CMD_PREFIX}/metricscollector/v1beta1/tfevent-metricscollector/Dockerfile.aarch64 .
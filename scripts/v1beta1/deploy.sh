@@ -30,4 +30,11 @@ kubectl apply -f manifests/v1beta1/db-manager
 kubectl apply -f manifests/v1beta1/pv
 kubectl apply -f manifests/v1beta1/mysql-db
 kubectl apply -f manifests/v1beta1/ui
+# Set KATIB_GRPC_TLS=true to encrypt gRPC traffic, see docs/grpc-tls.md for the required Secrets
+if [ "${KATIB_GRPC_TLS:-false}" == "true" ]; then
+  kubectl patch configmap katib-config -n kubeflow --patch "$(cat manifests/v1beta1/grpc-tls/katib-config-patch.yaml)"
+  kubectl patch deployment katib-db-manager -n kubeflow --patch "$(cat manifests/v1beta1/grpc-tls/db-manager-patch.yaml)"
+  kubectl patch deployment katib-controller -n kubeflow --patch "$(cat manifests/v1beta1/grpc-tls/katib-controller-patch.yaml)"
+  kubectl patch deployment katib-ui -n kubeflow --patch "$(cat manifests/v1beta1/grpc-tls/ui-patch.yaml)"
+fi
 cd - >/dev/null
@@ -0,0 +1,171 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+
+class V1beta1Artifact(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'metadata': 'dict(str, str)',
+        'name': 'str',
+        'uri': 'str'
+    }
+
+    attribute_map = {
+        'metadata': 'metadata',
+        'name': 'name',
+        'uri': 'uri'
+    }
+
+    def __init__(self, metadata=None, name=None, uri=None):  # noqa: E501
+        """V1beta1Artifact - a model defined in Swagger"""  # noqa: E501
+
+        self._metadata = None
+        self._name = None
+        self._uri = None
+        self.discriminator = None
+
+        if metadata is not None:
+            self.metadata = metadata
+        self.name = name
+        self.uri = uri
+
+    @property
+    def metadata(self):
+        """Gets the metadata of this V1beta1Artifact.  # noqa: E501
+
+        Key-value pairs describing the artifact, e.g. framework or format.  # noqa: E501
+
+        :return: The metadata of this V1beta1Artifact.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._metadata
+
+    @metadata.setter
+    def metadata(self, metadata):
+        """Sets the metadata of this V1beta1Artifact.
+
+        Key-value pairs describing the artifact, e.g. framework or format.  # noqa: E501
+
+        :param metadata: The metadata of this V1beta1Artifact.  # noqa: E501
+        :type: dict(str, str)
+        """
+
+        self._metadata = metadata
+
+    @property
+    def name(self):
+        """Gets the name of this V1beta1Artifact.  # noqa: E501
+
+        :return: The name of this V1beta1Artifact.  # noqa: E501
+        :rtype: str
+        """
+        return self._name
+
+    @name.setter
+    def name(self, name):
+        """Sets the name of this V1beta1Artifact.
+
+        :param name: The name of this V1beta1Artifact.  # noqa: E501
+        :type: str
+        """
+        if name is None:
+            raise ValueError("Invalid value for `name`, must not be `None`")  # noqa: E501
+
+        self._name = name
+
+    @property
+    def uri(self):
+        """Gets the uri of this V1beta1Artifact.  # noqa: E501
+
+        Location of the artifact, e.g. s3://bucket/model or /mnt/models/trial-1.  # noqa: E501
+
+        :return: The uri of this V1beta1Artifact.  # noqa: E501
+        :rtype: str
+        """
+        return self._uri
+
+    @uri.setter
+    def uri(self, uri):
+        """Sets the uri of this V1beta1Artifact.
+
+        Location of the artifact, e.g. s3://bucket/model or /mnt/models/trial-1.  # noqa: E501
+
+        :param uri: The uri of this V1beta1Artifact.  # noqa: E501
+        :type: str
+        """
+        if uri is None:
+            raise ValueError("Invalid value for `uri`, must not be `None`")  # noqa: E501
+
+        self._uri = uri
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1Artifact, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1Artifact):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
@@ -19,8 +19,11 @@ import six
 from kubeflow.katib.models.v1beta1_algorithm_spec import V1beta1AlgorithmSpec  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_metrics_collector_spec import V1beta1MetricsCollectorSpec  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_nas_config import V1beta1NasConfig  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_notification_spec import V1beta1NotificationSpec  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_objective_spec import V1beta1ObjectiveSpec  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_parameter_spec import V1beta1ParameterSpec  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_promotion_spec import V1beta1PromotionSpec  # noqa: F401,E501
+from kubeflow.katib.models.v1beta1_repetition_spec import V1beta1RepetitionSpec  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_trial_template import V1beta1TrialTemplate  # noqa: F401,E501
 
 
@@ -43,9 +46,12 @@ class V1beta1ExperimentSpec(object):
         'max_trial_count': 'int',
         'metrics_collector_spec': 'V1beta1MetricsCollectorSpec',
         'nas_config': 'V1beta1NasConfig',
+        'notifications': 'list[V1beta1NotificationSpec]',
         'objective': 'V1beta1ObjectiveSpec',
         'parallel_trial_count': 'int',
         'parameters': 'list[V1beta1ParameterSpec]',
+        'promote_best_trial': 'V1beta1PromotionSpec',
+        'repetitions': 'V1beta1RepetitionSpec',
         'resume_policy': 'str',
         'trial_template': 'V1beta1TrialTemplate'
     }
@@ -56,14 +62,17 @@ class V1beta1ExperimentSpec(object):
         'max_trial_count': 'maxTrialCount',
         'metrics_collector_spec': 'metricsCollectorSpec',
         'nas_config': 'nasConfig',
+        'notifications': 'notifications',
         'objective': 'objective',
         'parallel_trial_count': 'parallelTrialCount',
         'parameters': 'parameters',
+        'promote_best_trial': 'promoteBestTrial',
+        'repetitions': 'repetitions',
         'resume_policy': 'resumePolicy',
         'trial_template': 'trialTemplate'
     }
 
-    def __init__(self, algorithm=None, max_failed_trial_count=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, objective=None, parallel_trial_count=None, parameters=None, resume_policy=None, trial_template=None):  # noqa: E501
+    def __init__(self, algorithm=None, max_failed_trial_count=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, notifications=None, objective=None, parallel_trial_count=None, parameters=None, promote_best_trial=None, repetitions=None, resume_policy=None, trial_template=None):  # noqa: E501
         """V1beta1ExperimentSpec - a model defined in Swagger"""  # noqa: E501
 
         self._algorithm = None
@@ -71,9 +80,12 @@ class V1beta1ExperimentSpec(object):
         self._max_trial_count = None
         self._metrics_collector_spec = None
         self._nas_config = None
+        self._notifications = None
         self._objective = None
         self._parallel_trial_count = None
         self._parameters = None
+        self._promote_best_trial = None
+        self._repetitions = None
         self._resume_policy = None
         self._trial_template = None
         self.discriminator = None
@@ -88,12 +100,18 @@ class V1beta1ExperimentSpec(object):
             self.metrics_collector_spec = metrics_collector_spec
         if nas_config is not None:
             self.nas_config = nas_config
+        if notifications is not None:
+            self.notifications = notifications
         if objective is not None:
             self.objective = objective
         if parallel_trial_count is not None:
             self.parallel_trial_count = parallel_trial_count
         if parameters is not None:
             self.parameters = parameters
+        if promote_best_trial is not None:
+            self.promote_best_trial = promote_best_trial
+        if repetitions is not None:
+            self.repetitions = repetitions
         if resume_policy is not None:
             self.resume_policy = resume_policy
         if trial_template is not None:
@@ -212,6 +230,29 @@ class V1beta1ExperimentSpec(object):
 
         self._nas_config = nas_config
 
+    @property
+    def notifications(self):
+        """Gets the notifications of this V1beta1ExperimentSpec.  # noqa: E501
+
+        List of webhooks which are notified about the experiment events.  # noqa: E501
+
+        :return: The notifications of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: list[V1beta1NotificationSpec]
+        """
+        return self._notifications
+
+    @notifications.setter
+    def notifications(self, notifications):
+        """Sets the notifications of this V1beta1ExperimentSpec.
+
+        List of webhooks which are notified about the experiment events.  # noqa: E501
+
+        :param notifications: The notifications of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: list[V1beta1NotificationSpec]
+        """
+
+        self._notifications = notifications
+
     @property
     def objective(self):
         """Gets the objective of this V1beta1ExperimentSpec.  # noqa: E501
@@ -281,6 +322,52 @@ class V1beta1ExperimentSpec(object):
 
         self._parameters = parameters
 
+    @property
+    def promote_best_trial(self):
+        """Gets the promote_best_trial of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Describes the job which is created from the best trial when the experiment is succeeded.  # noqa: E501
+
+        :return: The promote_best_trial of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1PromotionSpec
+        """
+        return self._promote_best_trial
+
+    @promote_best_trial.setter
+    def promote_best_trial(self, promote_best_trial):
+        """Sets the promote_best_trial of this V1beta1ExperimentSpec.
+
+        Describes the job which is created from the best trial when the experiment is succeeded.  # noqa: E501
+
+        :param promote_best_trial: The promote_best_trial of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1PromotionSpec
+        """
+
+        self._promote_best_trial = promote_best_trial
+
+    @property
+    def repetitions(self):
+        """Gets the repetitions of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Describes how many times each suggested parameter assignment is run and how observations of the repeated trials are aggregated.  # noqa: E501
+
+        :return: The repetitions of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1RepetitionSpec
+        """
+        return self._repetitions
+
+    @repetitions.setter
+    def repetitions(self, repetitions):
+        """Sets the repetitions of this V1beta1ExperimentSpec.
+
+        Describes how many times each suggested parameter assignment is run and how observations of the repeated trials are aggregated.  # noqa: E501
+
+        :param repetitions: The repetitions of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1RepetitionSpec
+        """
+
+        self._repetitions = repetitions
+
     @property
     def resume_policy(self):
         """Gets the resume_policy of this V1beta1ExperimentSpec.  # noqa: E501
@@ -19,6 +19,7 @@ import six
 from kubeflow.katib.models.v1_time import V1Time  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_experiment_condition import V1beta1ExperimentCondition  # noqa: F401,E501
 from kubeflow.katib.models.v1beta1_optimal_trial import V1beta1OptimalTrial  # noqa: F401,E501
+from kubernetes.client import V1ObjectReference  # noqa: F401,E501
 
 
 class V1beta1ExperimentStatus(object):
@@ -42,6 +43,7 @@ class V1beta1ExperimentStatus(object):
         'killed_trial_list': 'list[str]',
         'last_reconcile_time': 'V1Time',
         'pending_trial_list': 'list[str]',
+        'promoted_job': 'V1ObjectReference',
         'running_trial_list': 'list[str]',
         'start_time': 'V1Time',
         'succeeded_trial_list': 'list[str]',
@@ -61,6 +63,7 @@ class V1beta1ExperimentStatus(object):
         'killed_trial_list': 'killedTrialList',
         'last_reconcile_time': 'lastReconcileTime',
         'pending_trial_list': 'pendingTrialList',
+        'promoted_job': 'promotedJob',
         'running_trial_list': 'runningTrialList',
         'start_time': 'startTime',
         'succeeded_trial_list': 'succeededTrialList',
@@ -72,7 +75,7 @@ class V1beta1ExperimentStatus(object):
         'trials_succeeded': 'trialsSucceeded'
     }
 
-    def __init__(self, completion_time=None, conditions=None, current_optimal_trial=None, failed_trial_list=None, killed_trial_list=None, last_reconcile_time=None, pending_trial_list=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, trials=None, trials_failed=None, trials_killed=None, trials_pending=None, trials_running=None, trials_succeeded=None):  # noqa: E501
+    def __init__(self, completion_time=None, conditions=None, current_optimal_trial=None, failed_trial_list=None, killed_trial_list=None, last_reconcile_time=None, pending_trial_list=None, promoted_job=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, trials=None, trials_failed=None, trials_killed=None, trials_pending=None, trials_running=None, trials_succeeded=None):  # noqa: E501
         """V1beta1ExperimentStatus - a model defined in Swagger"""  # noqa: E501
 
         self._completion_time = None
@@ -82,6 +85,7 @@ class V1beta1ExperimentStatus(object):
         self._killed_trial_list = None
         self._last_reconcile_time = None
         self._pending_trial_list = None
+        self._promoted_job = None
         self._running_trial_list = None
         self._start_time = None
         self._succeeded_trial_list = None
@@ -107,6 +111,8 @@ class V1beta1ExperimentStatus(object):
             self.last_reconcile_time = last_reconcile_time
         if pending_trial_list is not None:
             self.pending_trial_list = pending_trial_list
+        if promoted_job is not None:
+            self.promoted_job = promoted_job
         if running_trial_list is not None:
             self.running_trial_list = running_trial_list
         if start_time is not None:
@@ -287,6 +293,29 @@ class V1beta1ExperimentStatus(object):
 
         self._pending_trial_list = pending_trial_list
 
+    @property
+    def promoted_job(self):
+        """Gets the promoted_job of this V1beta1ExperimentStatus.  # noqa: E501
+
+        Reference to the job which is created from the best trial.  # noqa: E501
+
+        :return: The promoted_job of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: V1ObjectReference
+        """
+        return self._promoted_job
+
+    @promoted_job.setter
+    def promoted_job(self, promoted_job):
+        """Sets the promoted_job of this V1beta1ExperimentStatus.
+
+        Reference to the job which is created from the best trial.  # noqa: E501
+
+        :param promoted_job: The promoted_job of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: V1ObjectReference
+        """
+
+        self._promoted_job = promoted_job
+
     @property
     def running_trial_list(self):
         """Gets the running_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
@@ -31,6 +31,7 @@ class V1beta1FeasibleSpace(object):
                             and the value is json key in definition.
     """
     swagger_types = {
+        'distribution': 'str',
         'list': 'list[str]',
         'max': 'str',
         'min': 'str',
@@ -38,21 +39,25 @@ class V1beta1FeasibleSpace(object):
     }
 
     attribute_map = {
+        'distribution': 'distribution',
         'list': 'list',
         'max': 'max',
         'min': 'min',
         'step': 'step'
     }
 
-    def __init__(self, list=None, max=None, min=None, step=None):  # noqa: E501
+    def __init__(self, distribution=None, list=None, max=None, min=None, step=None):  # noqa: E501
         """V1beta1FeasibleSpace - a model defined in Swagger"""  # noqa: E501
 
+        self._distribution = None
         self._list = None
         self._max = None
         self._min = None
         self._step = None
         self.discriminator = None
 
+        if distribution is not None:
+            self.distribution = distribution
         if list is not None:
             self.list = list
         if max is not None:
@@ -62,6 +67,29 @@ class V1beta1FeasibleSpace(object):
         if step is not None:
             self.step = step
 
+    @property
+    def distribution(self):
+        """Gets the distribution of this V1beta1FeasibleSpace.  # noqa: E501
+
+        Distribution is the prior distribution of the double or int parameter. Step quantizes values of uniform and normal distributions, logUniform doesn't support Step and qLogUniform requires it. Default is uniform.  # noqa: E501
+
+        :return: The distribution of this V1beta1FeasibleSpace.  # noqa: E501
+        :rtype: str
+        """
+        return self._distribution
+
+    @distribution.setter
+    def distribution(self, distribution):
+        """Sets the distribution of this V1beta1FeasibleSpace.
+
+        Distribution is the prior distribution of the double or int parameter. Step quantizes values of uniform and normal distributions, logUniform doesn't support Step and qLogUniform requires it. Default is uniform.  # noqa: E501
+
+        :param distribution: The distribution of this V1beta1FeasibleSpace.  # noqa: E501
+        :type: str
+        """
+
+        self._distribution = distribution
+
     @property
     def list(self):
         """Gets the list of this V1beta1FeasibleSpace.  # noqa: E501
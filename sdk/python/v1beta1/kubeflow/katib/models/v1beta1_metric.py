@@ -34,23 +34,26 @@ class V1beta1Metric(object):
         'latest': 'str',
         'max': 'str',
         'min': 'str',
-        'name': 'str'
+        'name': 'str',
+        'value': 'str'
     }
 
     attribute_map = {
         'latest': 'latest',
         'max': 'max',
         'min': 'min',
-        'name': 'name'
+        'name': 'name',
+        'value': 'value'
     }
 
-    def __init__(self, latest=None, max=None, min=None, name=None):  # noqa: E501
+    def __init__(self, latest=None, max=None, min=None, name=None, value=None):  # noqa: E501
         """V1beta1Metric - a model defined in Swagger"""  # noqa: E501
 
         self._latest = None
         self._max = None
         self._min = None
         self._name = None
+        self._value = None
         self.discriminator = None
 
         if latest is not None:
@@ -61,6 +64,8 @@ class V1beta1Metric(object):
             self.min = min
         if name is not None:
             self.name = name
+        if value is not None:
+            self.value = value
 
     @property
     def latest(self):
@@ -146,6 +151,29 @@ class V1beta1Metric(object):
 
         self._name = name
 
+    @property
+    def value(self):
+        """Gets the value of this V1beta1Metric.  # noqa: E501
+
+        Value computed from the observation log by avg, lastNAvg, median or percentile strategy.  # noqa: E501
+
+        :return: The value of this V1beta1Metric.  # noqa: E501
+        :rtype: str
+        """
+        return self._value
+
+    @value.setter
+    def value(self, value):
+        """Sets the value of this V1beta1Metric.
+
+        Value computed from the observation log by avg, lastNAvg, median or percentile strategy.  # noqa: E501
+
+        :param value: The value of this V1beta1Metric.  # noqa: E501
+        :type: str
+        """
+
+        self._value = value
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
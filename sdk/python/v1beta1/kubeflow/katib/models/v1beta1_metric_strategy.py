@@ -31,27 +31,60 @@ class V1beta1MetricStrategy(object):
                             and the value is json key in definition.
     """
     swagger_types = {
+        'last_n': 'int',
         'name': 'str',
+        'percentile': 'float',
         'value': 'str'
     }
 
     attribute_map = {
+        'last_n': 'lastN',
         'name': 'name',
+        'percentile': 'percentile',
         'value': 'value'
     }
 
-    def __init__(self, name=None, value=None):  # noqa: E501
+    def __init__(self, last_n=None, name=None, percentile=None, value=None):  # noqa: E501
         """V1beta1MetricStrategy - a model defined in Swagger"""  # noqa: E501
 
+        self._last_n = None
         self._name = None
+        self._percentile = None
         self._value = None
         self.discriminator = None
 
+        if last_n is not None:
+            self.last_n = last_n
         if name is not None:
             self.name = name
+        if percentile is not None:
+            self.percentile = percentile
         if value is not None:
             self.value = value
 
+    @property
+    def last_n(self):
+        """Gets the last_n of this V1beta1MetricStrategy.  # noqa: E501
+
+        Number of the last metric values for lastNAvg strategy. Defaults to 10.  # noqa: E501
+
+        :return: The last_n of this V1beta1MetricStrategy.  # noqa: E501
+        :rtype: int
+        """
+        return self._last_n
+
+    @last_n.setter
+    def last_n(self, last_n):
+        """Sets the last_n of this V1beta1MetricStrategy.
+
+        Number of the last metric values for lastNAvg strategy. Defaults to 10.  # noqa: E501
+
+        :param last_n: The last_n of this V1beta1MetricStrategy.  # noqa: E501
+        :type: int
+        """
+
+        self._last_n = last_n
+
     @property
     def name(self):
         """Gets the name of this V1beta1MetricStrategy.  # noqa: E501
@@ -73,6 +106,29 @@ class V1beta1MetricStrategy(object):
 
         self._name = name
 
+    @property
+    def percentile(self):
+        """Gets the percentile of this V1beta1MetricStrategy.  # noqa: E501
+
+        Percentile from 0 to 100 for percentile strategy.  # noqa: E501
+
+        :return: The percentile of this V1beta1MetricStrategy.  # noqa: E501
+        :rtype: float
+        """
+        return self._percentile
+
+    @percentile.setter
+    def percentile(self, percentile):
+        """Sets the percentile of this V1beta1MetricStrategy.
+
+        Percentile from 0 to 100 for percentile strategy.  # noqa: E501
+
+        :param percentile: The percentile of this V1beta1MetricStrategy.  # noqa: E501
+        :type: float
+        """
+
+        self._percentile = percentile
+
     @property
     def value(self):
         """Gets the value of this V1beta1MetricStrategy.  # noqa: E501
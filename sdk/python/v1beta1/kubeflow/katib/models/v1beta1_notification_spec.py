@@ -0,0 +1,232 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubernetes.client import V1SecretKeySelector  # noqa: F401,E501
+
+
+class V1beta1NotificationSpec(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'auth_secret_ref': 'V1SecretKeySelector',
+        'events': 'list[str]',
+        'max_retries': 'int',
+        'payload_template': 'str',
+        'url': 'str'
+    }
+
+    attribute_map = {
+        'auth_secret_ref': 'authSecretRef',
+        'events': 'events',
+        'max_retries': 'maxRetries',
+        'payload_template': 'payloadTemplate',
+        'url': 'url'
+    }
+
+    def __init__(self, auth_secret_ref=None, events=None, max_retries=None, payload_template=None, url=None):  # noqa: E501
+        """V1beta1NotificationSpec - a model defined in Swagger"""  # noqa: E501
+
+        self._auth_secret_ref = None
+        self._events = None
+        self._max_retries = None
+        self._payload_template = None
+        self._url = None
+        self.discriminator = None
+
+        if auth_secret_ref is not None:
+            self.auth_secret_ref = auth_secret_ref
+        if events is not None:
+            self.events = events
+        if max_retries is not None:
+            self.max_retries = max_retries
+        if payload_template is not None:
+            self.payload_template = payload_template
+        self.url = url
+
+    @property
+    def auth_secret_ref(self):
+        """Gets the auth_secret_ref of this V1beta1NotificationSpec.  # noqa: E501
+
+        Secret key in the experiment namespace with the value of the Authorization header.  # noqa: E501
+
+        :return: The auth_secret_ref of this V1beta1NotificationSpec.  # noqa: E501
+        :rtype: V1SecretKeySelector
+        """
+        return self._auth_secret_ref
+
+    @auth_secret_ref.setter
+    def auth_secret_ref(self, auth_secret_ref):
+        """Sets the auth_secret_ref of this V1beta1NotificationSpec.
+
+        Secret key in the experiment namespace with the value of the Authorization header.  # noqa: E501
+
+        :param auth_secret_ref: The auth_secret_ref of this V1beta1NotificationSpec.  # noqa: E501
+        :type: V1SecretKeySelector
+        """
+
+        self._auth_secret_ref = auth_secret_ref
+
+    @property
+    def events(self):
+        """Gets the events of this V1beta1NotificationSpec.  # noqa: E501
+
+        List of events which trigger the notification. Defaults to Succeeded and Failed.  # noqa: E501
+
+        :return: The events of this V1beta1NotificationSpec.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._events
+
+    @events.setter
+    def events(self, events):
+        """Sets the events of this V1beta1NotificationSpec.
+
+        List of events which trigger the notification. Defaults to Succeeded and Failed.  # noqa: E501
+
+        :param events: The events of this V1beta1NotificationSpec.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._events = events
+
+    @property
+    def max_retries(self):
+        """Gets the max_retries of this V1beta1NotificationSpec.  # noqa: E501
+
+        Max number of retries with exponential backoff if the request fails. Defaults to 3  # noqa: E501
+
+        :return: The max_retries of this V1beta1NotificationSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_retries
+
+    @max_retries.setter
+    def max_retries(self, max_retries):
+        """Sets the max_retries of this V1beta1NotificationSpec.
+
+        Max number of retries with exponential backoff if the request fails. Defaults to 3  # noqa: E501
+
+        :param max_retries: The max_retries of this V1beta1NotificationSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._max_retries = max_retries
+
+    @property
+    def payload_template(self):
+        """Gets the payload_template of this V1beta1NotificationSpec.  # noqa: E501
+
+        Go template of the JSON payload. The template is executed with .Event, .Experiment and .BestTrial fields. Default payload contains the experiment, the event, the condition and the best trial.  # noqa: E501
+
+        :return: The payload_template of this V1beta1NotificationSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._payload_template
+
+    @payload_template.setter
+    def payload_template(self, payload_template):
+        """Sets the payload_template of this V1beta1NotificationSpec.
+
+        Go template of the JSON payload. The template is executed with .Event, .Experiment and .BestTrial fields. Default payload contains the experiment, the event, the condition and the best trial.  # noqa: E501
+
+        :param payload_template: The payload_template of this V1beta1NotificationSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._payload_template = payload_template
+
+    @property
+    def url(self):
+        """Gets the url of this V1beta1NotificationSpec.  # noqa: E501
+
+        URL of the HTTP endpoint. The payload is sent with the POST request.  # noqa: E501
+
+        :return: The url of this V1beta1NotificationSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._url
+
+    @url.setter
+    def url(self, url):
+        """Sets the url of this V1beta1NotificationSpec.
+
+        URL of the HTTP endpoint. The payload is sent with the POST request.  # noqa: E501
+
+        :param url: The url of this V1beta1NotificationSpec.  # noqa: E501
+        :type: str
+        """
+        if url is None:
+            raise ValueError("Invalid value for `url`, must not be `None`")  # noqa: E501
+
+        self._url = url
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1NotificationSpec, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1NotificationSpec):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
@@ -34,29 +34,62 @@ class V1beta1OptimalTrial(object):
                             and the value is json key in definition.
     """
     swagger_types = {
+        'artifacts': 'list[V1beta1Artifact]',
         'best_trial_name': 'str',
         'observation': 'V1beta1Observation',
-        'parameter_assignments': 'list[V1beta1ParameterAssignment]'
+        'parameter_assignments': 'list[V1beta1ParameterAssignment]',
+        'repetition_trial_names': 'list[str]'
     }
 
     attribute_map = {
+        'artifacts': 'artifacts',
         'best_trial_name': 'bestTrialName',
         'observation': 'observation',
-        'parameter_assignments': 'parameterAssignments'
+        'parameter_assignments': 'parameterAssignments',
+        'repetition_trial_names': 'repetitionTrialNames'
     }
 
-    def __init__(self, best_trial_name=None, observation=None, parameter_assignments=None):  # noqa: E501
+    def __init__(self, artifacts=None, best_trial_name=None, observation=None, parameter_assignments=None, repetition_trial_names=None):  # noqa: E501
         """V1beta1OptimalTrial - a model defined in Swagger"""  # noqa: E501
 
+        self._artifacts = None
         self._best_trial_name = None
         self._observation = None
         self._parameter_assignments = None
+        self._repetition_trial_names = None
         self.discriminator = None
 
+        if artifacts is not None:
+            self.artifacts = artifacts
         self.best_trial_name = best_trial_name
         if observation is not None:
             self.observation = observation
         self.parameter_assignments = parameter_assignments
+        if repetition_trial_names is not None:
+            self.repetition_trial_names = repetition_trial_names
+
+    @property
+    def artifacts(self):
+        """Gets the artifacts of this V1beta1OptimalTrial.  # noqa: E501
+
+        Checkpoints, models and other outputs reported by the best trial.  # noqa: E501
+
+        :return: The artifacts of this V1beta1OptimalTrial.  # noqa: E501
+        :rtype: list[V1beta1Artifact]
+        """
+        return self._artifacts
+
+    @artifacts.setter
+    def artifacts(self, artifacts):
+        """Sets the artifacts of this V1beta1OptimalTrial.
+
+        Checkpoints, models and other outputs reported by the best trial.  # noqa: E501
+
+        :param artifacts: The artifacts of this V1beta1OptimalTrial.  # noqa: E501
+        :type: list[V1beta1Artifact]
+        """
+
+        self._artifacts = artifacts
 
     @property
     def best_trial_name(self):
@@ -131,6 +164,29 @@ class V1beta1OptimalTrial(object):
 
         self._parameter_assignments = parameter_assignments
 
+    @property
+    def repetition_trial_names(self):
+        """Gets the repetition_trial_names of this V1beta1OptimalTrial.  # noqa: E501
+
+        Names of the repeated trials with the same parameter assignments. Observation is aggregated from these trials if repetitions are set.  # noqa: E501
+
+        :return: The repetition_trial_names of this V1beta1OptimalTrial.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._repetition_trial_names
+
+    @repetition_trial_names.setter
+    def repetition_trial_names(self, repetition_trial_names):
+        """Sets the repetition_trial_names of this V1beta1OptimalTrial.
+
+        Names of the repeated trials with the same parameter assignments. Observation is aggregated from these trials if repetitions are set.  # noqa: E501
+
+        :param repetition_trial_names: The repetition_trial_names of this V1beta1OptimalTrial.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._repetition_trial_names = repetition_trial_names
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
@@ -0,0 +1,175 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.models.v1beta1_parameter_assignment import V1beta1ParameterAssignment  # noqa: F401,E501
+
+
+class V1beta1PromotionSpec(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'name': 'str',
+        'parameter_overrides': 'list[V1beta1ParameterAssignment]',
+        'run_spec_patch': 'str'
+    }
+
+    attribute_map = {
+        'name': 'name',
+        'parameter_overrides': 'parameterOverrides',
+        'run_spec_patch': 'runSpecPatch'
+    }
+
+    def __init__(self, name=None, parameter_overrides=None, run_spec_patch=None):  # noqa: E501
+        """V1beta1PromotionSpec - a model defined in Swagger"""  # noqa: E501
+
+        self._name = None
+        self._parameter_overrides = None
+        self._run_spec_patch = None
+        self.discriminator = None
+
+        if name is not None:
+            self.name = name
+        if parameter_overrides is not None:
+            self.parameter_overrides = parameter_overrides
+        if run_spec_patch is not None:
+            self.run_spec_patch = run_spec_patch
+
+    @property
+    def name(self):
+        """Gets the name of this V1beta1PromotionSpec.  # noqa: E501
+
+        Name of the job. Defaults to <experiment name>-best.  # noqa: E501
+
+        :return: The name of this V1beta1PromotionSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._name
+
+    @name.setter
+    def name(self, name):
+        """Sets the name of this V1beta1PromotionSpec.
+
+        Name of the job. Defaults to <experiment name>-best.  # noqa: E501
+
+        :param name: The name of this V1beta1PromotionSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._name = name
+
+    @property
+    def parameter_overrides(self):
+        """Gets the parameter_overrides of this V1beta1PromotionSpec.  # noqa: E501
+
+        Parameter assignments which override the assignments of the best trial.  # noqa: E501
+
+        :return: The parameter_overrides of this V1beta1PromotionSpec.  # noqa: E501
+        :rtype: list[V1beta1ParameterAssignment]
+        """
+        return self._parameter_overrides
+
+    @parameter_overrides.setter
+    def parameter_overrides(self, parameter_overrides):
+        """Sets the parameter_overrides of this V1beta1PromotionSpec.
+
+        Parameter assignments which override the assignments of the best trial.  # noqa: E501
+
+        :param parameter_overrides: The parameter_overrides of this V1beta1PromotionSpec.  # noqa: E501
+        :type: list[V1beta1ParameterAssignment]
+        """
+
+        self._parameter_overrides = parameter_overrides
+
+    @property
+    def run_spec_patch(self):
+        """Gets the run_spec_patch of this V1beta1PromotionSpec.  # noqa: E501
+
+        JSON merge patch which is applied to the rendered job, e.g. to train for more epochs. Patch can't change apiVersion, kind and metadata of the job.  # noqa: E501
+
+        :return: The run_spec_patch of this V1beta1PromotionSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._run_spec_patch
+
+    @run_spec_patch.setter
+    def run_spec_patch(self, run_spec_patch):
+        """Sets the run_spec_patch of this V1beta1PromotionSpec.
+
+        JSON merge patch which is applied to the rendered job, e.g. to train for more epochs. Patch can't change apiVersion, kind and metadata of the job.  # noqa: E501
+
+        :param run_spec_patch: The run_spec_patch of this V1beta1PromotionSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._run_spec_patch = run_spec_patch
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1PromotionSpec, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1PromotionSpec):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
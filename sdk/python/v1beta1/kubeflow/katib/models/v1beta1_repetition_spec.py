@@ -0,0 +1,173 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    OpenAPI spec version: v1beta1-0.1
+    
+    Generated by: https://github.com/swagger-api/swagger-codegen.git
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+
+class V1beta1RepetitionSpec(object):
+    """NOTE: This class is auto generated by the swagger code generator program.
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      swagger_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    swagger_types = {
+        'aggregation': 'str',
+        'count': 'int',
+        'seed_parameter_name': 'str'
+    }
+
+    attribute_map = {
+        'aggregation': 'aggregation',
+        'count': 'count',
+        'seed_parameter_name': 'seedParameterName'
+    }
+
+    def __init__(self, aggregation=None, count=None, seed_parameter_name=None):  # noqa: E501
+        """V1beta1RepetitionSpec - a model defined in Swagger"""  # noqa: E501
+
+        self._aggregation = None
+        self._count = None
+        self._seed_parameter_name = None
+        self.discriminator = None
+
+        if aggregation is not None:
+            self.aggregation = aggregation
+        if count is not None:
+            self.count = count
+        if seed_parameter_name is not None:
+            self.seed_parameter_name = seed_parameter_name
+
+    @property
+    def aggregation(self):
+        """Gets the aggregation of this V1beta1RepetitionSpec.  # noqa: E501
+
+        How observations of the repeated trials are aggregated before they are reported to the suggestion service and compared to find the best trial. Defaults to mean. With std the mean is compared and the standard deviation is reported alongside as the <metric name>-std metric.  # noqa: E501
+
+        :return: The aggregation of this V1beta1RepetitionSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._aggregation
+
+    @aggregation.setter
+    def aggregation(self, aggregation):
+        """Sets the aggregation of this V1beta1RepetitionSpec.
+
+        How observations of the repeated trials are aggregated before they are reported to the suggestion service and compared to find the best trial. Defaults to mean. With std the mean is compared and the standard deviation is reported alongside as the <metric name>-std metric.  # noqa: E501
+
+        :param aggregation: The aggregation of this V1beta1RepetitionSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._aggregation = aggregation
+
+    @property
+    def count(self):
+        """Gets the count of this V1beta1RepetitionSpec.  # noqa: E501
+
+        How many trials are run for each parameter assignment. Defaults to 1.  # noqa: E501
+
+        :return: The count of this V1beta1RepetitionSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._count
+
+    @count.setter
+    def count(self, count):
+        """Sets the count of this V1beta1RepetitionSpec.
+
+        How many trials are run for each parameter assignment. Defaults to 1.  # noqa: E501
+
+        :param count: The count of this V1beta1RepetitionSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._count = count
+
+    @property
+    def seed_parameter_name(self):
+        """Gets the seed_parameter_name of this V1beta1RepetitionSpec.  # noqa: E501
+
+        Name of the trial parameter with the seed of the repeated trial, e.g. ${trialParameters.seed}. The seed is the index of the repetition, starting from 0. Defaults to seed.  # noqa: E501
+
+        :return: The seed_parameter_name of this V1beta1RepetitionSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._seed_parameter_name
+
+    @seed_parameter_name.setter
+    def seed_parameter_name(self, seed_parameter_name):
+        """Sets the seed_parameter_name of this V1beta1RepetitionSpec.
+
+        Name of the trial parameter with the seed of the repeated trial, e.g. ${trialParameters.seed}. The seed is the index of the repetition, starting from 0. Defaults to seed.  # noqa: E501
+
+        :param seed_parameter_name: The seed_parameter_name of this V1beta1RepetitionSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._seed_parameter_name = seed_parameter_name
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.swagger_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+        if issubclass(V1beta1RepetitionSpec, dict):
+            for key, value in self.items():
+                result[key] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1RepetitionSpec):
+            return False
+
+        return self.__dict__ == other.__dict__
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        return not self == other
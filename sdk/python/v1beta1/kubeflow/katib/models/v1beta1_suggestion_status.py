@@ -39,6 +39,7 @@ class V1beta1SuggestionStatus(object):
         'algorithm_settings': 'list[V1beta1AlgorithmSetting]',
         'completion_time': 'V1Time',
         'conditions': 'list[V1beta1SuggestionCondition]',
+        'endpoint': 'str',
         'last_reconcile_time': 'V1Time',
         'start_time': 'V1Time',
         'suggestion_count': 'int',
@@ -49,18 +50,20 @@ class V1beta1SuggestionStatus(object):
         'algorithm_settings': 'algorithmSettings',
         'completion_time': 'completionTime',
         'conditions': 'conditions',
+        'endpoint': 'endpoint',
         'last_reconcile_time': 'lastReconcileTime',
         'start_time': 'startTime',
         'suggestion_count': 'suggestionCount',
         'suggestions': 'suggestions'
     }
 
-    def __init__(self, algorithm_settings=None, completion_time=None, conditions=None, last_reconcile_time=None, start_time=None, suggestion_count=None, suggestions=None):  # noqa: E501
+    def __init__(self, algorithm_settings=None, completion_time=None, conditions=None, endpoint=None, last_reconcile_time=None, start_time=None, suggestion_count=None, suggestions=None):  # noqa: E501
         """V1beta1SuggestionStatus - a model defined in Swagger"""  # noqa: E501
 
         self._algorithm_settings = None
         self._completion_time = None
         self._conditions = None
+        self._endpoint = None
         self._last_reconcile_time = None
         self._start_time = None
         self._suggestion_count = None
@@ -73,6 +76,8 @@ class V1beta1SuggestionStatus(object):
             self.completion_time = completion_time
         if conditions is not None:
             self.conditions = conditions
+        if endpoint is not None:
+            self.endpoint = endpoint
         if last_reconcile_time is not None:
             self.last_reconcile_time = last_reconcile_time
         if start_time is not None:
@@ -151,6 +156,29 @@ class V1beta1SuggestionStatus(object):
 
         self._conditions = conditions
 
+    @property
+    def endpoint(self):
+        """Gets the endpoint of this V1beta1SuggestionStatus.  # noqa: E501
+
+        Endpoint of the shared algorithm service which serves this Suggestion. It is empty if the Suggestion has own Deployment and Service.  # noqa: E501
+
+        :return: The endpoint of this V1beta1SuggestionStatus.  # noqa: E501
+        :rtype: str
+        """
+        return self._endpoint
+
+    @endpoint.setter
+    def endpoint(self, endpoint):
+        """Sets the endpoint of this V1beta1SuggestionStatus.
+
+        Endpoint of the shared algorithm service which serves this Suggestion. It is empty if the Suggestion has own Deployment and Service.  # noqa: E501
+
+        :param endpoint: The endpoint of this V1beta1SuggestionStatus.  # noqa: E501
+        :type: str
+        """
+
+        self._endpoint = endpoint
+
     @property
     def last_reconcile_time(self):
         """Gets the last_reconcile_time of this V1beta1SuggestionStatus.  # noqa: E501
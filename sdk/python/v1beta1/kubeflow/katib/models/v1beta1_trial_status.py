@@ -35,6 +35,7 @@ class V1beta1TrialStatus(object):
                             and the value is json key in definition.
     """
     swagger_types = {
+        'artifacts': 'list[V1beta1Artifact]',
         'completion_time': 'V1Time',
         'conditions': 'list[V1beta1TrialCondition]',
         'last_reconcile_time': 'V1Time',
@@ -43,6 +44,7 @@ class V1beta1TrialStatus(object):
     }
 
     attribute_map = {
+        'artifacts': 'artifacts',
         'completion_time': 'completionTime',
         'conditions': 'conditions',
         'last_reconcile_time': 'lastReconcileTime',
@@ -50,9 +52,10 @@ class V1beta1TrialStatus(object):
         'start_time': 'startTime'
     }
 
-    def __init__(self, completion_time=None, conditions=None, last_reconcile_time=None, observation=None, start_time=None):  # noqa: E501
+    def __init__(self, artifacts=None, completion_time=None, conditions=None, last_reconcile_time=None, observation=None, start_time=None):  # noqa: E501
         """V1beta1TrialStatus - a model defined in Swagger"""  # noqa: E501
 
+        self._artifacts = None
         self._completion_time = None
         self._conditions = None
         self._last_reconcile_time = None
@@ -60,6 +63,8 @@ class V1beta1TrialStatus(object):
         self._start_time = None
         self.discriminator = None
 
+        if artifacts is not None:
+            self.artifacts = artifacts
         if completion_time is not None:
             self.completion_time = completion_time
         if conditions is not None:
@@ -71,6 +76,29 @@ class V1beta1TrialStatus(object):
         if start_time is not None:
             self.start_time = start_time
 
+    @property
+    def artifacts(self):
+        """Gets the artifacts of this V1beta1TrialStatus.  # noqa: E501
+
+        Checkpoints, models and other outputs reported by the Trial.  # noqa: E501
+
+        :return: The artifacts of this V1beta1TrialStatus.  # noqa: E501
+        :rtype: list[V1beta1Artifact]
+        """
+        return self._artifacts
+
+    @artifacts.setter
+    def artifacts(self, artifacts):
+        """Sets the artifacts of this V1beta1TrialStatus.
+
+        Checkpoints, models and other outputs reported by the Trial.  # noqa: E501
+
+        :param artifacts: The artifacts of this V1beta1TrialStatus.  # noqa: E501
+        :type: list[V1beta1Artifact]
+        """
+
+        self._artifacts = artifacts
+
     @property
     def completion_time(self):
         """Gets the completion_time of this V1beta1TrialStatus.  # noqa: E501
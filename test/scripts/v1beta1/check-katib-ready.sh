@@ -66,6 +66,9 @@ sed -i -e "s@image: gcr.io\/kubeflow-images-public\/katib\/v1beta1\/katib-contro
 sed -i -e "s@gcr.io\/kubeflow-images-public\/katib\/v1beta1\/file-metrics-collector@${REGISTRY}\/${REPO_NAME}\/v1beta1\/file-metrics-collector:${VERSION}@" manifests/v1beta1/katib-controller/katib-config.yaml
 sed -i -e "s@gcr.io\/kubeflow-images-public\/katib\/v1beta1\/tfevent-metrics-collector@${REGISTRY}\/${REPO_NAME}\/v1beta1\/tfevent-metrics-collector:${VERSION}@" manifests/v1beta1/katib-controller/katib-config.yaml
 
+# Training container launcher
+sed -i -e "s@gcr.io\/kubeflow-images-public\/katib\/v1beta1\/katib-launcher@${REGISTRY}\/${REPO_NAME}\/v1beta1\/katib-launcher:${VERSION}@" manifests/v1beta1/katib-controller/katib-config.yaml
+
 # Katib DB manager
 sed -i -e "s@image: gcr.io\/kubeflow-images-public\/katib\/v1beta1\/katib-db-manager@image: ${REGISTRY}\/${REPO_NAME}\/v1beta1\/katib-db-manager:${VERSION}@" manifests/v1beta1/db-manager/deployment.yaml
 
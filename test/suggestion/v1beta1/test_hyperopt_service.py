@@ -283,6 +283,40 @@ class TestHyperopt(unittest.TestCase):
         self.assertEqual(code, grpc.StatusCode.INVALID_ARGUMENT)
         self.assertTrue(details.startswith('failed to validate prior_weight(aaa)'))
 
+    def test_experiment_state(self):
+        def new_experiment(namespace, uid):
+            return api_pb2.Experiment(
+                name="test",
+                namespace=namespace,
+                uid=uid,
+                spec=api_pb2.ExperimentSpec(
+                    algorithm=api_pb2.AlgorithmSpec(algorithm_name="random"),
+                    objective=api_pb2.ObjectiveSpec(type=api_pb2.MAXIMIZE),
+                    parameter_specs=api_pb2.ExperimentSpec.ParameterSpecs(
+                        parameters=[
+                            api_pb2.ParameterSpec(
+                                name="param-1",
+                                parameter_type=api_pb2.DOUBLE,
+                                feasible_space=api_pb2.FeasibleSpace(max="5", min="1", list=[])
+                            )
+                        ]
+                    )
+                )
+            )
+
+        service = HyperoptService()
+        state = service._get_experiment_state(new_experiment("kubeflow", "uid-1"))
+        self.assertIs(state, service._get_experiment_state(new_experiment("kubeflow", "uid-1")))
+        # Experiments in other namespaces and recreated Experiments have own state
+        self.assertIsNot(state, service._get_experiment_state(new_experiment("other", "uid-1")))
+        self.assertIsNot(state, service._get_experiment_state(new_experiment("kubeflow", "uid-2")))
+        self.assertEqual(3, len(service.experiments))
+
+        # Unused states are released
+        service.state_ttl = -1
+        service._get_experiment_state(new_experiment("kubeflow", "uid-3"))
+        self.assertEqual([("kubeflow", "test", "uid-3")], list(service.experiments.keys()))
+
 
 if __name__ == '__main__':
     unittest.main()
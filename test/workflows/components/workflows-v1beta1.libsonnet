@@ -231,6 +231,10 @@
                     name: "build-file-metrics-collector",
                     template: "build-file-metrics-collector",
                   },
+                  {
+                    name: "build-launcher",
+                    template: "build-launcher",
+                  },
                   {
                     name: "build-tfevent-metrics-collector",
                     template: "build-tfevent-metrics-collector",
@@ -457,6 +461,9 @@
             $.parts(namespace, name, overrides).e2e(prow_env, bucket).buildTemplate("build-file-metrics-collector", testWorkerImage, [
               "test/scripts/v1beta1/build-file-metrics-collector.sh",
             ]),  // build-file-metrics-collector
+            $.parts(namespace, name, overrides).e2e(prow_env, bucket).buildTemplate("build-launcher", testWorkerImage, [
+              "test/scripts/v1beta1/build-launcher.sh",
+            ]),  // build-launcher
             $.parts(namespace, name, overrides).e2e(prow_env, bucket).buildTemplate("build-tfevent-metrics-collector", testWorkerImage, [
               "test/scripts/v1beta1/build-tfevent-metrics-collector.sh",
             ]),  // build-tfevent-metrics-collector